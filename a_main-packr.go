@@ -7,9 +7,41 @@ import "github.com/gobuffalo/packr"
 // You can use the "packr clean" command to clean up this,
 // and any other packr generated files.
 func init() {
-	packr.PackJSONBytes("./tmpl", "model.html", "\"e3tkZWZpbmUgIm1vZGVsIn19CnBhY2thZ2Uge3sgLlBhY2thZ2VOYW1lIH19CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBDb2RlIGdlbmVyYXRlZCBieSBtb2RlbGdlbiB8CnwgICAgICAgIERPIE5PVCBFRElULiAgICAgICAgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIElmIHlvdSB3YW50IHRvIGV4dGVuZCBiZWhhdmlvdXI6Ci8vIFBsZWFzZSBjcmVhdGUgYSBjdXN0b20gZmlsZSBpbiB0aGlzIGRpcmVjdG9yeQovLyBhbmQgY3JlYXRlIHRoZSBtZXRob2RzIHRoZXJlIHRvIGF2b2lkIG92ZXJ3cml0aW5nIHlvdXIgY29kZSB3aXRoIHRoZSBnZW5lcmF0ZWQgb25lLgoKaW1wb3J0ICgKImZtdCIKICAgIHt7IHJhbmdlICRrLCAkdjo9IC5Nb2RlbC5JbXBvcnRzIH19CiAgICAie3ska319IgogICAge3stIGVuZCB9fQopCgovLyB7ey5Nb2RlbC5OYW1lfX0gcmVwcmVzZW50cyBhIHJvdyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKdHlwZSB7ey5Nb2RlbC5OYW1lfX0gc3RydWN0IHsKICAgIHt7IHJhbmdlICRrLCAkdjo9IC5Nb2RlbC5GaWVsZHMgfX0KICAgICAgICB7eyAkdi5OYW1lIH19IHt7ICR2LlR5cGUgfX0gYGpzb246Int7JHYuQ29sdW1uTmFtZX19ImAKICAgIHt7LSBlbmQgfX0Kb2Zmc2V0IGludApsaW1pdCBpbnQKfQoKLy8gSW5zZXJ0IGEgbmV3IHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgSW5zZXJ0KHF1IFF1ZXJ5ZXIpIChsYXN0SW5zZXJ0SUQgaW50NjQsIGVyciBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9ICJJTlNFUlQgSU5UTyB7ey5Nb2RlbC5UYWJsZU5hbWV9fSAoe3suTW9kZWwuRmllbGRzIHwgaW5zZXJ0X2ZpZWxkc319KSBWQUxVRVMgKHt7Lk1vZGVsLkZpZWxkcyB8IGluc2VydF92YWx1ZXN9fSkiCiAgICByZXMsIGVyciA6PSBxdS5FeGVjKHN0bXR7eyAuIHwgaW5zZXJ0X2FyZ3MgfX0pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICByZXR1cm4gcmVzLkxhc3RJbnNlcnRJZCgpCn0KCi8vIFVwZGF0ZSBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZS4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBVcGRhdGUocXUgUXVlcnllciwgaWQgaW50NjQpIChpbnQ2NCwgZXJyb3IpIHsKICAgIGNvbnN0IHN0bXQgPSAiVVBEQVRFIHt7Lk1vZGVsLlRhYmxlTmFtZX19IFNFVCB7eyAuIHwgdXBkYXRlX3ZhbHVlcyB9fSBXSEVSRSBpZCA9ID8iCiAgICByZXN1bHQsIGVyciA6PSBxdS5FeGVjKHN0bXQsIHt7IC4gfCB1cGRhdGVfYXJncyB9fSBpZCkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHJldHVybiByZXN1bHQuUm93c0FmZmVjdGVkKCkKfQoKLy8gVXBzZXJ0IGluc2VydHMgYSBuZXcge3suTW9kZWwuTmFtZX19IHJvdyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKLy8gaWYgdGhlIHVuaXF1ZSBjb25zdHJhaW50cyBhcmUgbm90IGZvdW5kLCBvdGhlcndpc2UgaXQgdXBkYXRlcyBpdC4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBVcHNlcnQocXUgUXVlcnllcikgKGxhc3RJbnNlcnRJRCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICBjb25zdCBzdG10ID0gIklOU0VSVCBJTlRPIHt7Lk1vZGVsLlRhYmxlTmFtZX19ICh7ey5Nb2RlbC5GaWVsZHMgfCB1cHNlcnRfZmllbGRzfX0pIFZBTFVFUyAoe3suTW9kZWwuRmllbGRzIHwgdXBzZXJ0X3ZhbHVlc319KSBPTiBEVVBMSUNBVEUgS0VZIFVQREFURSB7eyAuIHwgdXBzZXJ0X29uX2R1cGxpY2F0ZSB9fSIKICAgIHJlcywgZXJyIDo9IHF1LkV4ZWMoc3RtdCwge3sgLiB8IHVwc2VydF9hcmdzIH19KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAgcmV0dXJuIHJlcy5MYXN0SW5zZXJ0SWQoKQp9CgovLyBGaW5kIGFuIGV4aXN0aW5nIHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRmluZChxdSBRdWVyeWVyLCBpZCBpbnQ2NCkgZXJyb3IgewogICAgY29uc3Qgc3RtdCA9ICJTRUxFQ1QgKiBGUk9NIHt7Lk1vZGVsLlRhYmxlTmFtZX19IFdIRVJFIGlkID0gPyIKICAgIHJvdyA6PSBxdS5RdWVyeVJvdyhzdG10LCBpZCkKICAgIHJldHVybiByb3cuU2Nhbih7eyAuIHwgc2Nhbl9maWVsZHN9fSkKfQoKLy8gTG9hZCBhbGwsIG9yIGEgc3Vic2V0IG9mIHt7Lk1vZGVsLk5hbWV9fSByb3dzIGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgTG9hZChxdSBRdWVyeWVyKSAoc2V0IFtde3suTW9kZWwuTmFtZX19LCBlcnIgZXJyb3IpIHsKICAgIHN0bXQgOj0gIlNFTEVDVCAqIEZST00ge3suTW9kZWwuVGFibGVOYW1lfX0iCgogICAgaWYge3suUmVjZWl2ZXJ9fS5saW1pdCA9PSAwICYmIHt7LlJlY2VpdmVyfX0ub2Zmc2V0ID4gMCB7CiAgICAgICAgcmV0dXJuIHNldCwgZm10LkVycm9yZigiY2Fubm90IHF1ZXJ5IHdpdGggb2Zmc2V0IGJ1dCBubyBsaW1pdCIpCiAgICB9CgogICAgaWYge3suUmVjZWl2ZXJ9fS5saW1pdCA+IDAgewogICAgICAgIHN0bXQgKz0gZm10LlNwcmludGYoIiBMSU1JVCAlZCIsIHt7LlJlY2VpdmVyfX0ubGltaXQpCiAgICB9CiAgICBpZiB7ey5SZWNlaXZlcn19Lm9mZnNldCA+IDAgewogICAgICAgIHN0bXQgKz0gZm10LlNwcmludGYoIiBPRkZTRVQgJWQiLCB7ey5SZWNlaXZlcn19Lm9mZnNldCkKICAgIH0KICAgIGRlZmVyIGZ1bmMoKSB7CiAgICAgICAge3suUmVjZWl2ZXJ9fS5saW1pdCA9IDAKICAgICAgICB7ey5SZWNlaXZlcn19Lm9mZnNldCA9IDAKICAgIH0oKQogICAgcm93cywgZXJyIDo9IHF1LlF1ZXJ5KHN0bXQpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIGRlZmVyIHJvd3MuQ2xvc2UoKQogICAgICAgIGZvciByb3dzLk5leHQoKSB7CiAgICAgICAgICAgIHZhciB7ey5SZWNlaXZlcn19IHt7Lk1vZGVsLk5hbWV9fQogICAgICAgICAgICBpZiBlcnIgPSByb3dzLlNjYW4oe3suIHwgc2Nhbl9maWVsZHN9fSk7IGVyciAhPSBuaWwgewogICAgICAgICAgICByZXR1cm4KICAgICAgICB9CiAgICAgICAgc2V0ID0gYXBwZW5kKHNldCwge3suUmVjZWl2ZXJ9fSkKICAgIH0KCiAgICByZXR1cm4KfQoKLy8gRGVsZXRlIGFuIGV4aXN0aW5nIHt7Lk1vZGVsLk5hbWV9fSByb3cgZnJvbSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBEZWxldGUocXUgUXVlcnllciwgaWQgaW50NjQpIChyb3dzQWZmZWN0ZWQgaW50NjQsIGVyciBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9ICJERUxFVEUgRlJPTSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSBXSEVSRSBpZCA9ID8iCiAgICByZXN1bHQsIGVyciA6PSBxdS5FeGVjKHN0bXQsIGlkKQoJaWYgZXJyICE9IG5pbCB7CgkJcmV0dXJuCgl9CgoJcmV0dXJuIHJlc3VsdC5Sb3dzQWZmZWN0ZWQoKQp9CgovLyBDb3VudCB0aGUgbnVtYmVyIG9mIHJvd3MgZnJvbSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKZnVuYyh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIENvdW50KHF1IFF1ZXJ5ZXIpIChjb3VudCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICBjb25zdCBzdG10ID0gIlNFTEVDVCBDT1VOVCgqKSBGUk9NIHt7Lk1vZGVsLlRhYmxlTmFtZX19IgogICAgcm93IDo9IHF1LlF1ZXJ5Um93KHN0bXQpCiAgICBpZiBlcnIgPSByb3cuU2NhbigmY291bnQpOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJldHVybgp9CgovLyBFeGlzdHMgY2hlY2tzIGZvciB0aGUgaXRlbXMgZXhpc3RlbmNlIGluIHRoZSBkYXRhYmFzZSwgYmFzZWQgb24gaXQncyBpZC4KLy8gQW4gZXJyb3Igd2lsbCBvbmx5IGJlIHJldHVybmVkIGlmIGEgU1FMIHJlbGF0ZWQgZmFpbHVyZSBoYXBwZW5zLgovLyBJbiBhbGwgb3RoZXIgY2FzZXMsIGEgYm9vbCBhbmQgbmlsIHdpbGwgcmV0dXJuLgpmdW5jKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRXhpc3RzKHF1IFF1ZXJ5ZXIsIGlkIGludDY0KSAoZXhpc3RzIGJvb2wsIGVyciBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9ICJTRUxFQ1QgRVhJU1RTKFNFTEVDVCAxIEZST00ge3suTW9kZWwuVGFibGVOYW1lfX0gV0hFUkUgaWQgPSA/IExJTUlUIDEpIEFTIGBleGlzdHNgIgogICAgdmFyIGNvdW50IGludAogICAgcm93IDo9IHF1LlF1ZXJ5Um93KHN0bXQsIGlkKQogICAgaWYgZXJyID0gcm93LlNjYW4oJmNvdW50KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXR1cm4gY291bnQgPiAwLCBuaWwKfQoKLy8gVGFibGVOYW1lIHJldHVybnMgdGhlIHRhYmxlIG5hbWUKZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBUYWJsZU5hbWUoKSBzdHJpbmcgewpyZXR1cm4gInt7Lk1vZGVsLlRhYmxlTmFtZX19Igp9CgovLyBTZXRMaW1pdCBzZXRzIHRoZSBxdWVyeSBsaW1pdApmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFNldExpbWl0KGxpbWl0IGludCkgKnt7Lk1vZGVsLk5hbWV9fSB7Cnt7LlJlY2VpdmVyfX0ubGltaXQgPSBsaW1pdApyZXR1cm4ge3suUmVjZWl2ZXJ9fQp9CgovLyBTZXRPZmZzZXQgc2V0cyB0aGUgcXVlcnkgb2Zmc2V0CmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgU2V0T2Zmc2V0KG9mZnNldCBpbnQpICp7ey5Nb2RlbC5OYW1lfX0gewp7ey5SZWNlaXZlcn19Lm9mZnNldCA9IG9mZnNldApyZXR1cm4ge3suUmVjZWl2ZXJ9fQp9Cnt7ZW5kfX0KCg==\"")
-	packr.PackJSONBytes("./tmpl", "tmpl.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImZtdCIKCSJodG1sL3RlbXBsYXRlIgoJInN0cmluZ3MiCikKCnZhciBGdW5jTWFwID0gdGVtcGxhdGUuRnVuY01hcHsKCSJpbnNlcnRfZmllbGRzIjogICAgICAgR2V0SW5zZXJ0RmllbGRzLAoJImluc2VydF92YWx1ZXMiOiAgICAgICBHZXRJbnNlcnRWYWx1ZXMsCgkiaW5zZXJ0X2FyZ3MiOiAgICAgICAgIEdldEluc2VydEFyZ3MsCgkic2Nhbl9maWVsZHMiOiAgICAgICAgIEdldFNjYW5GaWVsZHMsCgkidXBkYXRlX2FyZ3MiOiAgICAgICAgIEdldFVwZGF0ZUFyZ3MsCgkidXBkYXRlX3ZhbHVlcyI6ICAgICAgIEdldFVwZGF0ZVZhbHVlcywKCSJ1cHNlcnRfZmllbGRzIjogICAgICAgR2V0VXBzZXJ0RmllbGRzLAoJInVwc2VydF92YWx1ZXMiOiAgICAgICBHZXRVcHNlcnRWYWx1ZXMsCgkidXBzZXJ0X29uX2R1cGxpY2F0ZSI6IEdldFVwc2VydE9uRHVwbGljYXRlLAoJInVwc2VydF9hcmdzIjogICAgICAgICBHZXRVcHNlcnRBcmdzLAp9CgpmdW5jIEdldEluc2VydEZpZWxkcyhmaWVsZHMgW11UbXBsRmllbGQpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCWlmIGZsLkNvbHVtbk5hbWUgPT0gImlkIiB7CgkJCWNvbnRpbnVlCgkJfQoJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCAiYCIrZmwuQ29sdW1uTmFtZSsiYCIpCgl9CglyZXR1cm4gc3RyaW5ncy5Kb2luKHBhcnRzLCAiLCAiKQp9CgpmdW5jIEdldEluc2VydFZhbHVlcyhmaWVsZHMgW11UbXBsRmllbGQpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCXN3aXRjaCBmbC5Db2x1bW5OYW1lIHsKCQljYXNlICJpZCI6CgkJCWNvbnRpbnVlCgkJY2FzZSAiY3JlYXRlZF9hdCI6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCAiTk9XKCkiKQoJCQljb250aW51ZQoJCWRlZmF1bHQ6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCAiPyIpCgkJfQoJfQoJcmV0dXJuIHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikKfQoKZnVuYyBHZXRJbnNlcnRBcmdzKG0gU3RydWN0VG1wbERhdGEpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJc3dpdGNoIGZsLk5hbWUgewoJCWNhc2UgIklEIiwgIkNyZWF0ZWRBdCI6CgkJCWNvbnRpbnVlCgkJfQoJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiJXMuJXMiLCBtLlJlY2VpdmVyLCBmbC5OYW1lKSkKCX0KCWlmIGxlbihwYXJ0cykgPiAwIHsKCQlyZXR1cm4gIiwgIiArIHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikKCX0KCXJldHVybiAiIgp9CgpmdW5jIEdldFNjYW5GaWVsZHMobSBTdHJ1Y3RUbXBsRGF0YSkgdGVtcGxhdGUuSFRNTCB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJcGFydHMgPSBhcHBlbmQocGFydHMsIGZtdC5TcHJpbnRmKCImJXMuJXMiLCBtLlJlY2VpdmVyLCBmbC5OYW1lKSkKCX0KCXJldHVybiB0ZW1wbGF0ZS5IVE1MKHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikpCn0KCmZ1bmMgR2V0VXBkYXRlQXJncyhtIFN0cnVjdFRtcGxEYXRhKSB0ZW1wbGF0ZS5IVE1MIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIG0uTW9kZWwuRmllbGRzIHsKCQlzd2l0Y2ggZmwuTmFtZSB7CgkJY2FzZSAiSUQiLCAiQ3JlYXRlZEF0IiwgIlVwZGF0ZWRBdCI6CgkJCWNvbnRpbnVlCgkJfQoJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiJXMuJXMiLCBtLlJlY2VpdmVyLCBmbC5OYW1lKSkKCX0KCWlmIGxlbihwYXJ0cykgPiAwIHsKCQlyZXR1cm4gdGVtcGxhdGUuSFRNTChzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpICsgIiwgIikKCX0KCXJldHVybiAiIgp9CgpmdW5jIEdldFVwZGF0ZVZhbHVlcyhtIFN0cnVjdFRtcGxEYXRhKSBzdHJpbmcgewoJdmFyIHBhcnRzIFtdc3RyaW5nCglmb3IgXywgZmwgOj0gcmFuZ2UgbS5Nb2RlbC5GaWVsZHMgewoJCXN3aXRjaCBmbC5OYW1lIHsKCQljYXNlICJJRCIsICJDcmVhdGVkQXQiOgoJCQljb250aW51ZQoJCWNhc2UgIlVwZGF0ZWRBdCI6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiYCVzYD1VVENfVElNRVNUQU1QKCkiLCBmbC5Db2x1bW5OYW1lKSkKCQlkZWZhdWx0OgoJCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgZm10LlNwcmludGYoImAlc2A9PyIsIGZsLkNvbHVtbk5hbWUpKQoJCX0KCX0KCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpCn0KCmZ1bmMgR2V0VXBzZXJ0RmllbGRzKGZpZWxkcyBbXVRtcGxGaWVsZCkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIGZpZWxkcyB7CgkJcGFydHMgPSBhcHBlbmQocGFydHMsICJgIitmbC5Db2x1bW5OYW1lKyJgIikKCX0KCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpCn0KCmZ1bmMgR2V0VXBzZXJ0VmFsdWVzKGZpZWxkcyBbXVRtcGxGaWVsZCkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIGZpZWxkcyB7CgkJc3dpdGNoIGZsLkNvbHVtbk5hbWUgewoJCWNhc2UgImNyZWF0ZWRfYXQiOgoJCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgIk5PVygpIikKCQkJY29udGludWUKCQlkZWZhdWx0OgoJCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgIj8iKQoJCX0KCX0KCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpCn0KCmZ1bmMgR2V0VXBzZXJ0T25EdXBsaWNhdGUobSBTdHJ1Y3RUbXBsRGF0YSkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIG0uTW9kZWwuRmllbGRzIHsKCQlzd2l0Y2ggZmwuTmFtZSB7CgkJY2FzZSAiQ3JlYXRlZEF0IjoKCQkJY29udGludWUKCQljYXNlICJJRCI6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiYCVzYD1MQVNUX0lOU0VSVF9JRChgJXNgKSIsIGZsLkNvbHVtbk5hbWUsIGZsLkNvbHVtbk5hbWUpKQoJCWNhc2UgIlVwZGF0ZWRBdCI6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiYCVzYD1VVENfVElNRVNUQU1QKCkiLCBmbC5Db2x1bW5OYW1lKSkKCQlkZWZhdWx0OgoJCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgZm10LlNwcmludGYoImAlc2A9VkFMVUVTKGAlc2ApIiwgZmwuQ29sdW1uTmFtZSwgZmwuQ29sdW1uTmFtZSkpCgkJfQoJfQoJcmV0dXJuIHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikKfQoKZnVuYyBHZXRVcHNlcnRBcmdzKG0gU3RydWN0VG1wbERhdGEpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJc3dpdGNoIGZsLk5hbWUgewoJCWNhc2UgIkNyZWF0ZWRBdCI6CgkJCWNvbnRpbnVlCgkJfQoJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiJXMuJXMiLCBtLlJlY2VpdmVyLCBmbC5OYW1lKSkKCX0KCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpCn0K\"")
-	packr.PackJSONBytes("./tmpl", "types.go", "\"cGFja2FnZSB0bXBsCgovLyBUbXBsU3RydWN0cyBpcyBhIGNvbGxlY3Rpb24gb24gVG1wbFN0cnVjdAp0eXBlIFRtcGxTdHJ1Y3RzIFtdVG1wbFN0cnVjdAoKLy8gVG1wbFN0cnVjdCBkZWZpbmVzIHRoZSB0YWJsZSBkYXRhIHRvIHBhc3MgdG8gdGhlIG1vZGVscwp0eXBlIFRtcGxTdHJ1Y3Qgc3RydWN0IHsKCU5hbWUgICAgICBzdHJpbmcKCVRhYmxlTmFtZSBzdHJpbmcKCUZpZWxkcyAgICBbXVRtcGxGaWVsZAoJSW1wb3J0cyAgIG1hcFtzdHJpbmddc3RydWN0e30KfQoKLy8gVG1wbEZpZWxkIGRlZmluZXMgYSB0YWJsZSBmaWVsZCB0ZW1wbGF0ZQp0eXBlIFRtcGxGaWVsZCBzdHJ1Y3QgewoJTmFtZSAgICAgICBzdHJpbmcKCVR5cGUgICAgICAgc3RyaW5nCglDb2x1bW5OYW1lIHN0cmluZwoJTnVsbGFibGUgICBib29sCn0KCi8vIFN0cnVjdFRtcGxEYXRhIGRlZmluZXMgdGhlIHRvcCBsZXZlbCBzdHJ1Y3QgZGF0YSB0byBwYXNzIHRvIHRoZSBtb2RlbHMKdHlwZSBTdHJ1Y3RUbXBsRGF0YSBzdHJ1Y3QgewoJTW9kZWwgICAgICAgVG1wbFN0cnVjdAoJUmVjZWl2ZXIgICAgc3RyaW5nCglQYWNrYWdlTmFtZSBzdHJpbmcKfQo=\"")
-	packr.PackJSONBytes("./tmpl", "x_helpers.html", "\"e3tkZWZpbmUgImhlbHBlcnMifX0KCnBhY2thZ2Uge3sgLlBhY2thZ2VOYW1lIH19CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBDb2RlIGdlbmVyYXRlZCBieSBtb2RlbGdlbiB8CnwgICAgICAgIERPIE5PVCBFRElULiAgICAgICAgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCmltcG9ydCAoCgkiYnl0ZXMiCgkiY29udGV4dCIKCSJkYXRhYmFzZS9zcWwiCgkiZGF0YWJhc2Uvc3FsL2RyaXZlciIKCSJlbmNvZGluZy9qc29uIgoJImZtdCIKCSJsb2ciCgkicmVmbGVjdCIKCSJzdHJpbmdzIgoJInRpbWUiCgoJImdpdGh1Yi5jb20vZ28tc3FsLWRyaXZlci9teXNxbCIKKQoKLy8gU3RkVGltZSBwcm92aWRlcyBkZWZhdWx0IFNRTCBUSU1FIGZvcm1hdApjb25zdCBTdGRUaW1lID0gIjE1OjA0OjA1IgoKLy8gZW1wdHlUaW1lIGFsbG93cyBkZWZhdWx0IHRpbWVzIHRvIGJlIGNvbnNpZGVyZWQKLy8gbnVsbCBmb3IgaW5zZXJ0aW9uIGludG8gdGhlIGRhdGFiYXNlLgp2YXIgZW1wdHlUaW1lID0gdGltZS5UaW1le30KCi8vIG51bGxMaXRlcmFsIGlzIGhlbHBmdWwgZm9yIGNoZWNraW5nCi8vIGZvciBudWxscywgYXMgdGhleSB3b24ndCBjYXVzZSBlcnJvcnMsCi8vIHlldCB3ZSBuZWVkIHRoZSBjb250ZW50IG9mIHRoZSBmaWxlIHRvIGNoYW5nZSBhbnl3YXkKdmFyIG51bGxMaXRlcmFsID0gW11ieXRlKCJudWxsIikKCi8qKioqKioqKgoqIFR5cGVzICoKKioqKioqKiovCgovLyBRdWVyeWVyIGFsbG93cyBzcWwuREIgYW5kIHNxbC5UeCB0byBiZSB1c2VkIGludGVyY2hhbmdlYWJseSwgYWxsb3dpbmcgeW91Ci8vIHRvIHVzZSBhbnkgb2YgdGhlIG1vZGVsIG1ldGhvZHMgaW5zaWRlIHRyYW5zYWN0aW9ucyBvciBzdGFuZGFsb25lIGNhbGxzLgp0eXBlIFF1ZXJ5ZXIgaW50ZXJmYWNlIHsKCVF1ZXJ5KHF1ZXJ5IHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgKCpzcWwuUm93cywgZXJyb3IpCglRdWVyeVJvdyhxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pICpzcWwuUm93CglFeGVjKHF1ZXJ5IHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgKHNxbC5SZXN1bHQsIGVycm9yKQp9CgovKi0tLS0tLS0tLS0tLS0rCnwgVHlwZSBhbGlhc2VzIHwKKy0tLS0tLS0tLS0tLS0qLwoKLy8gTnVsbEZsb2F0NjQgYWxpYXNlcyBzcWwuTnVsbEZsb2F0NjQKdHlwZSBOdWxsRmxvYXQ2NCBzcWwuTnVsbEZsb2F0NjQKCi8vIE51bGxTdHJpbmcgYWxpYXNlcyBzcWwuTnVsbFN0cmluZwp0eXBlIE51bGxTdHJpbmcgc3FsLk51bGxTdHJpbmcKCi8vIE51bGxCb29sIGFsaWFzZXMgc3FsLk51bGxCb29sCnR5cGUgTnVsbEJvb2wgc3FsLk51bGxCb29sCgovLyBOdWxsSW50NjQgYWxpYXNlcyBzcWwuTnVsbEludDY0CnR5cGUgTnVsbEludDY0IHNxbC5OdWxsSW50NjQKCi8vIE51bGxUaW1lIGFsaWFzZXMgc3FsLk51bGxUaW1lCnR5cGUgTnVsbFRpbWUgbXlzcWwuTnVsbFRpbWUKCi8vIFJhd0pTT04gYWxpYXNlcyBqc29uLlJhd01lc3NhZ2UKdHlwZSBSYXdKU09OIGpzb24uUmF3TWVzc2FnZQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgTnVsbFN0cmluZyBpbXBsZW1lbnRhdGlvbnMgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIE1hcnNoYWxKU09OIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gTnVsbFN0cmluZykgTWFyc2hhbEpTT04oKSAoW11ieXRlLCBlcnJvcikgewoJdmFyIGEgKnN0cmluZwoJaWYgbi5WYWxpZCB7CgkJYSA9ICZuLlN0cmluZwoJfQoJcmV0dXJuIGpzb24uTWFyc2hhbChhKQp9CgovLyBVbm1hcnNoYWxKU09OIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gKk51bGxTdHJpbmcpIFVubWFyc2hhbEpTT04oYiBbXWJ5dGUpIGVycm9yIHsKCWlmIGJ5dGVzLkVxdWFsRm9sZChiLCBudWxsTGl0ZXJhbCkgewoJCW4uVmFsaWQgPSBmYWxzZQoJCXJldHVybiBuaWwKCX0KCWVyciA6PSBqc29uLlVubWFyc2hhbChiLCAmbi5TdHJpbmcpCgluLlZhbGlkID0gZXJyID09IG5pbAoJcmV0dXJuIGVycgp9CgovLyBWYWx1ZSBmb3IgTnVsbFN0cmluZwpmdW5jIChuIE51bGxTdHJpbmcpIFZhbHVlKCkgKGRyaXZlci5WYWx1ZSwgZXJyb3IpIHsKCWlmICFuLlZhbGlkIHsKCQlyZXR1cm4gbmlsLCBuaWwKCX0KCXJldHVybiBuLlN0cmluZywgbmlsCn0KCi8vIFNjYW4gZm9yIE51bGxTdHJpbmcKZnVuYyAobiAqTnVsbFN0cmluZykgU2NhbihzcmMgaW50ZXJmYWNle30pIGVycm9yIHsKCXZhciBhIHNxbC5OdWxsU3RyaW5nCglpZiBlcnIgOj0gYS5TY2FuKHNyYyk7IGVyciAhPSBuaWwgewoJCXJldHVybiBlcnIKCX0KCW4uU3RyaW5nID0gYS5TdHJpbmcKCWlmIHJlZmxlY3QuVHlwZU9mKHNyYykgIT0gbmlsIHsKCQluLlZhbGlkID0gdHJ1ZQoJfQoJcmV0dXJuIG5pbAp9CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgTnVsbEZsb2F0NjQgaW1wbGVtZW50YXRpb25zIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKLy8gTWFyc2hhbEpTT04gZm9yIE51bGxGbG9hdDY0CmZ1bmMgKG4gTnVsbEZsb2F0NjQpIE1hcnNoYWxKU09OKCkgKFtdYnl0ZSwgZXJyb3IpIHsKCXZhciBhICpmbG9hdDY0CglpZiBuLlZhbGlkIHsKCQlhID0gJm4uRmxvYXQ2NAoJfQoJcmV0dXJuIGpzb24uTWFyc2hhbChhKQp9CgovLyBWYWx1ZSBmb3IgTnVsbEZsb2F0NjQKZnVuYyAobiBOdWxsRmxvYXQ2NCkgVmFsdWUoKSAoZHJpdmVyLlZhbHVlLCBlcnJvcikgewoJaWYgIW4uVmFsaWQgewoJCXJldHVybiBuaWwsIG5pbAoJfQoJcmV0dXJuIG4uRmxvYXQ2NCwgbmlsCn0KCi8vIFVubWFyc2hhbEpTT04gZm9yIE51bGxGbG9hdDY0CmZ1bmMgKG4gKk51bGxGbG9hdDY0KSBVbm1hcnNoYWxKU09OKGIgW11ieXRlKSBlcnJvciB7CglpZiBieXRlcy5FcXVhbEZvbGQoYiwgbnVsbExpdGVyYWwpIHsKCQluLlZhbGlkID0gZmFsc2UKCQlyZXR1cm4gbmlsCgl9CgllcnIgOj0ganNvbi5Vbm1hcnNoYWwoYiwgJm4uRmxvYXQ2NCkKCW4uVmFsaWQgPSBlcnIgPT0gbmlsCglyZXR1cm4gZXJyCn0KCi8vIFNjYW4gZm9yIE51bGxGbG9hdDY0CmZ1bmMgKG4gKk51bGxGbG9hdDY0KSBTY2FuKHNyYyBpbnRlcmZhY2V7fSkgZXJyb3IgewoJdmFyIGEgc3FsLk51bGxGbG9hdDY0CglpZiBlcnIgOj0gYS5TY2FuKHNyYyk7IGVyciAhPSBuaWwgewoJCXJldHVybiBlcnIKCX0KCW4uRmxvYXQ2NCA9IGEuRmxvYXQ2NAoJaWYgcmVmbGVjdC5UeXBlT2Yoc3JjKSAhPSBuaWwgewoJCW4uVmFsaWQgPSB0cnVlCgl9CglyZXR1cm4gbmlsCn0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgTnVsbEludDY0IGltcGxlbWVudGF0aW9ucyB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgovLyBNYXJzaGFsSlNPTiBmb3IgTnVsbEludDY0CmZ1bmMgKG4gTnVsbEludDY0KSBNYXJzaGFsSlNPTigpIChbXWJ5dGUsIGVycm9yKSB7Cgl2YXIgYSAqaW50NjQKCWlmIG4uVmFsaWQgewoJCWEgPSAmbi5JbnQ2NAoJfQoJcmV0dXJuIGpzb24uTWFyc2hhbChhKQp9CgovLyBWYWx1ZSBmb3IgTnVsbEludDY0CmZ1bmMgKG4gTnVsbEludDY0KSBWYWx1ZSgpIChkcml2ZXIuVmFsdWUsIGVycm9yKSB7CglpZiAhbi5WYWxpZCB7CgkJcmV0dXJuIG5pbCwgbmlsCgl9CglyZXR1cm4gbi5JbnQ2NCwgbmlsCn0KCi8vIFVubWFyc2hhbEpTT04gZm9yIE51bGxJbnQ2NApmdW5jIChuICpOdWxsSW50NjQpIFVubWFyc2hhbEpTT04oYiBbXWJ5dGUpIGVycm9yIHsKCWlmIGJ5dGVzLkVxdWFsRm9sZChiLCBudWxsTGl0ZXJhbCkgewoJCW4uVmFsaWQgPSBmYWxzZQoJCXJldHVybiBuaWwKCX0KCWVyciA6PSBqc29uLlVubWFyc2hhbChiLCAmbi5JbnQ2NCkKCW4uVmFsaWQgPSBlcnIgPT0gbmlsCglyZXR1cm4gZXJyCn0KCi8vIFNjYW4gZm9yIE51bGxJbnQ2NApmdW5jIChuICpOdWxsSW50NjQpIFNjYW4oc3JjIGludGVyZmFjZXt9KSBlcnJvciB7CgkvLyBTZXQgaW5pdGlhbCBzdGF0ZSBmb3Igc3Vic2VxdWVudCBzY2Fucy4KCW4uVmFsaWQgPSBmYWxzZQoKCXZhciBhIHNxbC5OdWxsSW50NjQKCWlmIGVyciA6PSBhLlNjYW4oc3JjKTsgZXJyICE9IG5pbCB7CgkJcmV0dXJuIGVycgoJfQoJbi5JbnQ2NCA9IGEuSW50NjQKCWlmIHJlZmxlY3QuVHlwZU9mKHNyYykgIT0gbmlsIHsKCQluLlZhbGlkID0gdHJ1ZQoJfQoJcmV0dXJuIG5pbAp9CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgTnVsbEJvb2wgaW1wbGVtZW50YXRpb25zIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKLy8gTWFyc2hhbEpTT04gZm9yIE51bGxCb29sCmZ1bmMgKG4gTnVsbEJvb2wpIE1hcnNoYWxKU09OKCkgKFtdYnl0ZSwgZXJyb3IpIHsKCXZhciBhICpib29sCglpZiBuLlZhbGlkIHsKCQlhID0gJm4uQm9vbAoJfQoJcmV0dXJuIGpzb24uTWFyc2hhbChhKQp9CgovLyBWYWx1ZSBmb3IgTnVsbEJvb2wKZnVuYyAobiBOdWxsQm9vbCkgVmFsdWUoKSAoZHJpdmVyLlZhbHVlLCBlcnJvcikgewoJaWYgIW4uVmFsaWQgewoJCXJldHVybiBuaWwsIG5pbAoJfQoJcmV0dXJuIG4uQm9vbCwgbmlsCn0KCi8vIFVubWFyc2hhbEpTT04gZm9yIE51bGxCb29sCmZ1bmMgKG4gKk51bGxCb29sKSBVbm1hcnNoYWxKU09OKGIgW11ieXRlKSBlcnJvciB7Cgl2YXIgZmllbGQgKmJvb2wKCWVyciA6PSBqc29uLlVubWFyc2hhbChiLCAmZmllbGQpCglpZiBmaWVsZCAhPSBuaWwgewoJCW4uVmFsaWQgPSB0cnVlCgkJbi5Cb29sID0gKmZpZWxkCgl9CglyZXR1cm4gZXJyCn0KCi8vIFNjYW4gZm9yIE51bGxCb29sCmZ1bmMgKG4gKk51bGxCb29sKSBTY2FuKHNyYyBpbnRlcmZhY2V7fSkgZXJyb3IgewoJdmFyIGEgc3FsLk51bGxCb29sCglpZiBlcnIgOj0gYS5TY2FuKHNyYyk7IGVyciAhPSBuaWwgewoJCXJldHVybiBlcnIKCX0KCW4uQm9vbCA9IGEuQm9vbAoJaWYgcmVmbGVjdC5UeXBlT2Yoc3JjKSAhPSBuaWwgewoJCW4uVmFsaWQgPSB0cnVlCgl9CglyZXR1cm4gbmlsCn0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBOdWxsVGltZSBpbXBsZW1lbnRhdGlvbnMgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgovLyBNYXJzaGFsSlNPTiBmb3IgTnVsbFRpbWUKZnVuYyAobiBOdWxsVGltZSkgTWFyc2hhbEpTT04oKSAoW11ieXRlLCBlcnJvcikgewoJdmFyIGEgKnRpbWUuVGltZQoJaWYgbi5WYWxpZCB7CgkJYSA9ICZuLlRpbWUKCX0KCXJldHVybiBqc29uLk1hcnNoYWwoYSkKfQoKLy8gVmFsdWUgZm9yIE51bGxUaW1lCmZ1bmMgKG4gTnVsbFRpbWUpIFZhbHVlKCkgKGRyaXZlci5WYWx1ZSwgZXJyb3IpIHsKCWlmICFuLlZhbGlkIHsKCQlyZXR1cm4gbmlsLCBuaWwKCX0KCXJldHVybiBuLlRpbWUsIG5pbAp9CgovLyBVbm1hcnNoYWxKU09OIGZvciBOdWxsVGltZQpmdW5jIChuICpOdWxsVGltZSkgVW5tYXJzaGFsSlNPTihiIFtdYnl0ZSkgZXJyb3IgewogICAgcyA6PSBzdHJpbmcoYikKICAgIHMgPSBzdHJpbmdzLlRyaW0ocywgYCJgKQoKICAgIHZhciAoCiAgICAgICAgemVyb1RpbWUgdGltZS5UaW1lCiAgICAgICAgdGltICAgICAgdGltZS5UaW1lCiAgICAgICAgZXJyICAgICAgZXJyb3IKICAgICkKCiAgICBpZiBzdHJpbmdzLkVxdWFsRm9sZChzLCAibnVsbCIpIHsKICAgICAgICByZXR1cm4gbmlsCiAgICB9CgogICAgaWYgdGltLCBlcnIgPSB0aW1lLlBhcnNlKHRpbWUuUkZDMzMzOSwgcyk7IGVyciAhPSBuaWwgewogICAgICAgIG4uVmFsaWQgPSBmYWxzZQogICAgICAgIHJldHVybiBlcnIKICAgIH0KCiAgICBpZiB0aW0gPT0gemVyb1RpbWUgewogICAgICAgIHJldHVybiBuaWwKICAgIH0KCiAgICBuLlRpbWUgPSB0aW0KICAgIG4uVmFsaWQgPSB0cnVlCiAgICByZXR1cm4gbmlsCn0KCi8vIFNjYW4gZm9yIE51bGxUaW1lCmZ1bmMgKG4gKk51bGxUaW1lKSBTY2FuKHNyYyBpbnRlcmZhY2V7fSkgZXJyb3IgewoJLy8gU2V0IGluaXRpYWwgc3RhdGUgZm9yIHN1YnNlcXVlbnQgc2NhbnMuCgluLlZhbGlkID0gZmFsc2UKCgl2YXIgYSBteXNxbC5OdWxsVGltZQoJaWYgZXJyIDo9IGEuU2NhbihzcmMpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgluLlRpbWUgPSBhLlRpbWUKCWlmIHJlZmxlY3QuVHlwZU9mKHNyYykgIT0gbmlsIHsKCQluLlZhbGlkID0gdHJ1ZQoJfQoJcmV0dXJuIG5pbAp9CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBSYXdKU09OIGltcGxlbWVudGF0aW9ucyB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKLy8gTWFyc2hhbEpTT04gZm9yIE51bGxTdHJpbmcKZnVuYyAobiBSYXdKU09OKSBNYXJzaGFsSlNPTigpIChbXWJ5dGUsIGVycm9yKSB7CglpZiBsZW4obikgPT0gMCB7CgkJcmV0dXJuIFtdYnl0ZSgibnVsbCIpLCBuaWwKCX0KCWEgOj0ganNvbi5SYXdNZXNzYWdlKG4pCglyZXR1cm4gYS5NYXJzaGFsSlNPTigpCn0KCi8vIFZhbHVlIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gUmF3SlNPTikgVmFsdWUoKSAoZHJpdmVyLlZhbHVlLCBlcnJvcikgewoJcmV0dXJuIHN0cmluZyhuKSwgbmlsCn0KCi8vIFVubWFyc2hhbEpTT04gZm9yIE51bGxTdHJpbmcKZnVuYyAobiAqUmF3SlNPTikgVW5tYXJzaGFsSlNPTihiIFtdYnl0ZSkgZXJyb3IgewoJdmFyIGEganNvbi5SYXdNZXNzYWdlCglpZiBlcnIgOj0ganNvbi5Vbm1hcnNoYWwoYiwgJmEpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgljIDo9IFJhd0pTT04oYSkKCSpuID0gYwoJcmV0dXJuIG5pbAp9CgovLyBTY2FuIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gKlJhd0pTT04pIFNjYW4oc3JjIGludGVyZmFjZXt9KSBlcnJvciB7Cgl2YXIgYSBzcWwuTnVsbFN0cmluZwoJaWYgZXJyIDo9IGEuU2NhbihzcmMpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9Cglqc24gOj0gUmF3SlNPTihbXWJ5dGUoYS5TdHJpbmcpKQoJKm4gPSBqc24KCXJldHVybiBuaWwKfQoKLyotLS0tLS0tLS0tLS0tLS0tLSsKfCBIZWxwZXIgZnVuY3Rpb25zIHwKKy0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIFRvTnVsbFN0cmluZyByZXR1cm5zIGEgbmV3IE51bGxTdHJpbmcKZnVuYyBUb051bGxTdHJpbmcocyAqc3RyaW5nKSBOdWxsU3RyaW5nIHsKCWlmIHMgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbFN0cmluZyhzcWwuTnVsbFN0cmluZ3tWYWxpZDogZmFsc2V9KQoJfQoJcmV0dXJuIE51bGxTdHJpbmcoc3FsLk51bGxTdHJpbmd7U3RyaW5nOiAqcywgVmFsaWQ6IHRydWV9KQp9CgovLyBUb051bGxJbnQ2NCByZXR1cm5zIGEgbmV3IE51bGxJbnQ2NApmdW5jIFRvTnVsbEludDY0KGkgKmludDY0KSBOdWxsSW50NjQgewoJaWYgaSA9PSBuaWwgewoJCXJldHVybiBOdWxsSW50NjQoc3FsLk51bGxJbnQ2NHtWYWxpZDogZmFsc2V9KQoJfQoJcmV0dXJuIE51bGxJbnQ2NChzcWwuTnVsbEludDY0e0ludDY0OiAqaSwgVmFsaWQ6IHRydWV9KQp9CgovLyBUb051bGxGbG9hdDY0IHJldHVybnMgYSBuZXcgTnVsbEZsb2F0NjQKZnVuYyBUb051bGxGbG9hdDY0KGkgKmZsb2F0NjQpIE51bGxGbG9hdDY0IHsKCWlmIGkgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbEZsb2F0NjQoc3FsLk51bGxGbG9hdDY0e1ZhbGlkOiBmYWxzZX0pCgl9CglyZXR1cm4gTnVsbEZsb2F0NjQoc3FsLk51bGxGbG9hdDY0e0Zsb2F0NjQ6ICppLCBWYWxpZDogdHJ1ZX0pCn0KCi8vIFRvTnVsbEJvb2wgY3JlYXRlcyBhIG5ldyBOdWxsQm9vbApmdW5jIFRvTnVsbEJvb2woYiAqYm9vbCkgTnVsbEJvb2wgewoJaWYgYiA9PSBuaWwgewoJCXJldHVybiBOdWxsQm9vbChzcWwuTnVsbEJvb2x7VmFsaWQ6IGZhbHNlfSkKCX0KCXJldHVybiBOdWxsQm9vbChzcWwuTnVsbEJvb2x7Qm9vbDogKmIsIFZhbGlkOiB0cnVlfSkKfQoKLy8gVG9OdWxsVGltZSBjcmVhdGVzIGEgbmV3IE51bGxUaW1lCmZ1bmMgVG9OdWxsVGltZSh0IHRpbWUuVGltZSkgTnVsbFRpbWUgewoJaWYgdCA9PSBlbXB0eVRpbWUgewoJCXJldHVybiBOdWxsVGltZShteXNxbC5OdWxsVGltZXtWYWxpZDogZmFsc2V9KQoJfQoJcmV0dXJuIE51bGxUaW1lKG15c3FsLk51bGxUaW1le1RpbWU6IHQsIFZhbGlkOiB0cnVlfSkKfQoKLy8gVHhPcHRpb25zIGRlZmluZXMgYW4gb3B0aW9uIHR5cGUgZm9yIGNvbmZpZ3VyaW5nCi8vIHRyYW5zYXRpb25zLiBUaGlzIG1heSBvbmx5IGJlIHVzZWQgd2l0aCB0aGUgRXhlY3V0ZVRyYW5zYWN0aW9uIHdyYXBwZXIuCnR5cGUgVHhPcHRpb25zIHN0cnVjdCB7CglUaW1lb3V0ICAgdGltZS5EdXJhdGlvbgoJSXNvbGF0aW9uIHNxbC5Jc29sYXRpb25MZXZlbAoJUmVhZE9ubHkgIGJvb2wKfQoKLy8gRXhlY3V0ZVRyYW5zYWN0aW9uIGNsb3NlcyBvdmVyIGEgdHJhbnNhY3Rpb24gYW5kIGF1dG9tYXRpY2FsbHkgY29tbWl0cwovLyBvciByb2xsYmFja3MgZGVwZW5kaW5nIG9uIHdoZXRoZXIgZXJyb3JzIHdlcmUgZW5jb3VudGVyZWQuCi8vIEluIHRoZSBjYXNlIHdoZXJlIG5pbCBpcyBwYXNzZWQgZm9yIG9wdCAoKlR4T3B0aW9uKSwgdGhlIGZvbGxvd2luZyBkZWZhdWx0cyBhcmUgdXNlZDoKLy8gICZUeE9wdGlvbnN7Ci8vICAJVGltZW91dDogICA1ICogdGltZS5TZWNvbmQsCi8vICAJSXNvbGF0aW9uOiBzcWwuTGV2ZWxTZXJpYWxpemFibGUsCi8vICAJUmVhZE9ubHk6ICBmYWxzZSwKLy8gIH0KZnVuYyBFeGVjdXRlVHJhbnNhY3Rpb24oZGIgKnNxbC5EQiwgb3B0ICpUeE9wdGlvbnMsIGFjdGlvbnMgZnVuYygqc3FsLlR4KSBlcnJvcikgKGVyciBlcnJvcikgewoJLy8gUHJvdmlkZSBzYWZlIGRlZmF1bHRzIGluIGNhc2Ugbm9uZSB3ZXJlIGdpdmVuLgoJaWYgb3B0ID09IG5pbCB7CgkJb3B0ID0gJlR4T3B0aW9uc3sKCQkJVGltZW91dDogICA1ICogdGltZS5TZWNvbmQsCgkJCUlzb2xhdGlvbjogc3FsLkxldmVsU2VyaWFsaXphYmxlLAoJCQlSZWFkT25seTogIGZhbHNlLAoJCX0KCX0KCgkvLyBCdWlsZCB0aGUgY29udGV4dCB3aXRoIHRoZSBwcm92aWRlZCB0aW1lb3V0LgoJLy8gVGhpcyB3aWxsIGJlIHVzZWQgdG8gZGVmaW5lIHRoZSB0b3RhbCB0aW1lIHRoZSB0cmFuc2FjdGlvbiBtYXkgdGFrZSwKCS8vIHBhc3QgdGhpcyB0aW1lLCBpdCB3aWxsIGJlIGNhbmNlbGxlZCwgcm9sbGJhY2ssIHRoZW4gdGhyb3cgYW4gZXJyb3IuCgljdHgsIGNhbmNlbCA6PSBjb250ZXh0LldpdGhUaW1lb3V0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBvcHQuVGltZW91dCkKCWRlZmVyIGNhbmNlbCgpCgoJdmFyIHR4ICpzcWwuVHgKCWlmIHR4LCBlcnIgPSBkYi5CZWdpblR4KGN0eCwgJnNxbC5UeE9wdGlvbnN7CgkJSXNvbGF0aW9uOiBvcHQuSXNvbGF0aW9uLAoJCVJlYWRPbmx5OiAgb3B0LlJlYWRPbmx5LAoJfSk7IGVyciAhPSBuaWwgewoJCXJldHVybiBlcnIKCX0KCglkZWZlciBmdW5jKCkgewoJCWlmIHIgOj0gcmVjb3ZlcigpOyByICE9IG5pbCB7CgkJCS8vIE9ubHkgbmVlZCB0byBsb2cgaGVyZSBiZWNhdXNlIHBhbmljIHdvbid0IHJlcG9ydCB3aGV0aGVyCgkJCS8vIHRoZSByb2xsYmFjayB3YXMgc3VjY2Vzc2Z1bCBvciBub3QuCgkJCWlmIHR4ZXJyIDo9IHR4LlJvbGxiYWNrKCk7IHR4ZXJyICE9IG5pbCB7CgkJCQlsb2cuUHJpbnRsbigiZGIgcm9sbGJhY2sgZXJyb3I6IiwgdHhlcnIpCgkJCX0KCgkJCWxvZy5QcmludGYoInJvbGxlZCBiYWNrIHRyYW5zYWN0aW9uIikKCQkJcGFuaWMocikKCQl9IGVsc2UgaWYgZXJyICE9IG5pbCB7CgkJCS8vIElmIHdlIHJ1biBpbnRvIGlzc3VlcyByb2xsaW5nIGJhY2ssIGtlZXAgdHJhY2sgb2YgdGhlIGVycm9yIHRoYXQKCQkJLy8gY2F1c2VkIHRoZSBpc3N1ZSBhbmQgcHJvdmlkZSBzb21lIGNvbnRleHQgb24gdGhlIHJvbGxiYWNrIGZhaWx1cmUuCgkJCWlmIHJlcnIgOj0gdHguUm9sbGJhY2soKTsgcmVyciAhPSBuaWwgewoJCQkJZXJyID0gZm10LkVycm9yZigiZGIgZXJyb3I6ICV2IHJvbGxiYWNrIGVycm9yOiAldiIsIGVyciwgcmVycikKCQkJfQoJCX0gZWxzZSB7CgkJCWlmIGNlcnIgOj0gdHguQ29tbWl0KCk7IGNlcnIgIT0gbmlsIHsKCQkJCWVyciA9IGZtdC5FcnJvcmYoImNvbW1pdCBlcnJvcjogJXYiLCBjZXJyKQoJCQl9CgkJfQoJfSgpCgoJZXJyID0gYWN0aW9ucyh0eCkKCXJldHVybiBlcnIKfQp7e2VuZH19Cg==\"")
-	packr.PackJSONBytes("./tmpl", "x_helpers_test.html", "\"e3tkZWZpbmUgImhlbHBlcnN0ZXN0In19Ci8vK2J1aWxkICFoZWxwZXJzCgpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImRhdGFiYXNlL3NxbC9kcml2ZXIiCgkiZW5jb2RpbmcvanNvbiIKCSJyZWZsZWN0IgoJInRlc3RpbmciCgkidGltZSIKKQoKZnVuYyBUZXN0U3RydWN0RW1iZWRkaW5nKHQgKnRlc3RpbmcuVCkgewoJdGltIDo9IHRpbWUuRGF0ZSgyMDE3LCAxLCAxLCAwLCAwLCAwLCAwLCB0aW1lLlVUQykKCWV4cGVjdGVkIDo9IFtdYnl0ZShgeyJhIjoxMjMsImIiOnRydWUsImMiOjEyMy4xMjMsImQiOiJzdHJpbmciLCJlIjoiMjAxNy0wMS0wMVQwMDowMDowMFoiLCJmIjpbMSwyLDNdfWApCgl0eXBlIGVtYmVkIHN0cnVjdCB7CgkJQSBOdWxsSW50NjQgICBganNvbjoiYSxvbWl0ZW1wdHkiYAoJCUIgTnVsbEJvb2wgICAgYGpzb246ImIsb21pdGVtcHR5ImAKCQlDIE51bGxGbG9hdDY0IGBqc29uOiJjLG9taXRlbXB0eSJgCgkJRCBOdWxsU3RyaW5nICBganNvbjoiZCxvbWl0ZW1wdHkiYAoJCUUgTnVsbFRpbWUgICAgYGpzb246ImUsb21pdGVtcHR5ImAKCQlGIFJhd0pTT04gICAgIGBqc29uOiJmLG9taXRlbXB0eSJgCgl9CgllbSA6PSBlbWJlZHsKCQlBOiBOdWxsSW50NjR7VmFsaWQ6IHRydWUsIEludDY0OiAxMjN9LAoJCUI6IE51bGxCb29se1ZhbGlkOiB0cnVlLCBCb29sOiB0cnVlfSwKCQlDOiBOdWxsRmxvYXQ2NHtWYWxpZDogdHJ1ZSwgRmxvYXQ2NDogMTIzLjEyM30sCgkJRDogTnVsbFN0cmluZ3tWYWxpZDogdHJ1ZSwgU3RyaW5nOiAic3RyaW5nIn0sCgkJRTogTnVsbFRpbWV7VmFsaWQ6IHRydWUsIFRpbWU6IHRpbX0sCgkJRjogUmF3SlNPTihgWzEsMiwzXWApLAoJfQoJYiwgZXJyIDo9IGpzb24uTWFyc2hhbChlbSkKCWlmIGVyciAhPSBuaWwgewoJCXQuRmF0YWwoZXJyKQoJfQoJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGV4cGVjdGVkLCBiKSB7CgkJdC5GYXRhbCgibm90IHRoZSBzYW1lIEpTT04hIikKCX0KCWlmICEoc3RyaW5nKGIpID09IHN0cmluZyhleHBlY3RlZCkpIHsKCQl0LkZhdGFsKCJub3QgdGhlIHNhbWUhIikKCX0KCgl2YXIgZW0yIGVtYmVkCglpZiBlcnIgOj0ganNvbi5Vbm1hcnNoYWwoZXhwZWN0ZWQsICZlbTIpOyBlcnIgIT0gbmlsIHsKCQl0LkZhdGFsKGVycikKCX0KCWlmICFyZWZsZWN0LkRlZXBFcXVhbChlbTIsIGVtKSB7CgkJdC5GYXRhbCgibm90IGNvcnJlY3QiKQoJfQp9CgpmdW5jIFRlc3ROdWxsU3RyaW5nX1VubWFyc2hhbEpTT04odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgIE51bGxTdHJpbmcKCQlzb3VyY2UgIFtdYnl0ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICAgICJleHBsaWNpdCBudWxsIiwKCQkJc291cmNlOiAgW11ieXRlKGBudWxsYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAgICAic3RyaW5nIG51bGwiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYCJudWxsImApLAoJCQl3YW50RXJyOiBmYWxzZSwgLy8gdGhpcyBvbmUgU0hPVUxEIGJlIHZhbGlkCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJ2YWxpZCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgImhlbGxvImApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImludmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYHsia2V5IjoidmFsdWUifWApLAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiZW1wdHkiLAoJCQlzb3VyY2U6ICBbXWJ5dGV7fSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5Vbm1hcnNoYWxKU09OKHR0LnNvdXJjZSk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsU3RyaW5nLlVubWFyc2hhbEpTT04oKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbFN0cmluZ19WYWx1ZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbFN0cmluZwoJCXdhbnQgICAgZHJpdmVyLlZhbHVlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogTnVsbFN0cmluZ3sKCQkJCVZhbGlkOiAgdHJ1ZSwKCQkJCVN0cmluZzogImhlbGxvIiwKCQkJfSwKCQkJd2FudDogICAgZHJpdmVyLlZhbHVlKCJoZWxsbyIpLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogImludmFsaWQiLAoJCQluOiBOdWxsU3RyaW5newoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBuaWwsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uVmFsdWUoKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFN0cmluZy5WYWx1ZSgpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbFN0cmluZy5WYWx1ZSgpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsU3RyaW5nX1NjYW4odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsU3RyaW5nCgkJd2FudEVyciBib29sCgkJc3JjICAgICBpbnRlcmZhY2V7fQoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46ICZOdWxsU3RyaW5newoJCQkJU3RyaW5nOiAiaGVsbG8iLAoJCQkJVmFsaWQ6ICB0cnVlLAoJCQl9LAoJCQlzcmM6ICAgICAiIiwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJuaWwgdmFsdWUiLAoJCQluOiAmTnVsbFN0cmluZ3sKCQkJCVN0cmluZzogImhlbGxvIiwKCQkJCVZhbGlkOiAgZmFsc2UsCgkJCX0sCgkJCXNyYzogICAgIG5pbCwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uU2Nhbih0dC5zcmMpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFN0cmluZy5TY2FuKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCQlpZiAhdHQubi5WYWxpZCAmJiB0dC5zcmMgIT0gbmlsIHsKCQkJCXQuRXJyb3JmKCJzaG91bGQgcmV0dXJuIG51bGwiKQoJCQl9CgkJCWlmIHR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IHR0Lm4uU3RyaW5nIHsKCQkJCXQuRXJyb3JmKCJpbnZhbGlkIHZhbHVlIikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxTdHJpbmdfTWFyc2hhbEpTT04odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsU3RyaW5nCgkJd2FudCAgICBbXWJ5dGUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbFN0cmluZ3sKCQkJCVN0cmluZzogImhlbGxvIiwKCQkJCVZhbGlkOiAgdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGAiaGVsbG8iYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAidmFsaWQgbnVsbCIsCgkJCW46ICZOdWxsU3RyaW5newoJCQkJU3RyaW5nOiAiIiwKCQkJCVZhbGlkOiAgZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogImludmFsaWQiLAoJCQluOiAmTnVsbFN0cmluZ3sKCQkJCVZhbGlkOiB0cnVlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYCIiYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uTWFyc2hhbEpTT04oKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFN0cmluZy5NYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbFN0cmluZy5NYXJzaGFsSlNPTigpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsQm9vbF9Vbm1hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgICAgICBzdHJpbmcKCQluICAgICAgICAgICAgTnVsbEJvb2wKCQlzb3VyY2UgICAgICAgW11ieXRlCgkJd2FudEVyciAgICAgIGJvb2wKCQl3YW50VmFsaWRpdHkgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICAgICAgICAgImV4cGxpY2l0IG51bGwiLAoJCQlzb3VyY2U6ICAgICAgIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiAgICAgIGZhbHNlLAoJCQl3YW50VmFsaWRpdHk6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAgICAgICAgICJ2YWxpZCIsCgkJCXNvdXJjZTogICAgICAgW11ieXRlKGBmYWxzZWApLAoJCQl3YW50RXJyOiAgICAgIGZhbHNlLAoJCQl3YW50VmFsaWRpdHk6IHRydWUsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICAgICAgImludmFsaWQiLAoJCQlzb3VyY2U6ICAgICAgIFtdYnl0ZShgeyJrZXkiOiJ2YWx1ZSJ9YCksCgkJCXdhbnRFcnI6ICAgICAgdHJ1ZSwKCQkJd2FudFZhbGlkaXR5OiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgICAgICAiZW1wdHkiLAoJCQlzb3VyY2U6ICAgICAgIFtdYnl0ZXt9LAoJCQl3YW50RXJyOiAgICAgIHRydWUsCgkJCXdhbnRWYWxpZGl0eTogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICAgICAgImV4cGxpY2l0IG51bGwiLAoJCQlzb3VyY2U6ICAgICAgIFtdYnl0ZSgibnVsbCIpLAoJCQl3YW50RXJyOiAgICAgIGZhbHNlLAoJCQl3YW50VmFsaWRpdHk6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWlmIGVyciA6PSB0dC5uLlVubWFyc2hhbEpTT04odHQuc291cmNlKTsgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgJiYgdHQubi5WYWxpZCA9PSB0dC53YW50VmFsaWRpdHkgewoJCQkJdC5FcnJvcmYoIk51bGxCb29sLlVubWFyc2hhbEpTT04oKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbEJvb2xfVmFsdWUodCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgIE51bGxCb29sCgkJd2FudCAgICBkcml2ZXIuVmFsdWUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiBOdWxsQm9vbHsKCQkJCVZhbGlkOiB0cnVlLAoJCQkJQm9vbDogIHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIGRyaXZlci5WYWx1ZSh0cnVlKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogTnVsbEJvb2x7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIG5pbCwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJZ290LCBlcnIgOj0gdHQubi5WYWx1ZSgpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsQm9vbC5WYWx1ZSgpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbEJvb2wuVmFsdWUoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbEJvb2xfU2Nhbih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgKk51bGxCb29sCgkJd2FudEVyciBib29sCgkJc3JjICAgICBpbnRlcmZhY2V7fQoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46ICZOdWxsQm9vbHsKCQkJCUJvb2w6ICB0cnVlLAoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXNyYzogICAgIHRydWUsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAibmlsIHZhbHVlIiwKCQkJbjogJk51bGxCb29sewoJCQkJQm9vbDogIHRydWUsCgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXNyYzogICAgIGZhbHNlLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5TY2FuKHR0LnNyYyk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsQm9vbC5TY2FuKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCQlpZiAhdHQubi5WYWxpZCAmJiB0dC5zcmMgIT0gbmlsIHsKCQkJCXQuRXJyb3JmKCJzaG91bGQgcmV0dXJuIG51bGwiKQoJCQl9CgkJCWlmIHR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IHR0Lm4uQm9vbCB7CgkJCQl0LkVycm9yZigiaW52YWxpZCB2YWx1ZSIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsQm9vbF9NYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgKk51bGxCb29sCgkJd2FudCAgICBbXWJ5dGUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbEJvb2x7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGBmYWxzZWApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogInZhbGlkIG51bGwiLAoJCQluOiAmTnVsbEJvb2x7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogImludmFsaWQiLAoJCQluOiAmTnVsbEJvb2x7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGBmYWxzZWApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLk1hcnNoYWxKU09OKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxCb29sLk1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJCXJldHVybgoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChnb3QsIHR0LndhbnQpIHsKCQkJCXQuRXJyb3JmKCJOdWxsQm9vbC5NYXJzaGFsSlNPTigpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsVGltZV9Vbm1hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBOdWxsVGltZQoJCXNvdXJjZSAgW11ieXRlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogICAgInZhbGlkIiwKCQkJc291cmNlOiAgW11ieXRlKGAiMjAxNy0xMS0yNFQwMDowMDowMFoiYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiaW52YWxpZCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgeyJrZXkiOiJ2YWx1ZSJ9YCksCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJlbXB0eSIsCgkJCXNvdXJjZTogIFtdYnl0ZXt9LAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiZXhwbGljaXQgbnVsbCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5Vbm1hcnNoYWxKU09OKHR0LnNvdXJjZSk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsQm9vbC5Vbm1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxUaW1lX1ZhbHVlKHQgKnRlc3RpbmcuVCkgewoJdGltIDo9IHRpbWUuTm93KCkKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbFRpbWUKCQl3YW50ICAgIGRyaXZlci5WYWx1ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46IE51bGxUaW1lewoJCQkJVmFsaWQ6IHRydWUsCgkJCQlUaW1lOiAgdGltLAoJCQl9LAoJCQl3YW50OiAgICBkcml2ZXIuVmFsdWUodGltKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogTnVsbFRpbWV7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIG5pbCwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJZ290LCBlcnIgOj0gdHQubi5WYWx1ZSgpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsVGltZS5WYWx1ZSgpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbFRpbWUuVmFsdWUoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbFRpbWVfU2Nhbih0ICp0ZXN0aW5nLlQpIHsKCXRpbSA6PSB0aW1lLk5vdygpCgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsVGltZQoJCXdhbnRFcnIgYm9vbAoJCXNyYyAgICAgaW50ZXJmYWNle30KCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbFRpbWV7CgkJCQlUaW1lOiAgdGltLAoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXNyYzogICAgIHRpbSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJuaWwgdmFsdWUiLAoJCQluOiAmTnVsbFRpbWV7CgkJCQlUaW1lOiAgdGltLAoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQlzcmM6ICAgICB0aW1lLk5vdygpLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5TY2FuKHR0LnNyYyk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsVGltZS5TY2FuKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCQlpZiAhdHQubi5WYWxpZCAmJiB0dC5zcmMgIT0gbmlsIHsKCQkJCXQuRXJyb3JmKCJzaG91bGQgcmV0dXJuIG51bGwiKQoJCQl9CgkJCWlmIHR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IHR0Lm4uVGltZSB7CgkJCQl0LkVycm9yZigiaW52YWxpZCB2YWx1ZSIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsVGltZV9NYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgKk51bGxUaW1lCgkJd2FudCAgICBbXWJ5dGUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbFRpbWV7CgkJCQlUaW1lOiAgdGltZS5EYXRlKDIwMTcsIDExLCAyNCwgMCwgMCwgMCwgMCwgdGltZS5VVEMpLAoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgIjIwMTctMTEtMjRUMDA6MDA6MDBaImApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogInZhbGlkIG51bGwiLAoJCQluOiAmTnVsbFRpbWV7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogImludmFsaWQiLAoJCQluOiAmTnVsbFRpbWV7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGAiMDAwMS0wMS0wMVQwMDowMDowMFoiYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uTWFyc2hhbEpTT04oKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFRpbWUuTWFyc2hhbEpTT04oKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQkJcmV0dXJuCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgdHQud2FudCkgewoJCQkJdC5FcnJvcmYoIk51bGxUaW1lLk1hcnNoYWxKU09OKCkgPSAldiwgd2FudCAldiIsIGdvdCwgdHQud2FudCkKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxJbnQ2NF9Vbm1hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBOdWxsSW50NjQKCQlzb3VyY2UgIFtdYnl0ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICAgICJ2YWxpZCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgMTIzYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiaW52YWxpZCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgeyJrZXkiOiJ2YWx1ZSJ9YCksCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJlbXB0eSIsCgkJCXNvdXJjZTogIFtdYnl0ZXt9LAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWlmIGVyciA6PSB0dC5uLlVubWFyc2hhbEpTT04odHQuc291cmNlKTsgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxJbnQ2NC5Vbm1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxJbnQ2NF9WYWx1ZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbEludDY0CgkJd2FudCAgICBkcml2ZXIuVmFsdWUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiBOdWxsSW50NjR7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJCUludDY0OiAxMjMsCgkJCX0sCgkJCXdhbnQ6ICAgIGRyaXZlci5WYWx1ZShpbnQ2NCgxMjMpKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogTnVsbEludDY0ewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBuaWwsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uVmFsdWUoKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEludDY0LlZhbHVlKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJCXJldHVybgoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChnb3QsIHR0LndhbnQpIHsKCQkJCXQuRXJyb3JmKCJOdWxsSW50NjQuVmFsdWUoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbEludDY0X1NjYW4odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsSW50NjQKCQl3YW50RXJyIGJvb2wKCQlzcmMgICAgIGludGVyZmFjZXt9Cgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxJbnQ2NHsKCQkJCUludDY0OiAxMjMsCgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJc3JjOiAgICAgaW50NjQoMTIzKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJuaWwgdmFsdWUiLAoJCQluOiAmTnVsbEludDY0ewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQlzcmM6ICAgICBpbnQ2NCgxMjMpLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5TY2FuKHR0LnNyYyk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsSW50NjQuU2NhbigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQkJaWYgIXR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IG5pbCB7CgkJCQl0LkVycm9yZigic2hvdWxkIHJldHVybiBudWxsIikKCQkJfQoJCQlpZiB0dC5uLlZhbGlkICYmIHR0LnNyYyAhPSB0dC5uLkludDY0IHsKCQkJCXQuRXJyb3JmKCJpbnZhbGlkIHZhbHVlIikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxJbnQ2NF9NYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgKk51bGxJbnQ2NAoJCXdhbnQgICAgW11ieXRlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxJbnQ2NHsKCQkJCUludDY0OiAxMjMsCgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGAxMjNgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJ2YWxpZCBudWxsIiwKCQkJbjogJk51bGxJbnQ2NHsKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGBudWxsYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAiaW52YWxpZCIsCgkJCW46ICZOdWxsSW50NjR7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGAwYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uTWFyc2hhbEpTT04oKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEludDY0Lk1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJCXJldHVybgoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChnb3QsIHR0LndhbnQpIHsKCQkJCXQuRXJyb3JmKCJOdWxsSW50NjQuTWFyc2hhbEpTT04oKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQpmdW5jIFRlc3ROdWxsRmxvYXQ2NF9Vbm1hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBOdWxsRmxvYXQ2NAoJCXNvdXJjZSAgW11ieXRlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogICAgImV4cGxpY2l0IG51bGwiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJ2YWxpZCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgMTIzLjEyM2ApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImludmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYHsia2V5IjoidmFsdWUifWApLAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiZW1wdHkiLAoJCQlzb3VyY2U6ICBbXWJ5dGV7fSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5Vbm1hcnNoYWxKU09OKHR0LnNvdXJjZSk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsRmxvYXQ2NC5Vbm1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxGbG9hdDY0X1ZhbHVlKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBOdWxsRmxvYXQ2NAoJCXdhbnQgICAgZHJpdmVyLlZhbHVlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogTnVsbEZsb2F0NjR7CgkJCQlWYWxpZDogICB0cnVlLAoJCQkJRmxvYXQ2NDogMTIzLjEyMywKCQkJfSwKCQkJd2FudDogICAgZHJpdmVyLlZhbHVlKGZsb2F0NjQoMTIzLjEyMykpLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogImludmFsaWQiLAoJCQluOiBOdWxsRmxvYXQ2NHsKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJd2FudDogICAgbmlsLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLlZhbHVlKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxGbG9hdDY0LlZhbHVlKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJCXJldHVybgoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChnb3QsIHR0LndhbnQpIHsKCQkJCXQuRXJyb3JmKCJOdWxsRmxvYXQ2NC5WYWx1ZSgpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsRmxvYXQ2NF9TY2FuKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICAqTnVsbEZsb2F0NjQKCQl3YW50RXJyIGJvb2wKCQlzcmMgICAgIGludGVyZmFjZXt9Cgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxGbG9hdDY0ewoJCQkJRmxvYXQ2NDogMTIzLjEyMywKCQkJCVZhbGlkOiAgIHRydWUsCgkJCX0sCgkJCXNyYzogICAgIGZsb2F0NjQoMTIzKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJuaWwgdmFsdWUiLAoJCQluOiAmTnVsbEZsb2F0NjR7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXNyYzogICAgIGZsb2F0NjQoMTIzLjEyMyksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWlmIGVyciA6PSB0dC5uLlNjYW4odHQuc3JjKTsgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxGbG9hdDY0LlNjYW4oKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQl9CgkJCWlmICF0dC5uLlZhbGlkICYmIHR0LnNyYyAhPSBuaWwgewoJCQkJdC5FcnJvcmYoInNob3VsZCByZXR1cm4gbnVsbCIpCgkJCX0KCQkJaWYgdHQubi5WYWxpZCAmJiB0dC5zcmMgIT0gdHQubi5GbG9hdDY0IHsKCQkJCXQuRXJyb3JmKCJpbnZhbGlkIHZhbHVlIikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxGbG9hdDY0X01hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICAqTnVsbEZsb2F0NjQKCQl3YW50ICAgIFtdYnl0ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46ICZOdWxsRmxvYXQ2NHsKCQkJCUZsb2F0NjQ6IDEyMy4xMjMsCgkJCQlWYWxpZDogICB0cnVlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYDEyMy4xMjNgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJ2YWxpZCBudWxsIiwKCQkJbjogJk51bGxGbG9hdDY0ewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogJk51bGxGbG9hdDY0ewoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgMGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLk1hcnNoYWxKU09OKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxGbG9hdDY0Lk1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJCXJldHVybgoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChnb3QsIHR0LndhbnQpIHsKCQkJCXQuRXJyb3JmKCJOdWxsRmxvYXQ2NC5NYXJzaGFsSlNPTigpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3RUb051bGxCb29sKHQgKnRlc3RpbmcuVCkgewoJYiA6PSB0cnVlCgliYiA6PSBUb051bGxCb29sKCZiKQoJaWYgIWJiLlZhbGlkIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgdmFsaWQsIGdvdCAldiIsIGJiLlZhbGlkKQoJfQoJaWYgIWJiLkJvb2wgewoJCXQuRXJyb3JmKCJleHBlY3RlZCB0cnVlLCBnb3QgJXYiLCBiYi5Cb29sKQoJfQoKCXZhciBiMiAqYm9vbAoJYmIyIDo9IFRvTnVsbEJvb2woYjIpCglpZiBiYjIuVmFsaWQgewoJCXQuRXJyb3JmKCJleHBlY3RlZCBub3QgdmFsaWQsIGdvdCAldiIsIGJiMi5WYWxpZCkKCX0KCWlmIGJiMi5Cb29sIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgZmFsc2UsIGdvdCAldiIsIGJiMi5Cb29sKQoJfQp9CmZ1bmMgVGVzdFRvTnVsbEludDY0KHQgKnRlc3RpbmcuVCkgewoJYiA6PSBpbnQ2NCgxMjMpCgliYiA6PSBUb051bGxJbnQ2NCgmYikKCWlmICFiYi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIHZhbGlkLCBnb3QgJXYiLCBiYi5WYWxpZCkKCX0KCWlmIGJiLkludDY0ICE9IDEyMyB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIDEyMywgZ290ICV2IiwgYmIuSW50NjQpCgl9CgoJdmFyIGIyICppbnQ2NAoJYmIyIDo9IFRvTnVsbEludDY0KGIyKQoJaWYgYmIyLlZhbGlkIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgbm90IHZhbGlkLCBnb3QgJXYiLCBiYjIuVmFsaWQpCgl9CglpZiBiYjIuSW50NjQgIT0gMCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIDAsIGdvdCAldiIsIGJiMi5JbnQ2NCkKCX0KfQoKZnVuYyBUZXN0VG9OdWxsRmxvYXQ2NCh0ICp0ZXN0aW5nLlQpIHsKCWIgOj0gZmxvYXQ2NCgxMjMuMTIzKQoJYmIgOj0gVG9OdWxsRmxvYXQ2NCgmYikKCWlmICFiYi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIHZhbGlkLCBnb3QgJXYiLCBiYi5WYWxpZCkKCX0KCWlmIGJiLkZsb2F0NjQgIT0gMTIzLjEyMyB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIDEyMy4xMjMsIGdvdCAldiIsIGJiLkZsb2F0NjQpCgl9CgoJdmFyIGIyICpmbG9hdDY0CgliYjIgOj0gVG9OdWxsRmxvYXQ2NChiMikKCWlmIGJiMi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIG5vdCB2YWxpZCwgZ290ICV2IiwgYmIyLlZhbGlkKQoJfQoJaWYgYmIyLkZsb2F0NjQgIT0gMCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIDAsIGdvdCAldiIsIGJiMi5GbG9hdDY0KQoJfQp9CmZ1bmMgVGVzdFRvTnVsbFN0cmluZyh0ICp0ZXN0aW5nLlQpIHsKCWIgOj0gInF3ZSIKCWJiIDo9IFRvTnVsbFN0cmluZygmYikKCWlmICFiYi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIHZhbGlkLCBnb3QgJXYiLCBiYi5WYWxpZCkKCX0KCWlmIGJiLlN0cmluZyAhPSAicXdlIiB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIHF3ZSwgZ290ICV2IiwgYmIuU3RyaW5nKQoJfQoKCXZhciBiMiAqc3RyaW5nCgliYjIgOj0gVG9OdWxsU3RyaW5nKGIyKQoJaWYgYmIyLlZhbGlkIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgbm90IHZhbGlkLCBnb3QgJXYiLCBiYjIuVmFsaWQpCgl9CglpZiBiYjIuU3RyaW5nICE9ICIiIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgPGVtcHR5IHN0cmluZz4sIGdvdCAldiIsIGJiMi5TdHJpbmcpCgl9Cn0KZnVuYyBUZXN0VG9OdWxsVGltZSh0ICp0ZXN0aW5nLlQpIHsKCXRpbSA6PSB0aW1lLk5vdygpCgliYiA6PSBUb051bGxUaW1lKHRpbSkKCWlmICFiYi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIHZhbGlkLCBnb3QgJXYiLCBiYi5WYWxpZCkKCX0KCWlmIGJiLlRpbWUgIT0gdGltIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgJXYsIGdvdCAldiIsIHRpbSwgYmIuVGltZSkKCX0KCgl0aW0gPSB0aW1lLlRpbWV7fQoJYmIgPSBUb051bGxUaW1lKHRpbSkKCWlmIGJiLlZhbGlkIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgaW52YWxpZCwgZ290ICV2IiwgYmIuVmFsaWQpCgl9CglpZiBiYi5UaW1lICE9IHRpbSB7CgkJdC5FcnJvcmYoImV4cGVjdGVkICV2LCBnb3QgJXYiLCB0aW0sIGJiLlRpbWUpCgl9Cn0KCmZ1bmMgVGVzdFJhd0pTT05fTWFyc2hhbEpTT04odCAqdGVzdGluZy5UKSB7CgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJbmFtZSBzdHJpbmcKCQlkYXRhIFtdYnl0ZQoJCWV4cCAgc3RyaW5nCgl9ewoJCXsKCQkJbmFtZTogImVtcHR5IGRhdGEiLAoJCQlkYXRhOiBbXWJ5dGV7fSwKCQkJZXhwOiAgIm51bGwiLAoJCX0sCgl9CgoJZm9yIF8sIGMgOj0gcmFuZ2UgY2FzZXMgewoJCXQuUnVuKGMubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJcmogOj0gUmF3SlNPTihjLmRhdGEpCgkJCWIsIGVyciA6PSByai5NYXJzaGFsSlNPTigpCgkJCWlmIGVyciAhPSBuaWwgewoJCQkJdC5GYXRhbGYoInVuZXhwZWN0ZWQgZXJyb3I6ICV2IiwgZXJyKQoJCQl9CgkJCWlmIHN0cmluZyhiKSAhPSBjLmV4cCB7CgkJCQl0LkZhdGFsZigiXG5leHA6ICVxXG5nb3Q6ICVxIiwgYy5leHAsIHN0cmluZyhiKSkKCQkJfQoJCX0pCgl9Cn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "factory.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImZtdCIKCSJtYXRoIgoJInN0cmNvbnYiCgkic3RyaW5ncyIKKQoKLy8gR2V0RmFjdG9yeSByZXR1cm5zIHRoZSBmYWN0b3J5IG9mIGEgbW9kZWwsIGZpbGxpbmcgZXZlcnkgY29sdW1uIHdpdGggYSBmYWtlIHZhbHVlCi8vIGJ1dCBmb3IgYXV0byBpbmNyZW1lbnRlZCBjb2x1bW5zLCB0aGUgc29mdCBkZWxldGUgYW5kIHZlcnNpb24gY29sdW1ucywgbnVsbGFibGUKLy8gZm9yZWlnbiBrZXlzLCBhbmQgdGhlIGZvcmVpZ24ga2V5cyB0byBvdGhlciBtb2RlbHMsIHdob3NlIHJlZmVyZW5jZWQgcm93cyBhcmUgaW5zZXJ0ZWQgZmlyc3QuCmZ1bmMgR2V0RmFjdG9yeShtIFRtcGxTdHJ1Y3QsIG1vZGVscyBbXVRtcGxTdHJ1Y3QsIGltcG9ydFBhdGgsIHBrZywgc29mdERlbGV0ZSwgdmVyc2lvbiBzdHJpbmcpIFRtcGxGYWN0b3J5IHsKCWYgOj0gVG1wbEZhY3Rvcnl7SW1wb3J0OiBpbXBvcnRQYXRoLCBQYWNrYWdlOiBwa2d9CglieVRhYmxlIDo9IG1ha2UobWFwW3N0cmluZ11UbXBsU3RydWN0KQoJZm9yIF8sIG1vZGVsIDo9IHJhbmdlIG1vZGVscyB7CgkJYnlUYWJsZVttb2RlbC5UYWJsZU5hbWVdID0gbW9kZWwKCX0KCXBhcmVudHMgOj0gbWFrZShtYXBbc3RyaW5nXWJvb2wpCglmb3IgXywga2V5IDo9IHJhbmdlIG0uRm9yZWlnbktleXMgewoJCWlmIGZsLCBmb3VuZCA6PSBmaWVsZEJ5Q29sdW1uKG0uRmllbGRzLCBrZXkuQ29sdW1uKTsgZm91bmQgJiYgZmwuTnVsbGFibGUgewoJCQkvLyBsZWZ0IE5VTEwgcmF0aGVyIHRoYW4gcmVmZXJlbmNpbmcgYSByb3cgd2hpY2ggZG9lcyBub3QgZXhpc3QKCQkJcGFyZW50c1tmbC5Db2x1bW5OYW1lXSA9IHRydWUKCQkJY29udGludWUKCQl9CgkJdG8sIG9rIDo9IGJ5VGFibGVba2V5LlJlZlRhYmxlXQoJCWZsLCBmb3VuZCA6PSBmaWVsZEJ5Q29sdW1uKG0uRmllbGRzLCBrZXkuQ29sdW1uKQoJCWlmICFvayB8fCAhZm91bmQgfHwgcGFyZW50c1tmbC5Db2x1bW5OYW1lXSB8fCB0by5UYWJsZU5hbWUgPT0gbS5UYWJsZU5hbWUgewoJCQljb250aW51ZQoJCX0KCQlpZiBrZXkuUmVmQ29sdW1uICE9ICJpZCIgfHwgIUhhc0NvbHVtbih0by5GaWVsZHMsICJpZCIpIHx8IGZsLlR5cGUgIT0gImludDY0IiB7CgkJCWNvbnRpbnVlCgkJfQoJCXBhcmVudHNbZmwuQ29sdW1uTmFtZV0gPSB0cnVlCgkJZi5QYXJlbnRzID0gYXBwZW5kKGYuUGFyZW50cywgVG1wbEZhY3RvcnlQYXJlbnR7RmllbGQ6IGZsLCBNb2RlbDogdG99KQoJfQoJZm9yIF8sIGZsIDo9IHJhbmdlIG0uRmllbGRzIHsKCQlpZiBmbC5BdXRvSW5jIHx8IHBhcmVudHNbZmwuQ29sdW1uTmFtZV0gfHwgZmwuQ29sdW1uTmFtZSA9PSBzb2Z0RGVsZXRlIHx8IGZsLkNvbHVtbk5hbWUgPT0gdmVyc2lvbiB7CgkJCWNvbnRpbnVlCgkJfQoJCWYuRmllbGRzID0gYXBwZW5kKGYuRmllbGRzLCBUbXBsRmFjdG9yeUZpZWxke0ZpZWxkOiBmbCwgVmFsdWU6IEdldEZha2VWYWx1ZShwa2csIG0sIGZsKX0pCgl9CglyZXR1cm4gZgp9CgovLyBHZXRGYWtlVmFsdWUgcmV0dXJucyBhbiBleHByZXNzaW9uIG9mIHRoZSBmYWtlIHZhbHVlIG9mIGEgZmllbGQgb2YgYSBtb2RlbCwgbWFkZSBvZiB0aGUgZmFrZQovLyBmdW5jdGlvbnMgb2YgdGhlIGdlbmVyYXRlZCBmYWN0b3JpZXMgcGFja2FnZSBhbmQgb2YgdGhlIHNlcXVlbmNlIG51bWJlciBuLiBTdHJpbmdzIGhvbGQgbiwKLy8gc28gdGhleSBzdGF5IHVuaXF1ZSBhcyBsb25nIGFzIHRoZXkgZml0IHRoZWlyIGNvbHVtbiwgYW5kIHNvIGRvIGludGVnZXJzIHdpdGhpbiB0aGVpciByYW5nZSwKLy8gd2hpY2ggQ0hFQ0sgY29uc3RyYWludHMgY29tcGFyaW5nIHRoZSBjb2x1bW4gd2l0aCBhIG51bWJlciBuYXJyb3cuCmZ1bmMgR2V0RmFrZVZhbHVlKHBrZyBzdHJpbmcsIG0gVG1wbFN0cnVjdCwgZmwgVG1wbEZpZWxkKSBzdHJpbmcgewoJYmFzZSwgYXJncywgdW5zaWduZWQgOj0gcGFyc2VDb2x1bW5UeXBlKGZsLkNvbHVtblR5cGUpCgl2YXIgZXhwciBzdHJpbmcKCXN3aXRjaCBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKSB7CgljYXNlICJpbnQ2NCIsICJJbnQ2NCI6CgkJbG8sIGhpIDo9IGludDY0KDEpLCBpbnRSYW5nZXNbYmFzZV0KCQlpZiBiYXNlID09ICJ5ZWFyIiB7CgkJCWxvLCBoaSA9IDE5MDEsIDIxNTUKCQl9IGVsc2UgaWYgdW5zaWduZWQgewoJCQloaSA9IGhpKjIgKyAxCgkJfQoJCWlmIGhpID09IDAgewoJCQloaSA9IDEyNwoJCX0KCQlsbywgaGkgPSBjaGVja0JvdW5kcyhtLkNoZWNrcywgZmwuQ29sdW1uTmFtZSwgbG8sIGhpKQoJCWV4cHIgPSBmbXQuU3ByaW50ZigiZmFrZUludChuLCAlZCwgJWQpIiwgbG8sIGhpKQoJY2FzZSAiZmxvYXQ2NCIsICJGbG9hdDY0IjoKCQlkaWdpdHMsIHNjYWxlIDo9IDYsIDIKCQlpZiBiYXNlID09ICJkZWNpbWFsIiB7CgkJCWlmIHAsIHMsIG9rIDo9IHBhcnNlUHJlY2lzaW9uKGFyZ3MpOyBvayB7CgkJCQlkaWdpdHMsIHNjYWxlID0gbWluSW50KHAtcywgNiksIG1pbkludChzLCA2KQoJCQl9CgkJfQoJCWV4cHIgPSBmbXQuU3ByaW50ZigiZmFrZUZsb2F0KG4sICVkLCAlZCkiLCBkaWdpdHMsIHNjYWxlKQoJY2FzZSAiYm9vbCIsICJCb29sIjoKCQlleHByID0gImZha2VCb29sKG4pIgoJY2FzZSAic3RyaW5nIiwgIlN0cmluZyI6CgkJZXhwciA9IGZha2VTdHJpbmcobS5UYWJsZU5hbWUsIGZsLkNvbHVtbk5hbWUsIGJhc2UsIGFyZ3MpCgljYXNlICJbXWJ5dGUiOgoJCWlmIGJhc2UgPT0gImJpdCIgewoJCQlyZXR1cm4gImZha2VCaXRzKG4pIgoJCX0KCQluLCBfIDo9IHN0cmNvbnYuQXRvaShhcmdzKQoJCXJldHVybiBmbXQuU3ByaW50ZigiW11ieXRlKGZha2VUZXh0KCVzLCBuLCAlZCkpIiwgUXVvdGVTdHJpbmcoZmwuQ29sdW1uTmFtZSksIG4pCgljYXNlICJSYXdKU09OIjoKCQlyZXR1cm4gImZha2VKU09OKG4pIgoJY2FzZSAidGltZS5UaW1lIiwgIlRpbWUiOgoJCWlmIGJhc2UgPT0gImRhdGUiIHsKCQkJZXhwciA9ICJmYWtlRGF0ZShuKSIKCQl9IGVsc2UgewoJCQlleHByID0gImZha2VUaW1lKG4pIgoJCX0KCWRlZmF1bHQ6CgkJcmV0dXJuIHBrZyArICIuIiArIGZsLlR5cGUgKyAie30iCgl9CglpZiBzdHJpbmdzLkhhc1ByZWZpeChmbC5UeXBlLCAiTnVsbCIpIHsKCQlmaWVsZCA6PSBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKQoJCXJldHVybiBmbXQuU3ByaW50ZigiJXMuJXN7JXM6ICVzLCBWYWxpZDogdHJ1ZX0iLCBwa2csIGZsLlR5cGUsIGZpZWxkLCBleHByKQoJfQoJcmV0dXJuIGV4cHIKfQoKLy8gZmFrZVN0cmluZyByZXR1cm5zIHRoZSBmYWtlIHZhbHVlIGV4cHJlc3Npb24gb2YgYSBzdHJpbmcgY29sdW1uLCBndWVzc2luZwovLyB0aGUga2luZCBvZiB2YWx1ZSBmcm9tIHRoZSBjb2x1bW4gbmFtZSB3aGVuIGl0cyB0eXBlIHRlbGxzIG5vdGhpbmcgbW9yZS4KZnVuYyBmYWtlU3RyaW5nKHRhYmxlLCBjb2x1bW4sIGJhc2UsIGFyZ3Mgc3RyaW5nKSBzdHJpbmcgewoJc3dpdGNoIGJhc2UgewoJY2FzZSAiZW51bSIsICJzZXQiOgoJCW1lbWJlcnMgOj0gcXVvdGVkVmFsdWVzKGFyZ3MpCgkJZm9yIGksIG1lbWJlciA6PSByYW5nZSBtZW1iZXJzIHsKCQkJbWVtYmVyc1tpXSA9IFF1b3RlU3RyaW5nKG1lbWJlcikKCQl9CgkJcmV0dXJuIGZtdC5TcHJpbnRmKCJmYWtlUGljayhuLCAlcykiLCBzdHJpbmdzLkpvaW4obWVtYmVycywgIiwgIikpCgljYXNlICJ0aW1lIjoKCQlyZXR1cm4gImZha2VDbG9jayhuKSIKCX0KCW1heCA6PSB0ZXh0U2l6ZXNbYmFzZV0KCWlmIGJhc2UgPT0gImNoYXIiIHx8IGJhc2UgPT0gInZhcmNoYXIiIHsKCQltYXgsIF8gPSBzdHJjb252LkF0b2koYXJncykKCX0KCW5hbWUgOj0gc3RyaW5ncy5Ub0xvd2VyKGNvbHVtbikKCXN3aXRjaCB7CgljYXNlIGJhc2UgPT0gImNoYXIiICYmIG1heCA9PSAzNjoKCQlyZXR1cm4gImZha2VVVUlEKG4pIgoJY2FzZSBzdHJpbmdzLkNvbnRhaW5zKG5hbWUsICJlbWFpbCIpOgoJCXJldHVybiBmbXQuU3ByaW50ZigiZmFrZUVtYWlsKCVzLCBuLCAlZCkiLCBRdW90ZVN0cmluZyh0YWJsZSksIG1heCkKCWNhc2Ugc3RyaW5ncy5Db250YWlucyhuYW1lLCAidXJsIikgfHwgc3RyaW5ncy5Db250YWlucyhuYW1lLCAid2Vic2l0ZSIpOgoJCXJldHVybiBmbXQuU3ByaW50ZigiZmFrZVVSTCglcywgbiwgJWQpIiwgUXVvdGVTdHJpbmcodGFibGUpLCBtYXgpCgljYXNlIHN0cmluZ3MuQ29udGFpbnMobmFtZSwgInBob25lIik6CgkJcmV0dXJuIGZtdC5TcHJpbnRmKCJmYWtlUGhvbmUobiwgJWQpIiwgbWF4KQoJZGVmYXVsdDoKCQlyZXR1cm4gZm10LlNwcmludGYoImZha2VUZXh0KCVzLCBuLCAlZCkiLCBRdW90ZVN0cmluZyhjb2x1bW4pLCBtYXgpCgl9Cn0KCi8vIGNoZWNrQm91bmRzIG5hcnJvd3MgdGhlIHJhbmdlIG9mIGFuIGludGVnZXIgY29sdW1uIHRvIHRoZSBudW1iZXJzIGl0cyBDSEVDSyBjb25zdHJhaW50cyBhbGxvdy4KLy8gVGhlIHJhbmdlIGlzIGxlZnQgYXMgaXMgd2hlbiB0aGUgY29uc3RyYWludHMgZXhjbHVkZSBldmVyeSBudW1iZXIgb2YgaXQuCmZ1bmMgY2hlY2tCb3VuZHMoY2hlY2tzIFtdVG1wbENoZWNrLCBjb2x1bW4gc3RyaW5nLCBsbywgaGkgaW50NjQpIChpbnQ2NCwgaW50NjQpIHsKCWZyb20sIHRvIDo9IGxvLCBoaQoJZm9yIF8sIGNoZWNrIDo9IHJhbmdlIGNoZWNrcyB7CgkJY29sLCBsZW5ndGgsIG9wLCBudW1iZXIsIG9rIDo9IHBhcnNlQ2hlY2soY2hlY2spCgkJaWYgIW9rIHx8IGxlbmd0aCB8fCBjb2wgIT0gY29sdW1uIHsKCQkJY29udGludWUKCQl9CgkJZiwgZXJyIDo9IHN0cmNvbnYuUGFyc2VGbG9hdChudW1iZXIsIDY0KQoJCWlmIGVyciAhPSBuaWwgfHwgbWF0aC5BYnMoZikgPiAxPDw1MyB7CgkJCWNvbnRpbnVlCgkJfQoJCS8vIHRoZSBpbnRlZ2VycyBwYXN0IGEgbnVtYmVyIHdoaWNoIGlzIG5vdCBvbmUgYXJlIHRob3NlIHBhc3QgaXRzIGZsb29yIG9yIGNlaWxpbmcKCQlmbG9vciwgY2VpbCA6PSBpbnQ2NChtYXRoLkZsb29yKGYpKSwgaW50NjQobWF0aC5DZWlsKGYpKQoJCXN3aXRjaCBvcCB7CgkJY2FzZSAiPiI6CgkJCWlmIGZsb29yKzEgPiBmcm9tIHsKCQkJCWZyb20gPSBmbG9vciArIDEKCQkJfQoJCWNhc2UgIj49IjoKCQkJaWYgY2VpbCA+IGZyb20gewoJCQkJZnJvbSA9IGNlaWwKCQkJfQoJCWNhc2UgIjwiOgoJCQlpZiBjZWlsLTEgPCB0byB7CgkJCQl0byA9IGNlaWwgLSAxCgkJCX0KCQljYXNlICI8PSI6CgkJCWlmIGZsb29yIDwgdG8gewoJCQkJdG8gPSBmbG9vcgoJCQl9CgkJY2FzZSAiPT0iOgoJCQlpZiBmbG9vciA9PSBjZWlsIHsKCQkJCWZyb20sIHRvID0gZmxvb3IsIGZsb29yCgkJCX0KCQl9Cgl9CglpZiBmcm9tID4gdG8gewoJCXJldHVybiBsbywgaGkKCX0KCXJldHVybiBmcm9tLCB0bwp9Cg==\"")
+	packr.PackJSONBytes("./tmpl", "factory.html", "\"e3tkZWZpbmUgImZhY3RvcnkifX0KcGFja2FnZSBmYWN0b3JpZXMKCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuIHwKfCAgICAgICAgRE8gTk9UIEVESVQuICAgICAgICB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKaW1wb3J0ICgKImNvbnRleHQiCgp7eyAuRmFjdG9yeS5QYWNrYWdlIH19ICJ7eyAuRmFjdG9yeS5JbXBvcnQgfX0iCikKCnt7LSAkbW9kZWwgOj0gcHJpbnRmICIlcy4lcyIgLkZhY3RvcnkuUGFja2FnZSAuTW9kZWwuTmFtZSB9fQoKLy8ge3suTW9kZWwuTmFtZX19T3B0aW9uIGNoYW5nZXMgdGhlIHt7ICRtb2RlbCB9fSBidWlsdCBieSBOZXd7ey5Nb2RlbC5OYW1lfX0uCnR5cGUge3suTW9kZWwuTmFtZX19T3B0aW9uIGZ1bmMoKnt7ICRtb2RlbCB9fSkKCi8vIE5ld3t7Lk1vZGVsLk5hbWV9fSBidWlsZHMgYSB7eyAkbW9kZWwgfX0gaG9sZGluZyBmYWtlIHZhbHVlcyBmaXR0aW5nIHRoZSBjb2x1bW5zIG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwgdGhlbiBhcHBsaWVzIG9wdHMuCmZ1bmMgTmV3e3suTW9kZWwuTmFtZX19KG9wdHMgLi4ue3suTW9kZWwuTmFtZX19T3B0aW9uKSAqe3sgJG1vZGVsIH19IHsKICAgIHt7LSBpZiAuRmFjdG9yeS5GaWVsZHMgfX0KICAgIG4gOj0gbmV4dCgpCiAgICB7ey0gZW5kIH19CiAgICByb3cgOj0gJnt7ICRtb2RlbCB9fXsKICAgIHt7LSByYW5nZSAuRmFjdG9yeS5GaWVsZHMgfX0KICAgICAgICB7eyAuRmllbGQuTmFtZSB9fToge3sgLlZhbHVlIH19LAogICAge3stIGVuZCB9fQogICAgfQogICAgZm9yIF8sIG9wdCA6PSByYW5nZSBvcHRzIHsKICAgICAgICBvcHQocm93KQogICAgfQogICAgcmV0dXJuIHJvdwp9CgovLyBJbnNlcnR7ey5Nb2RlbC5OYW1lfX0gaW5zZXJ0cyBhIHt7ICRtb2RlbCB9fSBidWlsdCBieSBOZXd7ey5Nb2RlbC5OYW1lfX0gd2l0aCBvcHRzLgp7ey0gaWYgLkZhY3RvcnkuUGFyZW50cyB9fQovLyBUaGUgcm93cyBpdHMgZm9yZWlnbiBrZXlzIHJlZmVyZW5jZSBhcmUgaW5zZXJ0ZWQgZmlyc3QsIHVubGVzcyBvcHRzIHNldCB0aGVtLgp7ey0gZW5kIH19CmZ1bmMgSW5zZXJ0e3suTW9kZWwuTmFtZX19KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IHt7IC5GYWN0b3J5LlBhY2thZ2UgfX0uUXVlcnllckNvbnRleHQsIG9wdHMgLi4ue3suTW9kZWwuTmFtZX19T3B0aW9uKSAoKnt7ICRtb2RlbCB9fSwgZXJyb3IpIHsKICAgIHJvdyA6PSBOZXd7ey5Nb2RlbC5OYW1lfX0ob3B0cy4uLikKICAgIHt7LSByYW5nZSAuRmFjdG9yeS5QYXJlbnRzIH19CiAgICBpZiByb3cue3sgLkZpZWxkLk5hbWUgfX0gPT0gMCB7CiAgICAgICAgcGFyZW50LCBlcnIgOj0gSW5zZXJ0e3sgLk1vZGVsLk5hbWUgfX0oY3R4LCBxdSkKICAgICAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuIG5pbCwgZXJyCiAgICAgICAgfQogICAgICAgIHJvdy57eyAuRmllbGQuTmFtZSB9fSA9IHBhcmVudC5JRAogICAgfQogICAge3stIGVuZCB9fQogICAgaWQsIGVyciA6PSByb3cuSW5zZXJ0Q29udGV4dChjdHgsIHF1KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIG5pbCwgZXJyCiAgICB9CiAgICBpZiBpZCAhPSAwIHsKICAgICAgICByb3cuSUQgPSBpZAogICAgfQogICAgcmV0dXJuIHJvdywgbmlsCn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "factory_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgInRlc3RpbmciCgpmdW5jIFRlc3RHZXRGYWtlVmFsdWUodCAqdGVzdGluZy5UKSB7CgltIDo9IFRtcGxTdHJ1Y3R7CgkJVGFibGVOYW1lOiAidXNlciIsCgkJQ2hlY2tzOiBbXVRtcGxDaGVja3sKCQkJe05hbWU6ICJhZ2VfbWluIiwgQ2xhdXNlOiAiKGBhZ2VgID49IDE4KSJ9LAoJCQl7TmFtZTogImFnZV9tYXgiLCBDbGF1c2U6ICIoYGFnZWAgPCAxMzApIn0sCgkJCXtOYW1lOiAic2NvcmUiLCBDbGF1c2U6ICIoYHNjb3JlYCA+IDIwMCkifSwKCQkJe05hbWU6ICJyYXRpbmdfbWluIiwgQ2xhdXNlOiAiKGByYXRpbmdgID4gMS41KSJ9LAoJCQl7TmFtZTogInJhdGluZ19tYXgiLCBDbGF1c2U6ICIoYHJhdGluZ2AgPD0gOS45KSJ9LAoJCX0sCgl9Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJZmwgICBUbXBsRmllbGQKCQl3YW50IHN0cmluZwoJfXsKCQl7VG1wbEZpZWxke1R5cGU6ICJpbnQ2NCIsIENvbHVtbk5hbWU6ICJhZ2UiLCBDb2x1bW5UeXBlOiAiaW50KDExKSJ9LCAiZmFrZUludChuLCAxOCwgMTI5KSJ9LAoJCXtUbXBsRmllbGR7VHlwZTogImludDY0IiwgQ29sdW1uTmFtZTogInNjb3JlIiwgQ29sdW1uVHlwZTogInRpbnlpbnQoNCkifSwgImZha2VJbnQobiwgMSwgMTI3KSJ9LAoJCXtUbXBsRmllbGR7VHlwZTogImludDY0IiwgQ29sdW1uTmFtZTogInJhdGluZyIsIENvbHVtblR5cGU6ICJpbnQoMTEpIn0sICJmYWtlSW50KG4sIDIsIDkpIn0sCgkJe1RtcGxGaWVsZHtUeXBlOiAiTnVsbEludDY0IiwgQ29sdW1uTmFtZTogInJhbmsiLCBDb2x1bW5UeXBlOiAic21hbGxpbnQoNSkgdW5zaWduZWQifSwgIm1vZGVscy5OdWxsSW50NjR7SW50NjQ6IGZha2VJbnQobiwgMSwgNjU1MzUpLCBWYWxpZDogdHJ1ZX0ifSwKCQl7VG1wbEZpZWxke1R5cGU6ICJmbG9hdDY0IiwgQ29sdW1uTmFtZTogInByaWNlIiwgQ29sdW1uVHlwZTogImRlY2ltYWwoNSwyKSJ9LCAiZmFrZUZsb2F0KG4sIDMsIDIpIn0sCgkJe1RtcGxGaWVsZHtUeXBlOiAic3RyaW5nIiwgQ29sdW1uTmFtZTogImVtYWlsIiwgQ29sdW1uVHlwZTogInZhcmNoYXIoNjQpIn0sIGBmYWtlRW1haWwoInVzZXIiLCBuLCA2NClgfSwKCQl7VG1wbEZpZWxke1R5cGU6ICJzdHJpbmciLCBDb2x1bW5OYW1lOiAidG9rZW4iLCBDb2x1bW5UeXBlOiAiY2hhcigzNikifSwgImZha2VVVUlEKG4pIn0sCgkJe1RtcGxGaWVsZHtUeXBlOiAiTnVsbFN0cmluZyIsIENvbHVtbk5hbWU6ICJiaW8iLCBDb2x1bW5UeXBlOiAidGV4dCJ9LCBgbW9kZWxzLk51bGxTdHJpbmd7U3RyaW5nOiBmYWtlVGV4dCgiYmlvIiwgbiwgNjU1MzUpLCBWYWxpZDogdHJ1ZX1gfSwKCQl7VG1wbEZpZWxke1R5cGU6ICJzdHJpbmciLCBDb2x1bW5OYW1lOiAic3RhdHVzIiwgQ29sdW1uVHlwZTogImVudW0oJ29uJywnb2ZmJykifSwgYGZha2VQaWNrKG4sICJvbiIsICJvZmYiKWB9LAoJCXtUbXBsRmllbGR7VHlwZTogIltdYnl0ZSIsIENvbHVtbk5hbWU6ICJmbGFncyIsIENvbHVtblR5cGU6ICJiaXQoMSkifSwgImZha2VCaXRzKG4pIn0sCgkJe1RtcGxGaWVsZHtUeXBlOiAidGltZS5UaW1lIiwgQ29sdW1uTmFtZTogImJvcm4iLCBDb2x1bW5UeXBlOiAiZGF0ZSJ9LCAiZmFrZURhdGUobikifSwKCQl7VG1wbEZpZWxke1R5cGU6ICJSYXdKU09OIiwgQ29sdW1uTmFtZTogIm1ldGEiLCBDb2x1bW5UeXBlOiAianNvbiJ9LCAiZmFrZUpTT04obikifSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJaWYgZ290IDo9IEdldEZha2VWYWx1ZSgibW9kZWxzIiwgbSwgdHQuZmwpOyBnb3QgIT0gdHQud2FudCB7CgkJCXQuRXJyb3JmKCJHZXRGYWtlVmFsdWUoJXMgJXMpID0gJXMsIHdhbnQgJXMiLCB0dC5mbC5Db2x1bW5OYW1lLCB0dC5mbC5Db2x1bW5UeXBlLCBnb3QsIHR0LndhbnQpCgkJfQoJfQp9CgpmdW5jIFRlc3RHZXRGYWN0b3J5KHQgKnRlc3RpbmcuVCkgewoJdXNlciA6PSBUbXBsU3RydWN0ewoJCU5hbWU6ICAgICAgIlVzZXIiLAoJCVRhYmxlTmFtZTogInVzZXIiLAoJCUZpZWxkczogW11UbXBsRmllbGR7CgkJCXtOYW1lOiAiSUQiLCBUeXBlOiAiaW50NjQiLCBDb2x1bW5OYW1lOiAiaWQiLCBDb2x1bW5UeXBlOiAiYmlnaW50KDIwKSB1bnNpZ25lZCIsIEF1dG9JbmM6IHRydWV9LAoJCQl7TmFtZTogIk1hbmFnZXJJRCIsIFR5cGU6ICJpbnQ2NCIsIENvbHVtbk5hbWU6ICJtYW5hZ2VyX2lkIiwgQ29sdW1uVHlwZTogImJpZ2ludCgyMCkgdW5zaWduZWQifSwKCQl9LAoJCUZvcmVpZ25LZXlzOiBbXVRtcGxGb3JlaWduS2V5e3tOYW1lOiAidXNlcl9tYW5hZ2VyIiwgQ29sdW1uOiAibWFuYWdlcl9pZCIsIFJlZlRhYmxlOiAidXNlciIsIFJlZkNvbHVtbjogImlkIn19LAoJfQoJb3JkZXIgOj0gVG1wbFN0cnVjdHsKCQlOYW1lOiAgICAgICJPcmRlciIsCgkJVGFibGVOYW1lOiAib3JkZXIiLAoJCUZpZWxkczogW11UbXBsRmllbGR7CgkJCXtOYW1lOiAiSUQiLCBUeXBlOiAiaW50NjQiLCBDb2x1bW5OYW1lOiAiaWQiLCBDb2x1bW5UeXBlOiAiYmlnaW50KDIwKSB1bnNpZ25lZCIsIEF1dG9JbmM6IHRydWV9LAoJCQl7TmFtZTogIlVzZXJJRCIsIFR5cGU6ICJpbnQ2NCIsIENvbHVtbk5hbWU6ICJ1c2VyX2lkIiwgQ29sdW1uVHlwZTogImJpZ2ludCgyMCkgdW5zaWduZWQifSwKCQkJe05hbWU6ICJSZXZpZXdlcklEIiwgVHlwZTogIk51bGxJbnQ2NCIsIENvbHVtbk5hbWU6ICJyZXZpZXdlcl9pZCIsIENvbHVtblR5cGU6ICJiaWdpbnQoMjApIHVuc2lnbmVkIiwgTnVsbGFibGU6IHRydWV9LAoJCQl7TmFtZTogIk5vdGUiLCBUeXBlOiAic3RyaW5nIiwgQ29sdW1uTmFtZTogIm5vdGUiLCBDb2x1bW5UeXBlOiAidmFyY2hhcigxNikifSwKCQkJe05hbWU6ICJEZWxldGVkQXQiLCBUeXBlOiAiTnVsbFRpbWUiLCBDb2x1bW5OYW1lOiAiZGVsZXRlZF9hdCIsIENvbHVtblR5cGU6ICJkYXRldGltZSJ9LAoJCQl7TmFtZTogIlZlcnNpb24iLCBUeXBlOiAiaW50NjQiLCBDb2x1bW5OYW1lOiAidmVyc2lvbiIsIENvbHVtblR5cGU6ICJpbnQoMTEpIn0sCgkJfSwKCQlGb3JlaWduS2V5czogW11UbXBsRm9yZWlnbktleXsKCQkJe05hbWU6ICJvcmRlcl91c2VyIiwgQ29sdW1uOiAidXNlcl9pZCIsIFJlZlRhYmxlOiAidXNlciIsIFJlZkNvbHVtbjogImlkIn0sCgkJCXtOYW1lOiAib3JkZXJfcmV2aWV3ZXIiLCBDb2x1bW46ICJyZXZpZXdlcl9pZCIsIFJlZlRhYmxlOiAidXNlciIsIFJlZkNvbHVtbjogImlkIn0sCgkJfSwKCX0KCW1vZGVscyA6PSBbXVRtcGxTdHJ1Y3R7dXNlciwgb3JkZXJ9CgoJZiA6PSBHZXRGYWN0b3J5KG9yZGVyLCBtb2RlbHMsICJleGFtcGxlLmNvbS9tb2RlbHMiLCAibW9kZWxzIiwgImRlbGV0ZWRfYXQiLCAidmVyc2lvbiIpCglpZiBsZW4oZi5QYXJlbnRzKSAhPSAxIHx8IGYuUGFyZW50c1swXS5GaWVsZC5OYW1lICE9ICJVc2VySUQiIHx8IGYuUGFyZW50c1swXS5Nb2RlbC5OYW1lICE9ICJVc2VyIiB7CgkJdC5FcnJvcmYoIm9yZGVyIHBhcmVudHMgPSAlK3YiLCBmLlBhcmVudHMpCgl9CglpZiBsZW4oZi5GaWVsZHMpICE9IDEgfHwgZi5GaWVsZHNbMF0uRmllbGQuTmFtZSAhPSAiTm90ZSIgewoJCXQuRXJyb3JmKCJvcmRlciBmaWVsZHMgPSAlK3YiLCBmLkZpZWxkcykKCX0KCgkvLyBhIHJvdyByZWZlcmVuY2luZyBpdHMgb3duIHRhYmxlIGNhbm5vdCBoYXZlIGl0cyBwYXJlbnQgaW5zZXJ0ZWQgZmlyc3QKCWYgPSBHZXRGYWN0b3J5KHVzZXIsIG1vZGVscywgImV4YW1wbGUuY29tL21vZGVscyIsICJtb2RlbHMiLCAiIiwgIiIpCglpZiBsZW4oZi5QYXJlbnRzKSAhPSAwIHx8IGxlbihmLkZpZWxkcykgIT0gMSB8fCBmLkZpZWxkc1swXS5GaWVsZC5OYW1lICE9ICJNYW5hZ2VySUQiIHsKCQl0LkVycm9yZigidXNlciBmYWN0b3J5ID0gJSt2IiwgZikKCX0KfQo=\"")
+	packr.PackJSONBytes("./tmpl", "fixtures.html", "\"e3tkZWZpbmUgImZpeHR1cmVzIn19CnBhY2thZ2Uge3sgLlBhY2thZ2VOYW1lIH19CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBDb2RlIGdlbmVyYXRlZCBieSBtb2RlbGdlbiB8CnwgICAgICAgIERPIE5PVCBFRElULiAgICAgICAgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8Ke3sgaWYgLkltcG9ydHMgfX0KaW1wb3J0ICgKICAgIHt7LSByYW5nZSAuSW1wb3J0cyB9fQogICAgInt7IC4gfX0iCiAgICB7ey0gZW5kIH19CikKe3sgZW5kIH19Ci8vIHt7Lk1vZGVsLk5hbWV9fUZpeHR1cmVzIGhvbGRzIHRoZSByb3dzIG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwgYXMgZHVtcGVkIGJ5IG1vZGVsZ2VuIGR1bXAuCnZhciB7ey5Nb2RlbC5OYW1lfX1GaXh0dXJlcyA9IFtde3suTW9kZWwuTmFtZX19ewp7ey0gcmFuZ2UgLlJvd3MgfX0KICAgIHsKICAgIHt7LSByYW5nZSAuIH19CiAgICAgICAge3sgLkZpZWxkLk5hbWUgfX06IHt7IC5WYWx1ZSB9fSwKICAgIHt7LSBlbmQgfX0KICAgIH0sCnt7LSBlbmQgfX0KfQp7e2VuZH19Cg==\"")
+	packr.PackJSONBytes("./tmpl", "graphql.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImJ5dGVzIgoJImVuY29kaW5nL2pzb24iCgkic3RyaW5ncyIKKQoKLy8gVG1wbEdyYXBoUUxUeXBlIGRlZmluZXMgdGhlIEdyYXBoUUwgb2JqZWN0IHR5cGUgb2YgYSBtb2RlbCwgYW5kIHRoZSBmaWVsZHMgb2YgdGhlIFF1ZXJ5IHR5cGUKLy8gbG9hZGluZyBvbmUgcm93IG9mIHRoZSBtb2RlbCBieSBpZCwgU2luZ2xlLCBvciBwYWdpbmF0aW5nIHRocm91Z2ggYWxsIG9mIHRoZW0sIFBsdXJhbC4KdHlwZSBUbXBsR3JhcGhRTFR5cGUgc3RydWN0IHsKCU1vZGVsICBUbXBsU3RydWN0CglTaW5nbGUgc3RyaW5nCglQbHVyYWwgc3RyaW5nCglGaWVsZHMgW11UbXBsR3JhcGhRTEZpZWxkCglFbnVtcyAgW11UbXBsR3JhcGhRTEVudW0KCS8vIFJlZnMgcmVzb2x2ZSB0aGUgZm9yZWlnbiBrZXlzIG9mIHRoZSBtb2RlbCB0byB0aGUgcm93cyB0aGV5IHJlZmVyZW5jZS4KCVJlZnMgW11UbXBsR3JhcGhRTFJlZgoJLy8gTGlzdHMgcmVzb2x2ZSB0aGUgZm9yZWlnbiBrZXlzIHJlZmVyZW5jaW5nIHRoZSBtb2RlbCB0byBjb25uZWN0aW9ucyBvZiB0aGUgcm93cyByZWZlcmVuY2luZyBpdC4KCUxpc3RzIFtdVG1wbEdyYXBoUUxSZWYKfQoKLy8gVG1wbEdyYXBoUUxGaWVsZCBkZWZpbmVzIHRoZSBHcmFwaFFMIGZpZWxkIG9mIGEgY29sdW1uOiBpdHMgbmFtZSBhbmQgdHlwZSBpbiB0aGUgc2NoZW1hLAovLyB0aGUgR28gdHlwZSBpdHMgcmVzb2x2ZXIgcmV0dXJucyBhbmQgdGhlIGV4cHJlc3Npb24gY29udmVydGluZyB0aGUgbW9kZWwgZmllbGQgdG8gaXQuCnR5cGUgVG1wbEdyYXBoUUxGaWVsZCBzdHJ1Y3QgewoJRmllbGQgIFRtcGxGaWVsZAoJTmFtZSAgIHN0cmluZwoJVHlwZSAgIHN0cmluZwoJR29UeXBlIHN0cmluZwoJVmFsdWUgIHN0cmluZwp9CgovLyBUbXBsR3JhcGhRTEVudW0gZGVmaW5lcyB0aGUgR3JhcGhRTCBlbnVtIG9mIGFuIGVudW0gY29sdW1uLgp0eXBlIFRtcGxHcmFwaFFMRW51bSBzdHJ1Y3QgewoJTmFtZSAgIHN0cmluZwoJVmFsdWVzIFtdVG1wbEdyYXBoUUxFbnVtVmFsdWUKfQoKLy8gVG1wbEdyYXBoUUxFbnVtVmFsdWUgcGFpcnMgdGhlIG5hbWUgb2YgYSBHcmFwaFFMIGVudW0gdmFsdWUgd2l0aCB0aGUgY29sdW1uIHZhbHVlIGl0IHN0YW5kcyBmb3IuCnR5cGUgVG1wbEdyYXBoUUxFbnVtVmFsdWUgc3RydWN0IHsKCU5hbWUgIHN0cmluZwoJVmFsdWUgc3RyaW5nCn0KCi8vIFRtcGxHcmFwaFFMUmVmIGRlZmluZXMgYSBHcmFwaFFMIGZpZWxkIGZvbGxvd2luZyBhIGZvcmVpZ24ga2V5OiBDb2x1bW4gaXMKLy8gdGhlIGZvcmVpZ24ga2V5IGNvbHVtbiBhbmQgTW9kZWwgdGhlIG1vZGVsIG9uIHRoZSBvdGhlciBzaWRlIG9mIGl0Lgp0eXBlIFRtcGxHcmFwaFFMUmVmIHN0cnVjdCB7CglOYW1lICAgc3RyaW5nCglDb2x1bW4gVG1wbEZpZWxkCglNb2RlbCAgVG1wbFN0cnVjdAp9CgovLyBHZXRHcmFwaFFMVHlwZXMgcmV0dXJucyB0aGUgR3JhcGhRTCB0eXBlcyBvZiBhIHNldCBvZiBtb2RlbHMsIHdpdGggdGhlaXIgcmVzb2x2ZXJzCi8vIHJlYWRpbmcgZmllbGRzIGZyb20gdGhlIG1vZGVsIG5hbWVkIHZhbHVlLiBPbmx5IHRoZSBmb3JlaWduIGtleXMgb2YgYW4gaW50ZWdlciBjb2x1bW4KLy8gcmVmZXJlbmNpbmcgdGhlIGlkIG9mIGFub3RoZXIgbW9kZWwgYmVjb21lIGZpZWxkcywgYW5kIGZpZWxkcyB3aG9zZSBuYW1lcyBjbGFzaAovLyB3aXRoIHRob3NlIG9mIHRoZSBjb2x1bW5zIGFyZSBsZWZ0IG91dC4KZnVuYyBHZXRHcmFwaFFMVHlwZXMobW9kZWxzIFtdVG1wbFN0cnVjdCwgdmFsdWUgc3RyaW5nKSBbXVRtcGxHcmFwaFFMVHlwZSB7CglieVRhYmxlIDo9IG1ha2UobWFwW3N0cmluZ11UbXBsU3RydWN0KQoJZm9yIF8sIG0gOj0gcmFuZ2UgbW9kZWxzIHsKCQlieVRhYmxlW20uVGFibGVOYW1lXSA9IG0KCX0KCXR5cGUgZmsgc3RydWN0IHsKCQlrZXkgICAgVG1wbEZvcmVpZ25LZXkKCQlmcm9tICAgVG1wbFN0cnVjdAoJCWNvbHVtbiBUbXBsRmllbGQKCQl0byAgICAgVG1wbFN0cnVjdAoJfQoJdmFyIGZrcyBbXWZrCglmb3IgXywgbSA6PSByYW5nZSBtb2RlbHMgewoJCWZvciBfLCBrZXkgOj0gcmFuZ2UgbS5Gb3JlaWduS2V5cyB7CgkJCXRvLCBvayA6PSBieVRhYmxlW2tleS5SZWZUYWJsZV0KCQkJY29sLCBmb3VuZCA6PSBmaWVsZEJ5Q29sdW1uKG0uRmllbGRzLCBrZXkuQ29sdW1uKQoJCQlpZiAhb2sgfHwgIWZvdW5kIHx8IGtleS5SZWZDb2x1bW4gIT0gImlkIiB8fCAhSGFzQ29sdW1uKHRvLkZpZWxkcywgImlkIikgewoJCQkJY29udGludWUKCQkJfQoJCQlpZiBjb2wuVHlwZSAhPSAiaW50NjQiICYmIGNvbC5UeXBlICE9ICJOdWxsSW50NjQiIHsKCQkJCWNvbnRpbnVlCgkJCX0KCQkJZmtzID0gYXBwZW5kKGZrcywgZmt7a2V5LCBtLCBjb2wsIHRvfSkKCQl9Cgl9CgoJdmFyIHR5cGVzIFtdVG1wbEdyYXBoUUxUeXBlCglmb3IgXywgbSA6PSByYW5nZSBtb2RlbHMgewoJCXQgOj0gVG1wbEdyYXBoUUxUeXBle01vZGVsOiBtLCBTaW5nbGU6IGdyYXBoUUxOYW1lKG0uTmFtZSl9CgkJdC5QbHVyYWwgPSBwbHVyYWxOYW1lKHQuU2luZ2xlKQoJCXRha2VuIDo9IG1ha2UobWFwW3N0cmluZ11ib29sKQoJCWFkZCA6PSBmdW5jKG5hbWUgc3RyaW5nKSBib29sIHsKCQkJayA6PSBzdHJpbmdzLlRvTG93ZXIoc3RyaW5ncy5SZXBsYWNlKG5hbWUsICJfIiwgIiIsIC0xKSkKCQkJaWYgdGFrZW5ba10gewoJCQkJcmV0dXJuIGZhbHNlCgkJCX0KCQkJdGFrZW5ba10gPSB0cnVlCgkJCXJldHVybiB0cnVlCgkJfQoKCQlmb3IgXywgZmwgOj0gcmFuZ2UgbS5GaWVsZHMgewoJCQlmIDo9IGdyYXBoUUxGaWVsZChmbCwgdmFsdWUrIi4iK2ZsLk5hbWUpCgkJCWlmIGZsLkNvbHVtbk5hbWUgPT0gImlkIiB7CgkJCQlmLlR5cGUsIGYuR29UeXBlLCBmLlZhbHVlID0gIklEISIsICJncmFwaHFsLklEIiwgImdxbElEKCIrdmFsdWUrIi4iK2ZsLk5hbWUrIikiCgkJCX0KCQkJZm9yIF8sIGsgOj0gcmFuZ2UgZmtzIHsKCQkJCWlmIGsuZnJvbS5UYWJsZU5hbWUgPT0gbS5UYWJsZU5hbWUgJiYgay5jb2x1bW4uQ29sdW1uTmFtZSA9PSBmbC5Db2x1bW5OYW1lIHsKCQkJCQlmID0gZ3JhcGhRTElERmllbGQoZmwsIHZhbHVlKyIuIitmbC5OYW1lKQoJCQkJfQoJCQl9CgkJCWlmIGJhc2UsIGFyZ3MsIF8gOj0gcGFyc2VDb2x1bW5UeXBlKGZsLkNvbHVtblR5cGUpOyBiYXNlID09ICJlbnVtIiAmJiAoZmwuVHlwZSA9PSAic3RyaW5nIiB8fCBmbC5UeXBlID09ICJOdWxsU3RyaW5nIikgewoJCQkJaWYgZW51bSwgb2sgOj0gZ3JhcGhRTEVudW0obS5OYW1lK2ZsLk5hbWUsIHF1b3RlZFZhbHVlcyhhcmdzKSk7IG9rIHsKCQkJCQl0LkVudW1zID0gYXBwZW5kKHQuRW51bXMsIGVudW0pCgkJCQkJZi5UeXBlLCBmLkdvVHlwZSA9IGVudW0uTmFtZSwgInN0cmluZyIKCQkJCQlmLlZhbHVlID0gImdxbEVudW0oIiArIHZhbHVlICsgIi4iICsgZmwuTmFtZSArICIpIgoJCQkJCWlmIGZsLlR5cGUgPT0gIk51bGxTdHJpbmciIHsKCQkJCQkJZi5Hb1R5cGUgPSAiKnN0cmluZyIKCQkJCQkJZi5WYWx1ZSA9ICJncWxOdWxsRW51bSgiICsgdmFsdWUgKyAiLiIgKyBmbC5OYW1lICsgIikiCgkJCQkJfSBlbHNlIHsKCQkJCQkJZi5UeXBlICs9ICIhIgoJCQkJCX0KCQkJCX0KCQkJfQoJCQlmLk5hbWUgPSBncmFwaFFMTmFtZShmbC5Db2x1bW5OYW1lKQoJCQlhZGQoZi5OYW1lKQoJCQl0LkZpZWxkcyA9IGFwcGVuZCh0LkZpZWxkcywgZikKCQl9CgoJCWZvciBfLCBrIDo9IHJhbmdlIGZrcyB7CgkJCWlmIGsuZnJvbS5UYWJsZU5hbWUgPT0gbS5UYWJsZU5hbWUgewoJCQkJbmFtZSA6PSBncmFwaFFMTmFtZShzdHJpbmdzLlRyaW1TdWZmaXgoay5jb2x1bW4uQ29sdW1uTmFtZSwgIl9pZCIpKQoJCQkJaWYgbmFtZSA9PSBncmFwaFFMTmFtZShrLmNvbHVtbi5Db2x1bW5OYW1lKSB7CgkJCQkJbmFtZSArPSAiUmVmIgoJCQkJfQoJCQkJaWYgYWRkKG5hbWUpIHsKCQkJCQl0LlJlZnMgPSBhcHBlbmQodC5SZWZzLCBUbXBsR3JhcGhRTFJlZntOYW1lOiBuYW1lLCBDb2x1bW46IGsuY29sdW1uLCBNb2RlbDogay50b30pCgkJCQl9CgkJCX0KCQl9CgkJZm9yIF8sIGsgOj0gcmFuZ2UgZmtzIHsKCQkJaWYgay50by5UYWJsZU5hbWUgIT0gbS5UYWJsZU5hbWUgewoJCQkJY29udGludWUKCQkJfQoJCQluYW1lIDo9IHBsdXJhbE5hbWUoZ3JhcGhRTE5hbWUoay5mcm9tLk5hbWUpKQoJCQlmb3IgXywgb3RoZXIgOj0gcmFuZ2UgZmtzIHsKCQkJCWlmIG90aGVyLnRvLlRhYmxlTmFtZSA9PSBtLlRhYmxlTmFtZSAmJiBvdGhlci5mcm9tLlRhYmxlTmFtZSA9PSBrLmZyb20uVGFibGVOYW1lICYmIG90aGVyLmNvbHVtbi5Db2x1bW5OYW1lICE9IGsuY29sdW1uLkNvbHVtbk5hbWUgewoJCQkJCW5hbWUgKz0gIkJ5IiArIGsuY29sdW1uLk5hbWUKCQkJCQlicmVhawoJCQkJfQoJCQl9CgkJCWlmIGFkZChuYW1lKSB7CgkJCQl0Lkxpc3RzID0gYXBwZW5kKHQuTGlzdHMsIFRtcGxHcmFwaFFMUmVme05hbWU6IG5hbWUsIENvbHVtbjogay5jb2x1bW4sIE1vZGVsOiBrLmZyb219KQoJCQl9CgkJfQoJCXR5cGVzID0gYXBwZW5kKHR5cGVzLCB0KQoJfQoJcmV0dXJuIHR5cGVzCn0KCmZ1bmMgZmllbGRCeUNvbHVtbihmaWVsZHMgW11UbXBsRmllbGQsIGNvbHVtbiBzdHJpbmcpIChUbXBsRmllbGQsIGJvb2wpIHsKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCWlmIGZsLkNvbHVtbk5hbWUgPT0gY29sdW1uIHsKCQkJcmV0dXJuIGZsLCB0cnVlCgkJfQoJfQoJcmV0dXJuIFRtcGxGaWVsZHt9LCBmYWxzZQp9CgovLyBncmFwaFFMRmllbGQgcmV0dXJucyB0aGUgR3JhcGhRTCB0eXBlIG9mIGEgZmllbGQsIGFuZCBob3cgaXRzIHJlc29sdmVyIGNvbnZlcnRzIGl0LgpmdW5jIGdyYXBoUUxGaWVsZChmbCBUbXBsRmllbGQsIHZhbHVlIHN0cmluZykgVG1wbEdyYXBoUUxGaWVsZCB7CglmIDo9IFRtcGxHcmFwaFFMRmllbGR7RmllbGQ6IGZsLCBWYWx1ZTogdmFsdWV9CgliYXNlLCBfLCB1bnNpZ25lZCA6PSBwYXJzZUNvbHVtblR5cGUoZmwuQ29sdW1uVHlwZSkKCWludDMycyA6PSBiYXNlID09ICJ0aW55aW50IiB8fCBiYXNlID09ICJzbWFsbGludCIgfHwgYmFzZSA9PSAibWVkaXVtaW50IiB8fCAoYmFzZSA9PSAiaW50IiAmJiAhdW5zaWduZWQpCglzd2l0Y2ggZmwuVHlwZSB7CgljYXNlICJpbnQ2NCI6CgkJaWYgaW50MzJzIHsKCQkJZi5UeXBlLCBmLkdvVHlwZSwgZi5WYWx1ZSA9ICJJbnQhIiwgImludDMyIiwgImludDMyKCIrdmFsdWUrIikiCgkJfSBlbHNlIHsKCQkJZi5UeXBlLCBmLkdvVHlwZSwgZi5WYWx1ZSA9ICJJbnQ2NCEiLCAiSW50NjQiLCAiSW50NjQoIit2YWx1ZSsiKSIKCQl9CgljYXNlICJOdWxsSW50NjQiOgoJCWlmIGludDMycyB7CgkJCWYuVHlwZSwgZi5Hb1R5cGUsIGYuVmFsdWUgPSAiSW50IiwgIippbnQzMiIsICJncWxOdWxsSW50MzIoIit2YWx1ZSsiKSIKCQl9IGVsc2UgewoJCQlmLlR5cGUsIGYuR29UeXBlLCBmLlZhbHVlID0gIkludDY0IiwgIipJbnQ2NCIsICJncWxOdWxsSW50NjQoIit2YWx1ZSsiKSIKCQl9CgljYXNlICJmbG9hdDY0IjoKCQlmLlR5cGUsIGYuR29UeXBlID0gIkZsb2F0ISIsICJmbG9hdDY0IgoJY2FzZSAiTnVsbEZsb2F0NjQiOgoJCWYuVHlwZSwgZi5Hb1R5cGUsIGYuVmFsdWUgPSAiRmxvYXQiLCAiKmZsb2F0NjQiLCAiZ3FsTnVsbEZsb2F0NjQoIit2YWx1ZSsiKSIKCWNhc2UgImJvb2wiOgoJCWYuVHlwZSwgZi5Hb1R5cGUgPSAiQm9vbGVhbiEiLCAiYm9vbCIKCWNhc2UgIk51bGxCb29sIjoKCQlmLlR5cGUsIGYuR29UeXBlLCBmLlZhbHVlID0gIkJvb2xlYW4iLCAiKmJvb2wiLCAiZ3FsTnVsbEJvb2woIit2YWx1ZSsiKSIKCWNhc2UgInRpbWUuVGltZSI6CgkJZi5UeXBlLCBmLkdvVHlwZSwgZi5WYWx1ZSA9ICJUaW1lISIsICJncmFwaHFsLlRpbWUiLCAiZ3FsVGltZSgiK3ZhbHVlKyIpIgoJY2FzZSAiTnVsbFRpbWUiOgoJCWYuVHlwZSwgZi5Hb1R5cGUsIGYuVmFsdWUgPSAiVGltZSIsICIqZ3JhcGhxbC5UaW1lIiwgImdxbE51bGxUaW1lKCIrdmFsdWUrIikiCgljYXNlICJbXWJ5dGUiOgoJCS8vIGJhc2U2NCBlbmNvZGVkLCBvciBudWxsIHdoZW4gbmlsCgkJZi5UeXBlLCBmLkdvVHlwZSwgZi5WYWx1ZSA9ICJTdHJpbmciLCAiKnN0cmluZyIsICJncWxCeXRlcygiK3ZhbHVlKyIpIgoJY2FzZSAiUmF3SlNPTiI6CgkJZi5UeXBlLCBmLkdvVHlwZSwgZi5WYWx1ZSA9ICJKU09OIiwgIipSYXdKU09OIiwgImdxbEpTT04oIit2YWx1ZSsiKSIKCWNhc2UgIk51bGxTdHJpbmciOgoJCWYuVHlwZSwgZi5Hb1R5cGUsIGYuVmFsdWUgPSAiU3RyaW5nIiwgIipzdHJpbmciLCAiZ3FsTnVsbFN0cmluZygiK3ZhbHVlKyIpIgoJZGVmYXVsdDoKCQlmLlR5cGUsIGYuR29UeXBlID0gIlN0cmluZyEiLCAic3RyaW5nIgoJfQoJcmV0dXJuIGYKfQoKLy8gZ3JhcGhRTElERmllbGQgcmV0dXJucyB0aGUgR3JhcGhRTCBmaWVsZCBvZiBhIGZvcmVpZ24ga2V5IGNvbHVtbiwgYW4gSUQgYXMgdGhlIGlkIGl0IHJlZmVyZW5jZXMuCmZ1bmMgZ3JhcGhRTElERmllbGQoZmwgVG1wbEZpZWxkLCB2YWx1ZSBzdHJpbmcpIFRtcGxHcmFwaFFMRmllbGQgewoJaWYgZmwuVHlwZSA9PSAiTnVsbEludDY0IiB7CgkJcmV0dXJuIFRtcGxHcmFwaFFMRmllbGR7RmllbGQ6IGZsLCBUeXBlOiAiSUQiLCBHb1R5cGU6ICIqZ3JhcGhxbC5JRCIsIFZhbHVlOiAiZ3FsTnVsbElEKCIgKyB2YWx1ZSArICIpIn0KCX0KCXJldHVybiBUbXBsR3JhcGhRTEZpZWxke0ZpZWxkOiBmbCwgVHlwZTogIklEISIsIEdvVHlwZTogImdyYXBocWwuSUQiLCBWYWx1ZTogImdxbElEKCIgKyB2YWx1ZSArICIpIn0KfQoKLy8gZ3JhcGhRTEVudW0gcmV0dXJucyB0aGUgR3JhcGhRTCBlbnVtIG9mIHRoZSBtZW1iZXJzIG9mIGFuIGVudW0gY29sdW1uLCB3aXRoIHZhbHVlcwovLyBuYW1lZCBhZnRlciB0aGUgbWVtYmVycyBpbiB1cHBlciBjYXNlLiBJdCByZXBvcnRzIGZhbHNlIGlmIGEgbWVtYmVyIGhhcyBubyBzdWNoIG5hbWUuCmZ1bmMgZ3JhcGhRTEVudW0obmFtZSBzdHJpbmcsIG1lbWJlcnMgW11zdHJpbmcpIChUbXBsR3JhcGhRTEVudW0sIGJvb2wpIHsKCWVudW0gOj0gVG1wbEdyYXBoUUxFbnVte05hbWU6IG5hbWV9CglzZWVuIDo9IG1ha2UobWFwW3N0cmluZ11ib29sKQoJZm9yIF8sIG1lbWJlciA6PSByYW5nZSBtZW1iZXJzIHsKCQl2YWx1ZSA6PSBzdHJpbmdzLlRvVXBwZXIobWVtYmVyKQoJCWlmICF2YWxpZEdyYXBoUUxOYW1lKHZhbHVlKSB8fCBzZWVuW3ZhbHVlXSB8fCB2YWx1ZSA9PSAiVFJVRSIgfHwgdmFsdWUgPT0gIkZBTFNFIiB8fCB2YWx1ZSA9PSAiTlVMTCIgewoJCQlyZXR1cm4gVG1wbEdyYXBoUUxFbnVte30sIGZhbHNlCgkJfQoJCXNlZW5bdmFsdWVdID0gdHJ1ZQoJCWVudW0uVmFsdWVzID0gYXBwZW5kKGVudW0uVmFsdWVzLCBUbXBsR3JhcGhRTEVudW1WYWx1ZXtOYW1lOiB2YWx1ZSwgVmFsdWU6IG1lbWJlcn0pCgl9CglyZXR1cm4gZW51bSwgbGVuKGVudW0uVmFsdWVzKSA+IDAKfQoKZnVuYyB2YWxpZEdyYXBoUUxOYW1lKG5hbWUgc3RyaW5nKSBib29sIHsKCWlmIG5hbWUgPT0gIiIgfHwgaXNEaWdpdChuYW1lWzBdKSB7CgkJcmV0dXJuIGZhbHNlCgl9Cglmb3IgaSA6PSAwOyBpIDwgbGVuKG5hbWUpOyBpKysgewoJCWMgOj0gbmFtZVtpXQoJCWlmICFpc0xvd2VyKGMpICYmICFpc0RpZ2l0KGMpICYmIGMgIT0gJ18nICYmIChjIDwgJ0EnIHx8IGMgPiAnWicpIHsKCQkJcmV0dXJuIGZhbHNlCgkJfQoJfQoJcmV0dXJuIHRydWUKfQoKLy8gZ3JhcGhRTE5hbWUgcmV0dXJucyB0aGUgbG93ZXIgY2FtZWwgY2FzZSBHcmFwaFFMIG5hbWUgb2YgYSBjb2x1bW4gb3IgbW9kZWwgbmFtZSwKLy8gcmVwbGFjaW5nIHRoZSBjaGFyYWN0ZXJzIGEgR3JhcGhRTCBuYW1lIGNhbm5vdCBob2xkLgpmdW5jIGdyYXBoUUxOYW1lKHMgc3RyaW5nKSBzdHJpbmcgewoJdmFyIGIgW11ieXRlCgl1cHBlciA6PSBmYWxzZQoJZm9yIGkgOj0gMDsgaSA8IGxlbihzKTsgaSsrIHsKCQljIDo9IHNbaV0KCQlzd2l0Y2ggewoJCWNhc2UgYyA9PSAnXycgfHwgYyA9PSAnICcgfHwgYyA9PSAnLSc6CgkJCXVwcGVyID0gbGVuKGIpID4gMAoJCQljb250aW51ZQoJCWNhc2UgIWlzTG93ZXIoYykgJiYgIWlzRGlnaXQoYykgJiYgKGMgPCAnQScgfHwgYyA+ICdaJyk6CgkJCWMgPSAnXycKCQljYXNlIGxlbihiKSA9PSAwOgoJCQljID0gc3RyaW5ncy5Ub0xvd2VyKHN0cmluZyhjKSlbMF0KCQljYXNlIHVwcGVyICYmIGlzTG93ZXIoYyk6CgkJCWMgXj0gJyAnCgkJfQoJCXVwcGVyID0gZmFsc2UKCQliID0gYXBwZW5kKGIsIGMpCgl9CglpZiBsZW4oYikgPT0gMCB8fCBpc0RpZ2l0KGJbMF0pIHsKCQliID0gYXBwZW5kKFtdYnl0ZXsnXyd9LCBiLi4uKQoJfQoJcmV0dXJuIHN0cmluZyhiKQp9CgovLyBwbHVyYWxOYW1lIG5hbWVzIHRoZSBmaWVsZHMgaG9sZGluZyBjb25uZWN0aW9ucyBvZiByb3dzLCB3aGljaAovLyBnZXQgYSBMaXN0IHN1ZmZpeCB3aGVuIHRoZSBuYW1lIGxvb2tzIGxpa2UgYSBwbHVyYWwgYWxyZWFkeS4KZnVuYyBwbHVyYWxOYW1lKG5hbWUgc3RyaW5nKSBzdHJpbmcgewoJc3dpdGNoIHsKCWNhc2Ugc3RyaW5ncy5IYXNTdWZmaXgobmFtZSwgInMiKToKCQlyZXR1cm4gbmFtZSArICJMaXN0IgoJY2FzZSBzdHJpbmdzLkhhc1N1ZmZpeChuYW1lLCAieSIpICYmIGxlbihuYW1lKSA+IDEgJiYgIXN0cmluZ3MuQ29udGFpbnNSdW5lKCJhZWlvdSIsIHJ1bmUobmFtZVtsZW4obmFtZSktMl0pKToKCQlyZXR1cm4gbmFtZVs6bGVuKG5hbWUpLTFdICsgImllcyIKCWRlZmF1bHQ6CgkJcmV0dXJuIG5hbWUgKyAicyIKCX0KfQoKLy8gR2V0R3JhcGhRTFNpbmdsZSByZXR1cm5zIHRoZSBuYW1lIG9mIHRoZSBRdWVyeSBmaWVsZCBsb2FkaW5nIGEgcm93IG9mIGEgbW9kZWwgYnkgaWQuCmZ1bmMgR2V0R3JhcGhRTFNpbmdsZShtIFRtcGxTdHJ1Y3QpIHN0cmluZyB7CglyZXR1cm4gZ3JhcGhRTE5hbWUobS5OYW1lKQp9CgovLyBHcmFwaFFMU3RyaW5nIHF1b3RlcyBhIEdyYXBoUUwgZGVzY3JpcHRpb24sIHVzaW5nIG9ubHkgZXNjYXBlcwovLyB3aGljaCBhcmUgdmFsaWQgd2l0aGluIEdvIHJhdyBzdHJpbmdzIGFzIHdlbGwuCmZ1bmMgR3JhcGhRTFN0cmluZyhzIHN0cmluZykgc3RyaW5nIHsKCWJ1ZiA6PSBuZXcoYnl0ZXMuQnVmZmVyKQoJZW5jIDo9IGpzb24uTmV3RW5jb2RlcihidWYpCgllbmMuU2V0RXNjYXBlSFRNTChmYWxzZSkKCWVuYy5FbmNvZGUoQ29tbWVudFRleHQocykpCglyZXR1cm4gc3RyaW5ncy5SZXBsYWNlKHN0cmluZ3MuVHJpbVNwYWNlKGJ1Zi5TdHJpbmcoKSksICJgIiwgYFx1MDA2MGAsIC0xKQp9CgovLyBHcmFwaFFMRmllbGRNZXRob2QgcmV0dXJucyB0aGUgbmFtZSBvZiB0aGUgR28gbWV0aG9kIHJlc29sdmluZyBhIEdyYXBoUUwgZmllbGQuCmZ1bmMgR3JhcGhRTEZpZWxkTWV0aG9kKG5hbWUgc3RyaW5nKSBzdHJpbmcgewoJcmV0dXJuIHN0cmluZ3MuVG9VcHBlcihuYW1lWzoxXSkgKyBuYW1lWzE6XQp9Cg==\"")
+	packr.PackJSONBytes("./tmpl", "graphql_schema.html", "\"e3tkZWZpbmUgImdyYXBocWxzY2hlbWEifX0jIENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuLiBETyBOT1QgRURJVC4KCiJUaW1lIGlzIGFuIFJGQyAzMzM5IGRhdGUgYW5kIHRpbWUuIgpzY2FsYXIgVGltZQoKIkludDY0IGlzIGFuIGludGVnZXIgdG9vIGxhcmdlIGZvciBJbnQsIGVuY29kZWQgYXMgYSBzdHJpbmcuIgpzY2FsYXIgSW50NjQKCiJKU09OIGlzIGFueSBKU09OIHZhbHVlLCBhcyBzdG9yZWQgaW4gYSBKU09OIGNvbHVtbi4iCnNjYWxhciBKU09OCgp0eXBlIFF1ZXJ5IHsKe3stIHJhbmdlIC4gfX0KICAiVGhlIHt7IC5Nb2RlbC5OYW1lIH19IHdpdGggdGhlIGdpdmVuIGlkLCBpZiBhbnkuIgogIHt7IC5TaW5nbGUgfX0oaWQ6IElEISk6IHt7IC5Nb2RlbC5OYW1lIH19CiAgIkV2ZXJ5IHt7IC5Nb2RlbC5OYW1lIH19LCBvcmRlcmVkIGJ5IGlkLiIKICB7eyAuUGx1cmFsIH19KGZpcnN0OiBJbnQsIGFmdGVyOiBTdHJpbmcpOiB7eyAuTW9kZWwuTmFtZSB9fUNvbm5lY3Rpb24hCnt7LSBlbmQgfX0KfQoKIlBhZ2VJbmZvIHRlbGxzIHdoZXRoZXIgbW9yZSByb3dzIGZvbGxvdyBhIHBhZ2Ugb2YgYSBjb25uZWN0aW9uLCBhbmQgdGhlIGN1cnNvciB0byBsb2FkIHRoZW0gYWZ0ZXIuIgp0eXBlIFBhZ2VJbmZvIHsKICBlbmRDdXJzb3I6IFN0cmluZwogIGhhc05leHRQYWdlOiBCb29sZWFuIQp9Cnt7IHJhbmdlIC4gfX0KIkEgcm93IG9mIHRoZSB7eyAuTW9kZWwuVGFibGVOYW1lIH19IHRhYmxlLiIKdHlwZSB7eyAuTW9kZWwuTmFtZSB9fSB7Cnt7LSByYW5nZSAuRmllbGRzIH19CiAge3stIHdpdGggLkZpZWxkLkNvbW1lbnQgfX0KICB7eyBncmFwaHFsX3N0cmluZyAuIH19CiAge3stIGVuZCB9fQogIHt7IC5OYW1lIH19OiB7eyAuVHlwZSB9fQp7ey0gZW5kIH19Cnt7LSByYW5nZSAuUmVmcyB9fQogICJUaGUge3sgLk1vZGVsLk5hbWUgfX0ge3sgLkNvbHVtbi5Db2x1bW5OYW1lIH19IHJlZmVyZW5jZXMuIgogIHt7IC5OYW1lIH19OiB7eyAuTW9kZWwuTmFtZSB9fQp7ey0gZW5kIH19Cnt7LSByYW5nZSAuTGlzdHMgfX0KICAiVGhlIHt7IC5Nb2RlbC5OYW1lIH19IHJvd3MgcmVmZXJlbmNpbmcgdGhpcyBvbmUgYnkge3sgLkNvbHVtbi5Db2x1bW5OYW1lIH19LCBvcmRlcmVkIGJ5IGlkLiIKICB7eyAuTmFtZSB9fShmaXJzdDogSW50LCBhZnRlcjogU3RyaW5nKToge3sgLk1vZGVsLk5hbWUgfX1Db25uZWN0aW9uIQp7ey0gZW5kIH19Cn0KCnR5cGUge3sgLk1vZGVsLk5hbWUgfX1Db25uZWN0aW9uIHsKICBlZGdlczogW3t7IC5Nb2RlbC5OYW1lIH19RWRnZSFdIQogIG5vZGVzOiBbe3sgLk1vZGVsLk5hbWUgfX0hXSEKICBwYWdlSW5mbzogUGFnZUluZm8hCn0KCnR5cGUge3sgLk1vZGVsLk5hbWUgfX1FZGdlIHsKICBjdXJzb3I6IFN0cmluZyEKICBub2RlOiB7eyAuTW9kZWwuTmFtZSB9fSEKfQp7ey0gcmFuZ2UgLkVudW1zIH19CgplbnVtIHt7IC5OYW1lIH19IHsKe3stIHJhbmdlIC5WYWx1ZXMgfX0KICB7eyAuTmFtZSB9fQp7ey0gZW5kIH19Cn0Ke3stIGVuZCB9fQp7eyBlbmQgfX17eyBlbmQgfX0K\"")
+	packr.PackJSONBytes("./tmpl", "graphql_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgInRlc3RpbmciCgpmdW5jIFRlc3RHcmFwaFFMTmFtZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQlpbiwgbmFtZSwgcGx1cmFsIHN0cmluZwoJfXsKCQl7ImNyZWF0ZWRfYXQiLCAiY3JlYXRlZEF0IiwgImNyZWF0ZWRBdHMifSwKCQl7IlVzZXIiLCAidXNlciIsICJ1c2VycyJ9LAoJCXsiT3JkZXJJdGVtIiwgIm9yZGVySXRlbSIsICJvcmRlckl0ZW1zIn0sCgkJeyJDYXRlZ29yeSIsICJjYXRlZ29yeSIsICJjYXRlZ29yaWVzIn0sCgkJeyJEYXkiLCAiZGF5IiwgImRheXMifSwKCQl7IkFkZHJlc3MiLCAiYWRkcmVzcyIsICJhZGRyZXNzTGlzdCJ9LAoJCXsiMmZhIiwgIl8yZmEiLCAiXzJmYXMifSwKCQl7ImNvbC5uYW1lIiwgImNvbF9uYW1lIiwgImNvbF9uYW1lcyJ9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQluYW1lIDo9IGdyYXBoUUxOYW1lKHR0LmluKQoJCWlmIG5hbWUgIT0gdHQubmFtZSB7CgkJCXQuRXJyb3JmKCJncmFwaFFMTmFtZSglcSkgPSAlcSwgd2FudCAlcSIsIHR0LmluLCBuYW1lLCB0dC5uYW1lKQoJCX0KCQlpZiBwbHVyYWwgOj0gcGx1cmFsTmFtZShuYW1lKTsgcGx1cmFsICE9IHR0LnBsdXJhbCB7CgkJCXQuRXJyb3JmKCJwbHVyYWxOYW1lKCVxKSA9ICVxLCB3YW50ICVxIiwgbmFtZSwgcGx1cmFsLCB0dC5wbHVyYWwpCgkJfQoJfQp9CgpmdW5jIFRlc3RHZXRHcmFwaFFMVHlwZXModCAqdGVzdGluZy5UKSB7Cgl1c2VyIDo9IFRtcGxTdHJ1Y3R7CgkJTmFtZTogICAgICAiVXNlciIsCgkJVGFibGVOYW1lOiAidXNlciIsCgkJRmllbGRzOiBbXVRtcGxGaWVsZHsKCQkJe05hbWU6ICJJRCIsIFR5cGU6ICJpbnQ2NCIsIENvbHVtbk5hbWU6ICJpZCIsIENvbHVtblR5cGU6ICJiaWdpbnQoMjApIHVuc2lnbmVkIn0sCgkJCXtOYW1lOiAiU3RhdHVzIiwgVHlwZTogIk51bGxTdHJpbmciLCBDb2x1bW5OYW1lOiAic3RhdHVzIiwgQ29sdW1uVHlwZTogImVudW0oJ2FjdGl2ZScsJ2Jhbm5lZCcpIn0sCgkJCXtOYW1lOiAiTW9vZCIsIFR5cGU6ICJzdHJpbmciLCBDb2x1bW5OYW1lOiAibW9vZCIsIENvbHVtblR5cGU6ICJlbnVtKCdvaycsJ25vdCBvaycpIn0sCgkJCXtOYW1lOiAiQWdlIiwgVHlwZTogImludDY0IiwgQ29sdW1uTmFtZTogImFnZSIsIENvbHVtblR5cGU6ICJpbnQoMTEpIn0sCgkJfSwKCX0KCW9yZGVyIDo9IFRtcGxTdHJ1Y3R7CgkJTmFtZTogICAgICAiT3JkZXIiLAoJCVRhYmxlTmFtZTogIm9yZGVyIiwKCQlGaWVsZHM6IFtdVG1wbEZpZWxkewoJCQl7TmFtZTogIklEIiwgVHlwZTogImludDY0IiwgQ29sdW1uTmFtZTogImlkIiwgQ29sdW1uVHlwZTogImJpZ2ludCgyMCkgdW5zaWduZWQifSwKCQkJe05hbWU6ICJVc2VySUQiLCBUeXBlOiAiaW50NjQiLCBDb2x1bW5OYW1lOiAidXNlcl9pZCIsIENvbHVtblR5cGU6ICJiaWdpbnQoMjApIHVuc2lnbmVkIn0sCgkJCXtOYW1lOiAiUmV2aWV3ZXJJRCIsIFR5cGU6ICJOdWxsSW50NjQiLCBDb2x1bW5OYW1lOiAicmV2aWV3ZXJfaWQiLCBDb2x1bW5UeXBlOiAiYmlnaW50KDIwKSB1bnNpZ25lZCJ9LAoJCQl7TmFtZTogIkNvZGUiLCBUeXBlOiAic3RyaW5nIiwgQ29sdW1uTmFtZTogImNvZGUiLCBDb2x1bW5UeXBlOiAidmFyY2hhcig4KSJ9LAoJCX0sCgkJRm9yZWlnbktleXM6IFtdVG1wbEZvcmVpZ25LZXl7CgkJCXtOYW1lOiAib3JkZXJfdXNlciIsIENvbHVtbjogInVzZXJfaWQiLCBSZWZUYWJsZTogInVzZXIiLCBSZWZDb2x1bW46ICJpZCJ9LAoJCQl7TmFtZTogIm9yZGVyX3Jldmlld2VyIiwgQ29sdW1uOiAicmV2aWV3ZXJfaWQiLCBSZWZUYWJsZTogInVzZXIiLCBSZWZDb2x1bW46ICJpZCJ9LAoJCQl7TmFtZTogIm9yZGVyX2NvZGUiLCBDb2x1bW46ICJjb2RlIiwgUmVmVGFibGU6ICJ1c2VyIiwgUmVmQ29sdW1uOiAiaWQifSwKCQl9LAoJfQoJdHlwZXMgOj0gR2V0R3JhcGhRTFR5cGVzKFtdVG1wbFN0cnVjdHt1c2VyLCBvcmRlcn0sICJyLm0iKQoJaWYgbGVuKHR5cGVzKSAhPSAyIHsKCQl0LkZhdGFsZigiZ290ICVkIHR5cGVzLCB3YW50IDIiLCBsZW4odHlwZXMpKQoJfQoKCXUgOj0gdHlwZXNbMF0KCWlmIHUuU2luZ2xlICE9ICJ1c2VyIiB8fCB1LlBsdXJhbCAhPSAidXNlcnMiIHsKCQl0LkVycm9yZigidXNlciBxdWVyeSBmaWVsZHMgPSAlcSwgJXEiLCB1LlNpbmdsZSwgdS5QbHVyYWwpCgl9Cgl3YW50IDo9IG1hcFtzdHJpbmddc3RyaW5neyJpZCI6ICJJRCEiLCAic3RhdHVzIjogIlVzZXJTdGF0dXMiLCAibW9vZCI6ICJTdHJpbmchIiwgImFnZSI6ICJJbnQhIn0KCWZvciBfLCBmIDo9IHJhbmdlIHUuRmllbGRzIHsKCQlpZiBmLlR5cGUgIT0gd2FudFtmLk5hbWVdIHsKCQkJdC5FcnJvcmYoInVzZXIuJXM6IHR5cGUgJXEsIHdhbnQgJXEiLCBmLk5hbWUsIGYuVHlwZSwgd2FudFtmLk5hbWVdKQoJCX0KCX0KCWlmIGxlbih1LkVudW1zKSAhPSAxIHx8IHUuRW51bXNbMF0uVmFsdWVzWzFdLk5hbWUgIT0gIkJBTk5FRCIgfHwgdS5FbnVtc1swXS5WYWx1ZXNbMV0uVmFsdWUgIT0gImJhbm5lZCIgewoJCXQuRXJyb3JmKCJ1c2VyIGVudW1zID0gJSt2IiwgdS5FbnVtcykKCX0KCWlmIGxlbih1Lkxpc3RzKSAhPSAyIHx8IHUuTGlzdHNbMF0uTmFtZSAhPSAib3JkZXJzQnlVc2VySUQiIHx8IHUuTGlzdHNbMV0uTmFtZSAhPSAib3JkZXJzQnlSZXZpZXdlcklEIiB7CgkJdC5FcnJvcmYoInVzZXIgbGlzdHMgPSAlK3YiLCB1Lkxpc3RzKQoJfQoKCW8gOj0gdHlwZXNbMV0KCXdhbnQgPSBtYXBbc3RyaW5nXXN0cmluZ3siaWQiOiAiSUQhIiwgInVzZXJJZCI6ICJJRCEiLCAicmV2aWV3ZXJJZCI6ICJJRCIsICJjb2RlIjogIlN0cmluZyEifQoJZm9yIF8sIGYgOj0gcmFuZ2Ugby5GaWVsZHMgewoJCWlmIGYuVHlwZSAhPSB3YW50W2YuTmFtZV0gewoJCQl0LkVycm9yZigib3JkZXIuJXM6IHR5cGUgJXEsIHdhbnQgJXEiLCBmLk5hbWUsIGYuVHlwZSwgd2FudFtmLk5hbWVdKQoJCX0KCX0KCWlmIGxlbihvLlJlZnMpICE9IDIgfHwgby5SZWZzWzBdLk5hbWUgIT0gInVzZXIiIHx8IG8uUmVmc1sxXS5OYW1lICE9ICJyZXZpZXdlciIgfHwgby5SZWZzWzFdLk1vZGVsLk5hbWUgIT0gIlVzZXIiIHsKCQl0LkVycm9yZigib3JkZXIgcmVmcyA9ICUrdiIsIG8uUmVmcykKCX0KfQo=\"")
+	packr.PackJSONBytes("./tmpl", "lookup.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImZtdCIKCSJzdHJpbmdzIgoJInVuaWNvZGUiCgoJImdpdGh1Yi5jb20vTFVTSERpZ2l0YWwvbW9kZWxnZW4vc3FsZm10IgopCgovLyBsb29rdXBTdWZmaXhlcyBsaXN0cyB0aGUgc3VmZml4ZXMgb2YgdGhlIGlkZW50aWZpZXJzIGdlbmVyYXRlZCBmb3IgZXZlcnkgbW9kZWwsCi8vIHdoaWNoIHRoZSBjb25zdGFudHMgb2YgbG9va3VwIHRhYmxlcyBjYW5ub3QgYmUgbmFtZWQgYXMuCnZhciBsb29rdXBTdWZmaXhlcyA9IFtdc3RyaW5neyIiLCAiSUQiLCAiQnlDb2RlIiwgIkNvbHVtbnMiLCAiQ29ubmVjdGlvbiIsICJDb25uZWN0aW9uUmVzb2x2ZXIiLCAiQ3Vyc29yIiwKCSJFZGdlIiwgIkVkZ2VSZXNvbHZlciIsICJGaXh0dXJlcyIsICJIYW5kbGVyIiwgIk9wdGlvbiIsICJQYWdlIiwgIlF1ZXJ5IiwgIlJlcG9zaXRvcnkiLCAiUmVzb2x2ZXIifQoKLy8gR2V0TG9va3VwIHJldHVybnMgdGhlIGNvbnN0YW50cyBvZiB0aGUgcm93cyBvZiBhIGxvb2t1cCB0YWJsZSwgZ2l2ZW4gdGhlIGlkIGFuZCB0aGUgY29kZSBvZgovLyBldmVyeSByb3csIHJlYWQgZnJvbSBjb2x1bW4uIENvbnN0YW50cyBhcmUgbmFtZWQgYWZ0ZXIgdGhlIG1vZGVsIGFuZCB0aGUgY29kZSwgc3VjaCBhcwovLyBPcmRlclN0YXR1c1NoaXBwZWQgZm9yIGEgc2hpcHBlZCBjb2RlLCB3aGljaCBuZWVkcyB0byBtYWtlIHVwIGEgZGlzdGluY3QgR28gaWRlbnRpZmllciwKLy8gY2xhc2hpbmcgd2l0aCBub25lIG9mIHRoZSBpZGVudGlmaWVycyBnZW5lcmF0ZWQgZm9yIHRoZSBtb2RlbHMgb2YgdGhlIHBhY2thZ2UuCmZ1bmMgR2V0TG9va3VwKG0gVG1wbFN0cnVjdCwgbW9kZWxzIFtdVG1wbFN0cnVjdCwgY29sdW1uIHN0cmluZywgaWRzIFtdaW50NjQsIGNvZGVzIFtdc3RyaW5nKSAoVG1wbExvb2t1cCwgZXJyb3IpIHsKCWwgOj0gVG1wbExvb2t1cHtUeXBlOiBtLk5hbWUgKyAiSUQiLCBDb2x1bW46IGNvbHVtbn0KCXJlc2VydmVkIDo9IG1ha2UobWFwW3N0cmluZ11ib29sKQoJZm9yIF8sIHN1ZmZpeCA6PSByYW5nZSBsb29rdXBTdWZmaXhlcyB7CgkJcmVzZXJ2ZWRbbS5OYW1lK3N1ZmZpeF0gPSB0cnVlCgkJZm9yIF8sIG1vZGVsIDo9IHJhbmdlIG1vZGVscyB7CgkJCXJlc2VydmVkW21vZGVsLk5hbWUrc3VmZml4XSA9IHRydWUKCQl9Cgl9CgluYW1lZCA6PSBtYWtlKG1hcFtzdHJpbmddc3RyaW5nKQoJZm9yIGksIGlkIDo9IHJhbmdlIGlkcyB7CgkJc3VmZml4IDo9IExvb2t1cE5hbWUoY29kZXNbaV0pCgkJaWYgc3VmZml4ID09ICIiIHsKCQkJcmV0dXJuIFRtcGxMb29rdXB7fSwgZm10LkVycm9yZigiJXMgcm93ICVkOiBjb2RlICVxIGhvbGRzIG5vIGxldHRlcnMgb3IgZGlnaXRzIHRvIG5hbWUgYSBjb25zdGFudCBhZnRlciIsIG0uVGFibGVOYW1lLCBpZCwgY29kZXNbaV0pCgkJfQoJCW5hbWUgOj0gbS5OYW1lICsgc3VmZml4CgkJaWYgcmVzZXJ2ZWRbbmFtZV0gewoJCQlyZXR1cm4gVG1wbExvb2t1cHt9LCBmbXQuRXJyb3JmKCIlcyByb3cgJWQ6IGNvZGUgJXEgbmFtZXMgdGhlIGNvbnN0YW50ICVzLCB3aGljaCBpcyB0YWtlbiIsIG0uVGFibGVOYW1lLCBpZCwgY29kZXNbaV0sIG5hbWUpCgkJfQoJCWlmIGNvZGUsIG9rIDo9IG5hbWVkW25hbWVdOyBvayB7CgkJCXJldHVybiBUbXBsTG9va3Vwe30sIGZtdC5FcnJvcmYoIiVzIHJvdyAlZDogY29kZXMgJXEgYW5kICVxIGJvdGggbmFtZSB0aGUgY29uc3RhbnQgJXMiLCBtLlRhYmxlTmFtZSwgaWQsIGNvZGUsIGNvZGVzW2ldLCBuYW1lKQoJCX0KCQluYW1lZFtuYW1lXSA9IGNvZGVzW2ldCgkJbC5WYWx1ZXMgPSBhcHBlbmQobC5WYWx1ZXMsIFRtcGxMb29rdXBWYWx1ZXtOYW1lOiBuYW1lLCBJRDogaWQsIENvZGU6IGNvZGVzW2ldfSkKCX0KCXJldHVybiBsLCBuaWwKfQoKLy8gTG9va3VwTmFtZSB0dXJucyB0aGUgY29kZSBvZiBhIHJvdyBvZiBhIGxvb2t1cCB0YWJsZSBpbnRvIHRoZSBQYXNjYWxDYXNlIGlkZW50aWZpZXIKLy8gaXRzIGNvbnN0YW50IGlzIHN1ZmZpeGVkIHdpdGgsIGRyb3BwaW5nIGFueXRoaW5nIGJ1dCBsZXR0ZXJzIGFuZCBkaWdpdHM6Ci8vICJpbi1wcm9ncmVzcyIgYW5kICJJTl9QUk9HUkVTUyIgYm90aCBiZWNvbWUgSW5Qcm9ncmVzcy4KZnVuYyBMb29rdXBOYW1lKGNvZGUgc3RyaW5nKSBzdHJpbmcgewoJd29yZHMgOj0gc3RyaW5ncy5GaWVsZHNGdW5jKHN0cmluZ3MuVG9Mb3dlcihjb2RlKSwgZnVuYyhyIHJ1bmUpIGJvb2wgewoJCXJldHVybiAhdW5pY29kZS5Jc0xldHRlcihyKSAmJiAhdW5pY29kZS5Jc0RpZ2l0KHIpCgl9KQoJZm9yIGksIHcgOj0gcmFuZ2Ugd29yZHMgewoJCXdvcmRzW2ldID0gc3FsZm10LlNob3VsZENhcCh3KQoJfQoJcmV0dXJuIHNxbGZtdC5Ub1Bhc2NhbENhc2Uoc3RyaW5ncy5Kb2luKHdvcmRzLCAiXyIpKQp9Cg==\"")
+	packr.PackJSONBytes("./tmpl", "lookup.html", "\"e3tkZWZpbmUgImxvb2t1cCJ9fQpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgInN0cmNvbnYiCgovLyB7ey5Mb29rdXAuVHlwZX19IGlzIHRoZSBpZCBvZiBhIHJvdyBvZiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gbG9va3VwIHRhYmxlLgp0eXBlIHt7Lkxvb2t1cC5UeXBlfX0gaW50NjQKCi8vIFRoZSByb3dzIG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSB3aGVuIHRoZSBtb2RlbHMgd2VyZSBnZW5lcmF0ZWQsIG5hbWVkIGFmdGVyIHRoZWlyIHt7Lkxvb2t1cC5Db2x1bW59fS4KY29uc3QgKAp7ey0gcmFuZ2UgLkxvb2t1cC5WYWx1ZXMgfX0KICAgIHt7Lk5hbWV9fSB7eyQuTG9va3VwLlR5cGV9fSA9IHt7LklEfX0Ke3stIGVuZCB9fQopCgovLyB7ey5Nb2RlbC5OYW1lfX1CeUNvZGUgbWFwcyB0aGUge3suTG9va3VwLkNvbHVtbn19IG9mIGV2ZXJ5IHJvdyBvZiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUgdG8gaXRzIGlkLgp2YXIge3suTW9kZWwuTmFtZX19QnlDb2RlID0gbWFwW3N0cmluZ117ey5Mb29rdXAuVHlwZX19ewp7ey0gcmFuZ2UgLkxvb2t1cC5WYWx1ZXMgfX0KICAgIHt7IGdvX3N0cmluZyAuQ29kZSB9fToge3suTmFtZX19LAp7ey0gZW5kIH19Cn0KCi8vIFN0cmluZyByZXR1cm5zIHRoZSB7ey5Mb29rdXAuQ29sdW1ufX0gb2YgdGhlIHJvdywgb3IgdGhlIGlkIG9mIGEgcm93IHVua25vd24gd2hlbiB0aGUgbW9kZWxzIHdlcmUgZ2VuZXJhdGVkLgpmdW5jIChpZCB7ey5Mb29rdXAuVHlwZX19KSBTdHJpbmcoKSBzdHJpbmcgewogICAgc3dpdGNoIGlkIHsKe3stIHJhbmdlIC5Mb29rdXAuVmFsdWVzIH19CiAgICBjYXNlIHt7Lk5hbWV9fToKICAgICAgICByZXR1cm4ge3sgZ29fc3RyaW5nIC5Db2RlIH19Cnt7LSBlbmQgfX0KICAgIH0KICAgIHJldHVybiAie3suTG9va3VwLlR5cGV9fSgiICsgc3RyY29udi5Gb3JtYXRJbnQoaW50NjQoaWQpLCAxMCkgKyAiKSIKfQp7e2VuZH19Cg==\"")
+	packr.PackJSONBytes("./tmpl", "lookup_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgInRlc3RpbmciCgpmdW5jIFRlc3RMb29rdXBOYW1lKHQgKnRlc3RpbmcuVCkgewoJY2FzZXMgOj0gbWFwW3N0cmluZ11zdHJpbmd7CgkJInNoaXBwZWQiOiAgICAgIlNoaXBwZWQiLAoJCSJpbi1wcm9ncmVzcyI6ICJJblByb2dyZXNzIiwKCQkiSU5fUFJPR1JFU1MiOiAiSW5Qcm9ncmVzcyIsCgkJIm9uIGhvbGQiOiAgICAgIk9uSG9sZCIsCgkJImFwaSI6ICAgICAgICAgIkFQSSIsCgkJIjJmYSI6ICAgICAgICAgIjJmYSIsCgkJIi0tIjogICAgICAgICAgIiIsCgl9Cglmb3IgY29kZSwgd2FudCA6PSByYW5nZSBjYXNlcyB7CgkJaWYgZ290IDo9IExvb2t1cE5hbWUoY29kZSk7IGdvdCAhPSB3YW50IHsKCQkJdC5FcnJvcmYoIkxvb2t1cE5hbWUoJXEpID0gJXMsIHdhbnQgJXMiLCBjb2RlLCBnb3QsIHdhbnQpCgkJfQoJfQp9CgpmdW5jIFRlc3RHZXRMb29rdXAodCAqdGVzdGluZy5UKSB7CgltIDo9IFRtcGxTdHJ1Y3R7TmFtZTogIk9yZGVyU3RhdHVzIiwgVGFibGVOYW1lOiAib3JkZXJfc3RhdHVzIn0KCW1vZGVscyA6PSBbXVRtcGxTdHJ1Y3R7bSwge05hbWU6ICJPcmRlclN0YXR1c0hpc3RvcnkiLCBUYWJsZU5hbWU6ICJvcmRlcl9zdGF0dXNfaGlzdG9yeSJ9fQoJbCwgZXJyIDo9IEdldExvb2t1cChtLCBtb2RlbHMsICJjb2RlIiwgW11pbnQ2NHsxLCAzfSwgW11zdHJpbmd7InBlbmRpbmciLCAic2hpcHBlZCJ9KQoJaWYgZXJyICE9IG5pbCB7CgkJdC5GYXRhbChlcnIpCgl9CglpZiBsLlR5cGUgIT0gIk9yZGVyU3RhdHVzSUQiIHx8IGwuQ29sdW1uICE9ICJjb2RlIiB8fCBsZW4obC5WYWx1ZXMpICE9IDIgewoJCXQuRmF0YWxmKCJHZXRMb29rdXAoKSA9ICUrdiIsIGwpCgl9CglpZiB2IDo9IGwuVmFsdWVzWzFdOyB2Lk5hbWUgIT0gIk9yZGVyU3RhdHVzU2hpcHBlZCIgfHwgdi5JRCAhPSAzIHx8IHYuQ29kZSAhPSAic2hpcHBlZCIgewoJCXQuRXJyb3JmKCJHZXRMb29rdXAoKSB2YWx1ZSA9ICUrdiIsIHYpCgl9CgoJZm9yIF8sIGNvZGVzIDo9IHJhbmdlIFtdW11zdHJpbmd7eyJwZW5kaW5nIiwgIlBFTkRJTkcifSwgeyJwZW5kaW5nIiwgInF1ZXJ5In0sIHsicGVuZGluZyIsICJpZCJ9LCB7InBlbmRpbmciLCAiISJ9LCB7InBlbmRpbmciLCAiaGlzdG9yeSJ9LCB7InBlbmRpbmciLCAiaGlzdG9yeV9xdWVyeSJ9fSB7CgkJaWYgXywgZXJyIDo9IEdldExvb2t1cChtLCBtb2RlbHMsICJjb2RlIiwgW11pbnQ2NHsxLCAyfSwgY29kZXMpOyBlcnIgPT0gbmlsIHsKCQkJdC5FcnJvcmYoIkdldExvb2t1cCglcSkgc3VjY2VlZGVkLCB3YW50IGFuIGVycm9yIiwgY29kZXMpCgkJfQoJfQp9Cg==\"")
+	packr.PackJSONBytes("./tmpl", "model.html", "\"e3tkZWZpbmUgIm1vZGVsIn19CnBhY2thZ2Uge3sgLlBhY2thZ2VOYW1lIH19CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBDb2RlIGdlbmVyYXRlZCBieSBtb2RlbGdlbiB8CnwgICAgICAgIERPIE5PVCBFRElULiAgICAgICAgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIElmIHlvdSB3YW50IHRvIGV4dGVuZCBiZWhhdmlvdXI6Ci8vIFBsZWFzZSBjcmVhdGUgYSBjdXN0b20gZmlsZSBpbiB0aGlzIGRpcmVjdG9yeQovLyBhbmQgY3JlYXRlIHRoZSBtZXRob2RzIHRoZXJlIHRvIGF2b2lkIG92ZXJ3cml0aW5nIHlvdXIgY29kZSB3aXRoIHRoZSBnZW5lcmF0ZWQgb25lLgoKaW1wb3J0ICgKImNvbnRleHQiCiJkYXRhYmFzZS9zcWwiCiJmbXQiCiAgICB7eyByYW5nZSAkaywgJHY6PSAuTW9kZWwuSW1wb3J0cyB9fQogICAgInt7JGt9fSIKICAgIHt7LSBlbmQgfX0KKQoKLy8ge3suTW9kZWwuTmFtZX19IHJlcHJlc2VudHMgYSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCnR5cGUge3suTW9kZWwuTmFtZX19IHN0cnVjdCB7CiAgICB7eyByYW5nZSAkaywgJHY6PSAuTW9kZWwuRmllbGRzIH19CiAgICAgICAge3sgJHYuTmFtZSB9fSB7eyAkdi5UeXBlIH19IGBqc29uOiJ7eyR2LkNvbHVtbk5hbWV9fSJgIHt7ICR2IHwgZmllbGRfY29tbWVudCB9fQogICAge3stIGVuZCB9fQoKICAgIC8vIHNuYXBzaG90IGhvbGRzIHRoZSBmaWVsZCB2YWx1ZXMgbGFzdCByZWFkIGZyb20gb3Igd3JpdHRlbiB0byB0aGUgdGFibGUuCiAgICBzbmFwc2hvdCAqe3suTW9kZWwuTmFtZX19Cn0KCi8vIFZhbGlkYXRlIGNoZWNrcyB0aGUgZmllbGRzIG9mIHRoZSB7ey5Nb2RlbC5OYW1lfX0gZml0IHRoZSBjb2x1bW5zIG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gcmV0dXJuaW5nIFZhbGlkYXRpb25FcnJvcnMgbGlzdGluZyBldmVyeSBmaWVsZCB3aGljaCBkb2VzIG5vdC4Ke3stIHdpdGggZGF0YWJhc2VfY2hlY2tzIC4gfX0KLy8gVGhlc2UgY2hlY2sgY29uc3RyYWludHMgYXJlIG9ubHkgZW5mb3JjZWQgYnkgdGhlIGRhdGFiYXNlOgp7ey0gcmFuZ2UgLiB9fQovLyAge3sgLk5hbWUgfX06IHt7IGdvX2NvbW1lbnQgLkNsYXVzZSB9fQp7ey0gZW5kIH19Cnt7LSBlbmQgfX0KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBWYWxpZGF0ZSgpIGVycm9yIHsKICAgIHt7LSB3aXRoIHZhbGlkYXRpb25fcnVsZXMgLiB9fQogICAgdmFyIGVycnMgVmFsaWRhdGlvbkVycm9ycwogICAge3stIHJhbmdlIC4gfX0KICAgIGlmIHt7IC5JbnZhbGlkIH19IHsKICAgICAgICBlcnJzID0gYXBwZW5kKGVycnMsIEZpZWxkRXJyb3J7RmllbGQ6IHt7IGdvX3N0cmluZyAuRmllbGQuTmFtZSB9fSwgQ29sdW1uOiB7eyBnb19zdHJpbmcgLkZpZWxkLkNvbHVtbk5hbWUgfX0sIE1lc3NhZ2U6IHt7IGdvX3N0cmluZyAuTWVzc2FnZSB9fSB9KQogICAgfQogICAge3stIGVuZCB9fQogICAgaWYgbGVuKGVycnMpID4gMCB7CiAgICAgICAgcmV0dXJuIGVycnMKICAgIH0KICAgIHt7LSBlbmQgfX0KICAgIHJldHVybiBuaWwKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIEluc2VydCBhIG5ldyB7ey5Nb2RlbC5OYW1lfX0gcm93IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZQpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEluc2VydChxdSBRdWVyeWVyKSAobGFzdEluc2VydElEIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19Lkluc2VydENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpKQp9Cnt7IGVuZCB9fQovLyBJbnNlcnRDb250ZXh0IGluc2VydHMgYSBuZXcge3suTW9kZWwuTmFtZX19IHJvdyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBJbnNlcnRDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSAobGFzdEluc2VydElEIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIGNvbnN0IHN0bXQgPSB7eyBwcmludGYgIklOU0VSVCBJTlRPICVzICglcykgVkFMVUVTICglcykiIChzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSkgKGluc2VydF9maWVsZHMgLk1vZGVsLkZpZWxkcykgKGluc2VydF92YWx1ZXMgLk1vZGVsLkZpZWxkcykgfCBnb19zdHJpbmcgfX0KICAgIGlmIGVyciA9IGJlZm9yZUluc2VydChjdHgsIHF1LCB7ey5SZWNlaXZlcn19KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGlmIC5WYWxpZGF0ZSB9fQogICAgaWYgZXJyID0ge3suUmVjZWl2ZXJ9fS5WYWxpZGF0ZSgpOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICB7ey0gZW5kIH19CiAgICByZXMsIGVyciA6PSBxdS5FeGVjQ29udGV4dChjdHgsIHN0bXR7eyAuIHwgaW5zZXJ0X2FyZ3MgfX0pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICBpZiBsYXN0SW5zZXJ0SUQsIGVyciA9IHJlcy5MYXN0SW5zZXJ0SWQoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAgcmV0dXJuIGxhc3RJbnNlcnRJRCwgYWZ0ZXJJbnNlcnQoY3R4LCBxdSwge3suUmVjZWl2ZXJ9fSkKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIFVwZGF0ZSBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZS4Ke3stIGlmIC5WZXJzaW9uIH19Ci8vIFRoZSByb3cgaXMgb25seSB1cGRhdGVkIGlmIGl0cyB7ey5WZXJzaW9ufX0gc3RpbGwgbWF0Y2hlcyB0aGUgbW9kZWwsIHdoaWNoIGlzIHRoZW4gaW5jcmVtZW50ZWQsCi8vIG90aGVyd2lzZSBFcnJTdGFsZU9iamVjdCBpcyByZXR1cm5lZC4Ke3stIGVuZCB9fQpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFVwZGF0ZShxdSBRdWVyeWVyLCBpZCBpbnQ2NCkgKGludDY0LCBlcnJvcikgewogICAgcmV0dXJuIHt7LlJlY2VpdmVyfX0uVXBkYXRlQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYXNRdWVyeWVyQ29udGV4dChxdSksIGlkKQp9Cnt7IGVuZCB9fQovLyBVcGRhdGVDb250ZXh0IHVwZGF0ZXMgYW4gZXhpc3Rpbmcge3suTW9kZWwuTmFtZX19IHJvdyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUuCnt7LSBpZiAuVmVyc2lvbiB9fQovLyBUaGUgcm93IGlzIG9ubHkgdXBkYXRlZCBpZiBpdHMge3suVmVyc2lvbn19IHN0aWxsIG1hdGNoZXMgdGhlIG1vZGVsLCB3aGljaCBpcyB0aGVuIGluY3JlbWVudGVkLAovLyBvdGhlcndpc2UgRXJyU3RhbGVPYmplY3QgaXMgcmV0dXJuZWQuCnt7LSBlbmQgfX0KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBVcGRhdGVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBpZCBpbnQ2NCkgKGludDY0LCBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9IHt7IHByaW50ZiAiVVBEQVRFICVzIFNFVCAlcyBXSEVSRSBgaWRgID0gPyVzIiAoc3FsX2lkZW50IC5Nb2RlbC5UYWJsZU5hbWUpICh1cGRhdGVfdmFsdWVzIC4pIChhbmRfdmVyc2lvbiAuKSB8IGdvX3N0cmluZyB9fQogICAgaWYgZXJyIDo9IGJlZm9yZVVwZGF0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGlmIC5WYWxpZGF0ZSB9fQogICAgaWYgZXJyIDo9IHt7LlJlY2VpdmVyfX0uVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGVuZCB9fQogICAge3stIGlmIC5WZXJzaW9uIH19CiAgICByZXN1bHQsIGVyciA6PSBxdS5FeGVjQ29udGV4dChjdHgsIHN0bXQsIHt7IC4gfCB1cGRhdGVfYXJncyB9fSBpZCwge3suUmVjZWl2ZXJ9fS57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0pCiAgICB7ey0gZWxzZSB9fQogICAgcmVzdWx0LCBlcnIgOj0gcXUuRXhlY0NvbnRleHQoY3R4LCBzdG10LCB7eyAuIHwgdXBkYXRlX2FyZ3MgfX0gaWQpCiAgICB7ey0gZW5kIH19CiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICBhZmZlY3RlZCwgZXJyIDo9IHJlc3VsdC5Sb3dzQWZmZWN0ZWQoKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGlmIC5WZXJzaW9uIH19CiAgICBpZiBhZmZlY3RlZCA9PSAwIHsKICAgICAgICByZXR1cm4gMCwgRXJyU3RhbGVPYmplY3QKICAgIH0KICAgIHt7LlJlY2VpdmVyfX0ue3sgZmllbGRfbmFtZSAuTW9kZWwuRmllbGRzIC5WZXJzaW9uIH19KysKICAgIHt7LSBlbmQgfX0KICAgIHt7LlJlY2VpdmVyfX0uU25hcHNob3QoKQogICAgcmV0dXJuIGFmZmVjdGVkLCBhZnRlclVwZGF0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KQp9Cnt7IGlmIG5vdCAuQ29udGV4dE9ubHkgfX0KLy8gU2F2ZSB1cGRhdGVzIHRoZSBjb2x1bW5zIG9mIHRoZSB7ey5Nb2RlbC5OYW1lfX0gcm93IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZQovLyB3aGljaCBjaGFuZ2VkIHNpbmNlIGl0IHdhcyBmb3VuZCBvciBsYXN0IHNhdmVkLCBzZWUgRGlydHlDb2x1bW5zLgovLyBOb3RoaW5nIGlzIGV4ZWN1dGVkIHdoZW4gbm8gY29sdW1uIGNoYW5nZWQuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgU2F2ZShxdSBRdWVyeWVyKSAoaW50NjQsIGVycm9yKSB7CiAgICByZXR1cm4ge3suUmVjZWl2ZXJ9fS5TYXZlQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYXNRdWVyeWVyQ29udGV4dChxdSkpCn0Ke3sgZW5kIH19Ci8vIFNhdmVDb250ZXh0IHVwZGF0ZXMgdGhlIGNvbHVtbnMgb2YgdGhlIHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCi8vIHdoaWNoIGNoYW5nZWQgc2luY2UgaXQgd2FzIGZvdW5kIG9yIGxhc3Qgc2F2ZWQsIHNlZSBEaXJ0eUNvbHVtbnMuCi8vIE5vdGhpbmcgaXMgZXhlY3V0ZWQgd2hlbiBubyBjb2x1bW4gY2hhbmdlZC4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBTYXZlQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgKGludDY0LCBlcnJvcikgewogICAgaWYgZXJyIDo9IGJlZm9yZVVwZGF0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGlmIC5WYWxpZGF0ZSB9fQogICAgaWYgZXJyIDo9IHt7LlJlY2VpdmVyfX0uVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGVuZCB9fQogICAgY29scyA6PSB7ey5SZWNlaXZlcn19LkRpcnR5Q29sdW1ucygpCiAgICBpZiBsZW4oY29scykgPT0gMCB7CiAgICAgICAgcmV0dXJuIDAsIG5pbAogICAgfQogICAgYWZmZWN0ZWQsIGVyciA6PSB7ey5SZWNlaXZlcn19LnVwZGF0ZUNvbHVtbnMoY3R4LCBxdSwge3suUmVjZWl2ZXJ9fS5JRCwgY29scykKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHt7LlJlY2VpdmVyfX0uU25hcHNob3QoKQogICAgcmV0dXJuIGFmZmVjdGVkLCBhZnRlclVwZGF0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KQp9Cnt7IGlmIG5vdCAuQ29udGV4dE9ubHkgfX0KLy8gVXBkYXRlQ29sdW1ucyB1cGRhdGVzIG9ubHkgdGhlIGdpdmVuIGNvbHVtbnMgb2YgYW4gZXhpc3Rpbmcge3suTW9kZWwuTmFtZX19IHJvdwovLyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUgd2l0aCB0aGUgdmFsdWVzIG9mIHRoZSBtb2RlbC4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBVcGRhdGVDb2x1bW5zKHF1IFF1ZXJ5ZXIsIGlkIGludDY0LCBjb2xzIC4uLlNlbGVjdGFibGUpIChpbnQ2NCwgZXJyb3IpIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19LlVwZGF0ZUNvbHVtbnNDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBhc1F1ZXJ5ZXJDb250ZXh0KHF1KSwgaWQsIGNvbHMuLi4pCn0Ke3sgZW5kIH19Ci8vIFVwZGF0ZUNvbHVtbnNDb250ZXh0IHVwZGF0ZXMgb25seSB0aGUgZ2l2ZW4gY29sdW1ucyBvZiBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93Ci8vIGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSB3aXRoIHRoZSB2YWx1ZXMgb2YgdGhlIG1vZGVsLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFVwZGF0ZUNvbHVtbnNDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBpZCBpbnQ2NCwgY29scyAuLi5TZWxlY3RhYmxlKSAoaW50NjQsIGVycm9yKSB7CiAgICBpZiBsZW4oY29scykgPT0gMCB7CiAgICAgICAgcmV0dXJuIDAsIG5pbAogICAgfQogICAgaWYgZXJyIDo9IGJlZm9yZVVwZGF0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGlmIC5WYWxpZGF0ZSB9fQogICAgaWYgZXJyIDo9IHt7LlJlY2VpdmVyfX0uVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGVuZCB9fQogICAgY29sdW1ucyA6PSBtYWtlKFtdQ29sdW1uLCBsZW4oY29scykpCiAgICBmb3IgcG9zLCBjb2wgOj0gcmFuZ2UgY29scyB7CiAgICAgICAgY29sdW1uc1twb3NdID0gY29sLmNvbHVtbigpCiAgICB9CiAgICBhZmZlY3RlZCwgZXJyIDo9IHt7LlJlY2VpdmVyfX0udXBkYXRlQ29sdW1ucyhjdHgsIHF1LCBpZCwgY29sdW1ucykKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHt7LlJlY2VpdmVyfX0uU25hcHNob3QoKQogICAgcmV0dXJuIGFmZmVjdGVkLCBhZnRlclVwZGF0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KQp9CgovLyB1cGRhdGVDb2x1bW5zIHVwZGF0ZXMgdGhlIGdpdmVuIGNvbHVtbnMgb2YgYW4gZXhpc3Rpbmcgcm93IHdpdGggdGhlIHZhbHVlcyBvZiB0aGUgbW9kZWwuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgdXBkYXRlQ29sdW1ucyhjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgaWQgaW50NjQsIGNvbHMgW11Db2x1bW4pIChpbnQ2NCwgZXJyb3IpIHsKICAgIHZhbHVlcywgZXJyIDo9IHt7LlJlY2VpdmVyfX0udmFsdWVzRm9yKGNvbHMpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICBzZXQgOj0gbWFrZShbXUFzc2lnbm1lbnQsIDAsIGxlbihjb2xzKSsyKQogICAgZm9yIHBvcywgY29sIDo9IHJhbmdlIGNvbHMgewogICAgICAgIHNldCA9IGFwcGVuZChzZXQsIGNvbC5zZXQodmFsdWVzW3Bvc10pKQogICAgfQogICAge3stIGlmIGhhc19jb2x1bW4gLk1vZGVsLkZpZWxkcyAidXBkYXRlZF9hdCIgfX0KICAgIHNldCA9IGFwcGVuZChzZXQsIEFzc2lnbm1lbnR7ZXhwcjoge3sgcHJpbnRmICIlcz1VVENfVElNRVNUQU1QKCkiIChzcWxfaWRlbnQgInVwZGF0ZWRfYXQiKSB8IGdvX3N0cmluZyB9fX0pCiAgICB7ey0gZW5kIH19CiAgICBmaWx0ZXIgOj0gcXVlcnl7fS53aGVyZShbXUNvbmRpdGlvbnsge3suTW9kZWwuTmFtZX19Q29sdW1ucy5JRC5FcShpZCkgfSkKICAgIHt7LSBpZiAuVmVyc2lvbiB9fQogICAgc2V0ID0gYXBwZW5kKHNldCwgQXNzaWdubWVudHtleHByOiB7eyBwcmludGYgIiVbMV1zPSVbMV1zKzEiIChzcWxfaWRlbnQgLlZlcnNpb24pIHwgZ29fc3RyaW5nIH19fSkKICAgIGZpbHRlciA9IGZpbHRlci53aGVyZShbXUNvbmRpdGlvbnsge3suTW9kZWwuTmFtZX19Q29sdW1ucy57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0uRXEoe3suUmVjZWl2ZXJ9fS57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0pIH0pCiAgICB7ey0gZW5kIH19CiAgICBzdG10LCBhcmdzLCBlcnIgOj0gZmlsdGVyLnVwZGF0ZVN0bXQoe3sgc3FsX2lkZW50IC5Nb2RlbC5UYWJsZU5hbWUgfCBnb19zdHJpbmcgfX0sIHNldCkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHJlc3VsdCwgZXJyIDo9IHF1LkV4ZWNDb250ZXh0KGN0eCwgc3RtdCwgYXJncy4uLikKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIGFmZmVjdGVkLCBlcnIgOj0gcmVzdWx0LlJvd3NBZmZlY3RlZCgpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICB7ey0gaWYgLlZlcnNpb24gfX0KICAgIGlmIGFmZmVjdGVkID09IDAgewogICAgICAgIHJldHVybiAwLCBFcnJTdGFsZU9iamVjdAogICAgfQogICAge3suUmVjZWl2ZXJ9fS57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0rKwogICAge3stIGVuZCB9fQogICAgcmV0dXJuIGFmZmVjdGVkLCBuaWwKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIFVwc2VydCBpbnNlcnRzIGEgbmV3IHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCi8vIGlmIHRoZSB1bmlxdWUgY29uc3RyYWludHMgYXJlIG5vdCBmb3VuZCwgb3RoZXJ3aXNlIGl0IHVwZGF0ZXMgaXQuCnt7LSBpZiAuVmVyc2lvbiB9fQovLyBBbiBleGlzdGluZyByb3cgaXMgb25seSB1cGRhdGVkIGlmIGl0cyB7ey5WZXJzaW9ufX0gc3RpbGwgbWF0Y2hlcyB0aGUgbW9kZWwsIHdoaWNoIGlzIHRoZW4gaW5jcmVtZW50ZWQsCi8vIG90aGVyd2lzZSBFcnJTdGFsZU9iamVjdCBpcyByZXR1cm5lZC4Ke3stIGVuZCB9fQpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFVwc2VydChxdSBRdWVyeWVyKSAobGFzdEluc2VydElEIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19LlVwc2VydENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpKQp9Cnt7IGVuZCB9fQovLyBVcHNlcnRDb250ZXh0IGluc2VydHMgYSBuZXcge3suTW9kZWwuTmFtZX19IHJvdyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKLy8gaWYgdGhlIHVuaXF1ZSBjb25zdHJhaW50cyBhcmUgbm90IGZvdW5kLCBvdGhlcndpc2UgaXQgdXBkYXRlcyBpdC4Ke3stIGlmIC5WZXJzaW9uIH19Ci8vIEFuIGV4aXN0aW5nIHJvdyBpcyBvbmx5IHVwZGF0ZWQgaWYgaXRzIHt7LlZlcnNpb259fSBzdGlsbCBtYXRjaGVzIHRoZSBtb2RlbCwgd2hpY2ggaXMgdGhlbiBpbmNyZW1lbnRlZCwKLy8gb3RoZXJ3aXNlIEVyclN0YWxlT2JqZWN0IGlzIHJldHVybmVkLgp7ey0gZW5kIH19CmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgVXBzZXJ0Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgKGxhc3RJbnNlcnRJRCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICBjb25zdCBzdG10ID0ge3sgcHJpbnRmICJJTlNFUlQgSU5UTyAlcyAoJXMpIFZBTFVFUyAoJXMpIE9OIERVUExJQ0FURSBLRVkgVVBEQVRFICVzIiAoc3FsX2lkZW50IC5Nb2RlbC5UYWJsZU5hbWUpICh1cHNlcnRfZmllbGRzIC5Nb2RlbC5GaWVsZHMpICh1cHNlcnRfdmFsdWVzIC5Nb2RlbC5GaWVsZHMpICh1cHNlcnRfb25fZHVwbGljYXRlIC4pIHwgZ29fc3RyaW5nIH19CiAgICBpZiBlcnIgPSBiZWZvcmVVcHNlcnQoY3R4LCBxdSwge3suUmVjZWl2ZXJ9fSk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHt7LSBpZiAuVmFsaWRhdGUgfX0KICAgIGlmIGVyciA9IHt7LlJlY2VpdmVyfX0uVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGVuZCB9fQogICAgcmVzLCBlcnIgOj0gcXUuRXhlY0NvbnRleHQoY3R4LCBzdG10LCB7eyAuIHwgdXBzZXJ0X2FyZ3MgfX0pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gMCwgZXJyCiAgICB9CiAgICB7ey0gaWYgLlZlcnNpb24gfX0KICAgIC8vIE15U1FMIHJlcG9ydHMgb25lIHJvdyBhZmZlY3RlZCBmb3IgYW4gaW5zZXJ0LCB0d28gZm9yIGFuIHVwZGF0ZQogICAgLy8gYW5kIG5vbmUgd2hlbiB0aGUgZ3VhcmRlZCBhc3NpZ25tZW50cyBsZWZ0IHRoZSBleGlzdGluZyByb3cgdW50b3VjaGVkLgogICAgYWZmZWN0ZWQsIGVyciA6PSByZXMuUm93c0FmZmVjdGVkKCkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHN3aXRjaCBhZmZlY3RlZCB7CiAgICBjYXNlIDA6CiAgICAgICAgcmV0dXJuIDAsIEVyclN0YWxlT2JqZWN0CiAgICBjYXNlIDI6CiAgICAgICAge3suUmVjZWl2ZXJ9fS57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0rKwogICAgfQogICAge3stIGVuZCB9fQogICAgaWYgbGFzdEluc2VydElELCBlcnIgPSByZXMuTGFzdEluc2VydElkKCk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiAwLCBlcnIKICAgIH0KICAgIHJldHVybiBsYXN0SW5zZXJ0SUQsIGFmdGVyVXBzZXJ0KGN0eCwgcXUsIHt7LlJlY2VpdmVyfX0pCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBJbnNlcnRNYW55IGluc2VydHMgYSBzZXQgb2Yge3suTW9kZWwuTmFtZX19IHJvd3MgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyB1c2luZyBhcyBmZXcgbXVsdGktcm93IHN0YXRlbWVudHMgYXMgTWF4UGxhY2Vob2xkZXJzIGFuZCBNYXhQYWNrZXRTaXplIGFsbG93LgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEluc2VydE1hbnkocXUgUXVlcnllciwgc2V0IFtde3suTW9kZWwuTmFtZX19KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19Lkluc2VydE1hbnlDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBhc1F1ZXJ5ZXJDb250ZXh0KHF1KSwgc2V0KQp9Cnt7IGVuZCB9fQovLyBJbnNlcnRNYW55Q29udGV4dCBpbnNlcnRzIGEgc2V0IG9mIHt7Lk1vZGVsLk5hbWV9fSByb3dzIGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gdXNpbmcgYXMgZmV3IG11bHRpLXJvdyBzdGF0ZW1lbnRzIGFzIE1heFBsYWNlaG9sZGVycyBhbmQgTWF4UGFja2V0U2l6ZSBhbGxvdy4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBJbnNlcnRNYW55Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgc2V0IFtde3suTW9kZWwuTmFtZX19KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIGNvbnN0ICgKICAgICAgICBwcmVmaXggPSB7eyBwcmludGYgIklOU0VSVCBJTlRPICVzICglcykgVkFMVUVTICIgKHNxbF9pZGVudCAuTW9kZWwuVGFibGVOYW1lKSAoaW5zZXJ0X2ZpZWxkcyAuTW9kZWwuRmllbGRzKSB8IGdvX3N0cmluZyB9fQogICAgICAgIHJvdyAgICA9IHt7IHByaW50ZiAiKCVzKSIgKGluc2VydF92YWx1ZXMgLk1vZGVsLkZpZWxkcykgfCBnb19zdHJpbmcgfX0KICAgICkKICAgIGFyZ3MgOj0gbWFrZShbXVtdaW50ZXJmYWNle30sIDAsIGxlbihzZXQpKQogICAgZm9yIHBvcyA6PSByYW5nZSBzZXQgewogICAgICAgIGl0ZW0gOj0gJnNldFtwb3NdCiAgICAgICAgaWYgZXJyID0gYmVmb3JlSW5zZXJ0KGN0eCwgcXUsIGl0ZW0pOyBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuIDAsIGVycgogICAgICAgIH0KICAgICAgICB7ey0gaWYgLlZhbGlkYXRlIH19CiAgICAgICAgaWYgZXJyID0gaXRlbS5WYWxpZGF0ZSgpOyBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuIDAsIGVycgogICAgICAgIH0KICAgICAgICB7ey0gZW5kIH19CiAgICAgICAgYXJncyA9IGFwcGVuZChhcmdzLCBbXWludGVyZmFjZXt9eyB7eyB3aXRoX3JlY2VpdmVyIC4gIml0ZW0iIHwgaW5zZXJ0X2FyZ19saXN0IH19IH0pCiAgICB9CiAgICBpZiByb3dzQWZmZWN0ZWQsIGVyciA9IGV4ZWNCYXRjaChjdHgsIHF1LCBwcmVmaXgsIHJvdywgIiIsIGFyZ3MpOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIGZvciBwb3MgOj0gcmFuZ2Ugc2V0IHsKICAgICAgICBpZiBlcnIgPSBhZnRlckluc2VydChjdHgsIHF1LCAmc2V0W3Bvc10pOyBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuCiAgICAgICAgfQogICAgfQogICAgcmV0dXJuCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBVcHNlcnRNYW55IHVwc2VydHMgYSBzZXQgb2Yge3suTW9kZWwuTmFtZX19IHJvd3MgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyB1c2luZyBhcyBmZXcgbXVsdGktcm93IHN0YXRlbWVudHMgYXMgTWF4UGxhY2Vob2xkZXJzIGFuZCBNYXhQYWNrZXRTaXplIGFsbG93LgovLyBBcyB3aXRoIGFueSBPTiBEVVBMSUNBVEUgS0VZIFVQREFURSBzdGF0ZW1lbnQsIGV2ZXJ5IHVwZGF0ZWQgcm93IGNvdW50cyBhcyB0d28gcm93cyBhZmZlY3RlZC4Ke3stIGlmIC5WZXJzaW9uIH19Ci8vIEV4aXN0aW5nIHJvd3Mgd2hvc2Uge3suVmVyc2lvbn19IGRvZXMgbm90IG1hdGNoIGFyZSBsZWZ0IHVudG91Y2hlZCByYXRoZXIgdGhhbiByZXBvcnRlZCBhcyBzdGFsZS4Ke3stIGVuZCB9fQpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFVwc2VydE1hbnkocXUgUXVlcnllciwgc2V0IFtde3suTW9kZWwuTmFtZX19KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19LlVwc2VydE1hbnlDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBhc1F1ZXJ5ZXJDb250ZXh0KHF1KSwgc2V0KQp9Cnt7IGVuZCB9fQovLyBVcHNlcnRNYW55Q29udGV4dCB1cHNlcnRzIGEgc2V0IG9mIHt7Lk1vZGVsLk5hbWV9fSByb3dzIGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gdXNpbmcgYXMgZmV3IG11bHRpLXJvdyBzdGF0ZW1lbnRzIGFzIE1heFBsYWNlaG9sZGVycyBhbmQgTWF4UGFja2V0U2l6ZSBhbGxvdy4KLy8gQXMgd2l0aCBhbnkgT04gRFVQTElDQVRFIEtFWSBVUERBVEUgc3RhdGVtZW50LCBldmVyeSB1cGRhdGVkIHJvdyBjb3VudHMgYXMgdHdvIHJvd3MgYWZmZWN0ZWQuCnt7LSBpZiAuVmVyc2lvbiB9fQovLyBFeGlzdGluZyByb3dzIHdob3NlIHt7LlZlcnNpb259fSBkb2VzIG5vdCBtYXRjaCBhcmUgbGVmdCB1bnRvdWNoZWQgcmF0aGVyIHRoYW4gcmVwb3J0ZWQgYXMgc3RhbGUuCnt7LSBlbmQgfX0KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBVcHNlcnRNYW55Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgc2V0IFtde3suTW9kZWwuTmFtZX19KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIGNvbnN0ICgKICAgICAgICBwcmVmaXggPSB7eyBwcmludGYgIklOU0VSVCBJTlRPICVzICglcykgVkFMVUVTICIgKHNxbF9pZGVudCAuTW9kZWwuVGFibGVOYW1lKSAodXBzZXJ0X2ZpZWxkcyAuTW9kZWwuRmllbGRzKSB8IGdvX3N0cmluZyB9fQogICAgICAgIHJvdyAgICA9IHt7IHByaW50ZiAiKCVzKSIgKHVwc2VydF92YWx1ZXMgLk1vZGVsLkZpZWxkcykgfCBnb19zdHJpbmcgfX0KICAgICAgICBzdWZmaXggPSB7eyBwcmludGYgIiBPTiBEVVBMSUNBVEUgS0VZIFVQREFURSAlcyIgKHVwc2VydF9vbl9kdXBsaWNhdGUgLikgfCBnb19zdHJpbmcgfX0KICAgICkKICAgIGFyZ3MgOj0gbWFrZShbXVtdaW50ZXJmYWNle30sIDAsIGxlbihzZXQpKQogICAgZm9yIHBvcyA6PSByYW5nZSBzZXQgewogICAgICAgIGl0ZW0gOj0gJnNldFtwb3NdCiAgICAgICAgaWYgZXJyID0gYmVmb3JlVXBzZXJ0KGN0eCwgcXUsIGl0ZW0pOyBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuIDAsIGVycgogICAgICAgIH0KICAgICAgICB7ey0gaWYgLlZhbGlkYXRlIH19CiAgICAgICAgaWYgZXJyID0gaXRlbS5WYWxpZGF0ZSgpOyBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuIDAsIGVycgogICAgICAgIH0KICAgICAgICB7ey0gZW5kIH19CiAgICAgICAgYXJncyA9IGFwcGVuZChhcmdzLCBbXWludGVyZmFjZXt9eyB7eyB3aXRoX3JlY2VpdmVyIC4gIml0ZW0iIHwgdXBzZXJ0X2FyZ3MgfX0gfSkKICAgIH0KICAgIGlmIHJvd3NBZmZlY3RlZCwgZXJyID0gZXhlY0JhdGNoKGN0eCwgcXUsIHByZWZpeCwgcm93LCBzdWZmaXgsIGFyZ3MpOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIGZvciBwb3MgOj0gcmFuZ2Ugc2V0IHsKICAgICAgICBpZiBlcnIgPSBhZnRlclVwc2VydChjdHgsIHF1LCAmc2V0W3Bvc10pOyBlcnIgIT0gbmlsIHsKICAgICAgICAgICAgcmV0dXJuCiAgICAgICAgfQogICAgfQogICAgcmV0dXJuCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBGaW5kIGFuIGV4aXN0aW5nIHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRmluZChxdSBRdWVyeWVyLCBpZCBpbnQ2NCkgZXJyb3IgewogICAgcmV0dXJuIHt7LlJlY2VpdmVyfX0uRmluZENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBpZCkKfQp7eyBlbmQgfX0KLy8gRmluZENvbnRleHQgZmluZHMgYW4gZXhpc3Rpbmcge3suTW9kZWwuTmFtZX19IHJvdyBpbiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBGaW5kQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgaWQgaW50NjQpIGVycm9yIHsKICAgIGNvbnN0IHN0bXQgPSB7eyBwcmludGYgIlNFTEVDVCAlcyBGUk9NICVzIFdIRVJFIGBpZGAgPSA/JXMiIChzZWxlY3RfZmllbGRzIC5Nb2RlbC5GaWVsZHMpIChzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSkgKGFuZF9ub3RfZGVsZXRlZCAuKSB8IGdvX3N0cmluZyB9fQogICAgcm93IDo9IHF1LlF1ZXJ5Um93Q29udGV4dChjdHgsIHN0bXQsIGlkKQogICAgaWYgZXJyIDo9IHJvdy5TY2FuKHt7IC4gfCBzY2FuX2ZpZWxkc319KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIGVycgogICAgfQogICAge3suUmVjZWl2ZXJ9fS5TbmFwc2hvdCgpCiAgICByZXR1cm4gbmlsCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBGaW5kRm9yVXBkYXRlIGZpbmRzIGFuIGV4aXN0aW5nIHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyBsb2NraW5nIGl0IGFnYWluc3Qgb3RoZXIgd3JpdGVzIHVudGlsIHRoZSB0cmFuc2FjdGlvbiBlbmRzLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEZpbmRGb3JVcGRhdGUodHggVHhRdWVyeWVyLCBpZCBpbnQ2NCwgb3B0cyAuLi5Mb2NrT3B0aW9uKSBlcnJvciB7CiAgICByZXR1cm4ge3suUmVjZWl2ZXJ9fS5GaW5kRm9yVXBkYXRlQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgdHgsIGlkLCBvcHRzLi4uKQp9Cnt7IGVuZCB9fQovLyBGaW5kRm9yVXBkYXRlQ29udGV4dCBmaW5kcyBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gbG9ja2luZyBpdCBhZ2FpbnN0IG90aGVyIHdyaXRlcyB1bnRpbCB0aGUgdHJhbnNhY3Rpb24gZW5kcy4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBGaW5kRm9yVXBkYXRlQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCB0eCBUeFF1ZXJ5ZXIsIGlkIGludDY0LCBvcHRzIC4uLkxvY2tPcHRpb24pIGVycm9yIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19LmZpbmRMb2NrZWQoY3R4LCB0eCwgaWQsIGZhbHNlLCBvcHRzKQp9Cnt7IGlmIG5vdCAuQ29udGV4dE9ubHkgfX0KLy8gRmluZEZvclNoYXJlIGZpbmRzIGFuIGV4aXN0aW5nIHt7Lk1vZGVsLk5hbWV9fSByb3cgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyBsb2NraW5nIGl0IGFnYWluc3Qgd3JpdGVzIGZyb20gb3RoZXIgdHJhbnNhY3Rpb25zIHVudGlsIHRoZSB0cmFuc2FjdGlvbiBlbmRzLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEZpbmRGb3JTaGFyZSh0eCBUeFF1ZXJ5ZXIsIGlkIGludDY0LCBvcHRzIC4uLkxvY2tPcHRpb24pIGVycm9yIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19LkZpbmRGb3JTaGFyZUNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIHR4LCBpZCwgb3B0cy4uLikKfQp7eyBlbmQgfX0KLy8gRmluZEZvclNoYXJlQ29udGV4dCBmaW5kcyBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gbG9ja2luZyBpdCBhZ2FpbnN0IHdyaXRlcyBmcm9tIG90aGVyIHRyYW5zYWN0aW9ucyB1bnRpbCB0aGUgdHJhbnNhY3Rpb24gZW5kcy4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBGaW5kRm9yU2hhcmVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHR4IFR4UXVlcnllciwgaWQgaW50NjQsIG9wdHMgLi4uTG9ja09wdGlvbikgZXJyb3IgewogICAgcmV0dXJuIHt7LlJlY2VpdmVyfX0uZmluZExvY2tlZChjdHgsIHR4LCBpZCwgdHJ1ZSwgb3B0cykKfQoKLy8gZmluZExvY2tlZCBmaW5kcyBhbiBleGlzdGluZyByb3cgdGhyb3VnaCBhIGxvY2tpbmcgcmVhZC4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBmaW5kTG9ja2VkKGN0eCBjb250ZXh0LkNvbnRleHQsIHR4IFR4UXVlcnllciwgaWQgaW50NjQsIHNoYXJlIGJvb2wsIG9wdHMgW11Mb2NrT3B0aW9uKSBlcnJvciB7CiAgICBjb25zdCBzdG10ID0ge3sgcHJpbnRmICJTRUxFQ1QgJXMgRlJPTSAlcyBXSEVSRSBgaWRgID0gPyVzIiAoc2VsZWN0X2ZpZWxkcyAuTW9kZWwuRmllbGRzKSAoc3FsX2lkZW50IC5Nb2RlbC5UYWJsZU5hbWUpIChhbmRfbm90X2RlbGV0ZWQgLikgfCBnb19zdHJpbmcgfX0KICAgIGxvY2ssIGVyciA6PSBsb2NrQ2xhdXNlKHNoYXJlLCBvcHRzKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIGVycgogICAgfQogICAgcm93IDo9IHR4LlF1ZXJ5Um93Q29udGV4dChjdHgsIHN0bXQrbG9jaywgaWQpCiAgICBpZiBlcnIgOj0gcm93LlNjYW4oe3sgLiB8IHNjYW5fZmllbGRzfX0pOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gZXJyCiAgICB9CiAgICB7ey5SZWNlaXZlcn19LlNuYXBzaG90KCkKICAgIHJldHVybiBuaWwKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIExvYWQgYWxsIHt7Lk1vZGVsLk5hbWV9fSByb3dzIGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLgovLyBVc2UgYSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSB0byBsb2FkIGEgZmlsdGVyZWQgb3IgcGFnaW5hdGVkIHN1YnNldCBvZiB0aGVtLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIExvYWQocXUgUXVlcnllcikgKHNldCBbXXt7Lk1vZGVsLk5hbWV9fSwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4ge3suUmVjZWl2ZXJ9fS5Mb2FkQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYXNRdWVyeWVyQ29udGV4dChxdSkpCn0Ke3sgZW5kIH19Ci8vIExvYWRDb250ZXh0IGxvYWRzIGFsbCB7ey5Nb2RlbC5OYW1lfX0gcm93cyBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZS4KLy8gVXNlIGEge3suTW9kZWwuTmFtZX19UXVlcnkgdG8gbG9hZCBhIGZpbHRlcmVkIG9yIHBhZ2luYXRlZCBzdWJzZXQgb2YgdGhlbS4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBMb2FkQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgKHNldCBbXXt7Lk1vZGVsLk5hbWV9fSwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4ge3suTW9kZWwuTmFtZX19UXVlcnl7fS5Mb2FkQ29udGV4dChjdHgsIHF1KQp9Cnt7IGlmIG5vdCAuQ29udGV4dE9ubHkgfX0KLy8gRWFjaCBjYWxscyBmbiB3aXRoIGV2ZXJ5IHt7Lk1vZGVsLk5hbWV9fSByb3cgb2YgdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLCBvbmUgcm93IGF0IGEgdGltZS4KLy8gSXRlcmF0aW9uIHN0b3BzIGF0IHRoZSBmaXJzdCBlcnJvciBmbiByZXR1cm5zLCB3aGljaCBFYWNoIHJldHVybnMsIHVubGVzcyBpdCBpcyBFcnJTdG9wLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEVhY2gocXUgUXVlcnllciwgZm4gZnVuYygqe3suTW9kZWwuTmFtZX19KSBlcnJvcikgZXJyb3IgewogICAgcmV0dXJuIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5e30uRWFjaENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBmbikKfQp7eyBlbmQgfX0KLy8gRWFjaENvbnRleHQgY2FsbHMgZm4gd2l0aCBldmVyeSB7ey5Nb2RlbC5OYW1lfX0gcm93IG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwgb25lIHJvdyBhdCBhIHRpbWUuCi8vIEl0ZXJhdGlvbiBzdG9wcyBhdCB0aGUgZmlyc3QgZXJyb3IgZm4gcmV0dXJucywgd2hpY2ggRWFjaENvbnRleHQgcmV0dXJucywgdW5sZXNzIGl0IGlzIEVyclN0b3AuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRWFjaENvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQsIGZuIGZ1bmMoKnt7Lk1vZGVsLk5hbWV9fSkgZXJyb3IpIGVycm9yIHsKICAgIHJldHVybiB7ey5Nb2RlbC5OYW1lfX1RdWVyeXt9LkVhY2hDb250ZXh0KGN0eCwgcXUsIGZuKQp9Cnt7IGlmIG5vdCAuQ29udGV4dE9ubHkgfX0KLy8gTG9hZEFmdGVyIGxvYWRzIHVwIHRvIGxpbWl0IHt7Lk1vZGVsLk5hbWV9fSByb3dzIG9yZGVyZWQgYnkgaWQsIGZvbGxvd2luZyB0aGUgcm93IHRoZSBjdXJzb3IgcG9pbnRzIGF0LgovLyBQYXNzIGFuIGVtcHR5IGN1cnNvciB0byBsb2FkIHRoZSBmaXJzdCBwYWdlLCB0aGVuIHRoZSBOZXh0IGN1cnNvciBvZiBlYWNoIHBhZ2UgdG8gbG9hZCB0aGUgZm9sbG93aW5nIG9uZS4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBMb2FkQWZ0ZXIocXUgUXVlcnllciwgY3Vyc29yIHN0cmluZywgbGltaXQgaW50KSAoe3suTW9kZWwuTmFtZX19UGFnZSwgZXJyb3IpIHsKICAgIHJldHVybiB7ey5Nb2RlbC5OYW1lfX1RdWVyeXt9LkxvYWRBZnRlckNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBjdXJzb3IsIGxpbWl0KQp9Cnt7IGVuZCB9fQovLyBMb2FkQWZ0ZXJDb250ZXh0IGxvYWRzIHVwIHRvIGxpbWl0IHt7Lk1vZGVsLk5hbWV9fSByb3dzIG9yZGVyZWQgYnkgaWQsIGZvbGxvd2luZyB0aGUgcm93IHRoZSBjdXJzb3IgcG9pbnRzIGF0LgovLyBQYXNzIGFuIGVtcHR5IGN1cnNvciB0byBsb2FkIHRoZSBmaXJzdCBwYWdlLCB0aGVuIHRoZSBOZXh0IGN1cnNvciBvZiBlYWNoIHBhZ2UgdG8gbG9hZCB0aGUgZm9sbG93aW5nIG9uZS4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBMb2FkQWZ0ZXJDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBjdXJzb3Igc3RyaW5nLCBsaW1pdCBpbnQpICh7ey5Nb2RlbC5OYW1lfX1QYWdlLCBlcnJvcikgewogICAgcmV0dXJuIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5e30uTG9hZEFmdGVyQ29udGV4dChjdHgsIHF1LCBjdXJzb3IsIGxpbWl0KQp9Cnt7LSBpZiAuU29mdERlbGV0ZSB9fQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIERlbGV0ZSBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyBieSBzZXR0aW5nIGl0cyB7ey5Tb2Z0RGVsZXRlfX0gY29sdW1uLiBVc2UgSGFyZERlbGV0ZSB0byByZW1vdmUgaXQgZm9yIGdvb2QuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRGVsZXRlKHF1IFF1ZXJ5ZXIsIGlkIGludDY0KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiB7ey5SZWNlaXZlcn19LkRlbGV0ZUNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBpZCkKfQp7eyBlbmQgfX0KLy8gRGVsZXRlQ29udGV4dCBkZWxldGVzIGFuIGV4aXN0aW5nIHt7Lk1vZGVsLk5hbWV9fSByb3cgZnJvbSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUsCi8vIGJ5IHNldHRpbmcgaXRzIHt7LlNvZnREZWxldGV9fSBjb2x1bW4uIFVzZSBIYXJkRGVsZXRlQ29udGV4dCB0byByZW1vdmUgaXQgZm9yIGdvb2QuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRGVsZXRlQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgaWQgaW50NjQpIChyb3dzQWZmZWN0ZWQgaW50NjQsIGVyciBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9IHt7IHByaW50ZiAiVVBEQVRFICVzIFNFVCAlcz1VVENfVElNRVNUQU1QKCkgV0hFUkUgYGlkYCA9ID8lcyIgKHNxbF9pZGVudCAuTW9kZWwuVGFibGVOYW1lKSAoc3FsX2lkZW50IC5Tb2Z0RGVsZXRlKSAoYW5kX25vdF9kZWxldGVkIC4pIHwgZ29fc3RyaW5nIH19CiAgICBpZiBlcnIgPSBiZWZvcmVEZWxldGUoY3R4LCBxdSwge3suUmVjZWl2ZXJ9fSk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgcmVzdWx0LCBlcnIgOj0gcXUuRXhlY0NvbnRleHQoY3R4LCBzdG10LCBpZCkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgaWYgcm93c0FmZmVjdGVkLCBlcnIgPSByZXN1bHQuUm93c0FmZmVjdGVkKCk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgcmV0dXJuIHJvd3NBZmZlY3RlZCwgYWZ0ZXJEZWxldGUoY3R4LCBxdSwge3suUmVjZWl2ZXJ9fSkKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIEhhcmREZWxldGUgcmVtb3ZlcyBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyB3aGV0aGVyIGl0IHdhcyBzb2Z0IGRlbGV0ZWQgb3Igbm90LgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEhhcmREZWxldGUocXUgUXVlcnllciwgaWQgaW50NjQpIChyb3dzQWZmZWN0ZWQgaW50NjQsIGVyciBlcnJvcikgewogICAgcmV0dXJuIHt7LlJlY2VpdmVyfX0uSGFyZERlbGV0ZUNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBpZCkKfQp7eyBlbmQgfX0KLy8gSGFyZERlbGV0ZUNvbnRleHQgcmVtb3ZlcyBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyB3aGV0aGVyIGl0IHdhcyBzb2Z0IGRlbGV0ZWQgb3Igbm90LgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEhhcmREZWxldGVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBpZCBpbnQ2NCkgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICBjb25zdCBzdG10ID0ge3sgcHJpbnRmICJERUxFVEUgRlJPTSAlcyBXSEVSRSBgaWRgID0gPyIgKHNxbF9pZGVudCAuTW9kZWwuVGFibGVOYW1lKSB8IGdvX3N0cmluZyB9fQogICAgaWYgZXJyID0gYmVmb3JlRGVsZXRlKGN0eCwgcXUsIHt7LlJlY2VpdmVyfX0pOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJlc3VsdCwgZXJyIDo9IHF1LkV4ZWNDb250ZXh0KGN0eCwgc3RtdCwgaWQpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIGlmIHJvd3NBZmZlY3RlZCwgZXJyID0gcmVzdWx0LlJvd3NBZmZlY3RlZCgpOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJldHVybiByb3dzQWZmZWN0ZWQsIGFmdGVyRGVsZXRlKGN0eCwgcXUsIHt7LlJlY2VpdmVyfX0pCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBSZXN0b3JlIGEgc29mdCBkZWxldGVkIHt7Lk1vZGVsLk5hbWV9fSByb3cgb2YgdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgUmVzdG9yZShxdSBRdWVyeWVyLCBpZCBpbnQ2NCkgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4ge3suUmVjZWl2ZXJ9fS5SZXN0b3JlQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYXNRdWVyeWVyQ29udGV4dChxdSksIGlkKQp9Cnt7IGVuZCB9fQovLyBSZXN0b3JlQ29udGV4dCByZXN0b3JlcyBhIHNvZnQgZGVsZXRlZCB7ey5Nb2RlbC5OYW1lfX0gcm93IG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZQpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFJlc3RvcmVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBpZCBpbnQ2NCkgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICBjb25zdCBzdG10ID0ge3sgcHJpbnRmICJVUERBVEUgJXMgU0VUICVbMl1zPU5VTEwgV0hFUkUgYGlkYCA9ID8gQU5EICVbMl1zIElTIE5PVCBOVUxMIiAoc3FsX2lkZW50IC5Nb2RlbC5UYWJsZU5hbWUpIChzcWxfaWRlbnQgLlNvZnREZWxldGUpIHwgZ29fc3RyaW5nIH19CiAgICByZXN1bHQsIGVyciA6PSBxdS5FeGVjQ29udGV4dChjdHgsIHN0bXQsIGlkKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXR1cm4gcmVzdWx0LlJvd3NBZmZlY3RlZCgpCn0KCi8vIFdpdGhEZWxldGVkIHN0YXJ0cyBhIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5IHdoaWNoIGluY2x1ZGVzIHNvZnQgZGVsZXRlZCByb3dzLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFdpdGhEZWxldGVkKCkge3suTW9kZWwuTmFtZX19UXVlcnkgewogICAgcmV0dXJuIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5e30uV2l0aERlbGV0ZWQoKQp9CgovLyBPbmx5RGVsZXRlZCBzdGFydHMgYSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSB3aGljaCBvbmx5IG1hdGNoZXMgc29mdCBkZWxldGVkIHJvd3MuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgT25seURlbGV0ZWQoKSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSB7CiAgICByZXR1cm4ge3suTW9kZWwuTmFtZX19UXVlcnl7fS5Pbmx5RGVsZXRlZCgpCn0Ke3stIGVsc2UgfX0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBEZWxldGUgYW4gZXhpc3Rpbmcge3suTW9kZWwuTmFtZX19IHJvdyBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZQpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIERlbGV0ZShxdSBRdWVyeWVyLCBpZCBpbnQ2NCkgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4ge3suUmVjZWl2ZXJ9fS5EZWxldGVDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBhc1F1ZXJ5ZXJDb250ZXh0KHF1KSwgaWQpCn0Ke3sgZW5kIH19Ci8vIERlbGV0ZUNvbnRleHQgZGVsZXRlcyBhbiBleGlzdGluZyB7ey5Nb2RlbC5OYW1lfX0gcm93IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRGVsZXRlQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgaWQgaW50NjQpIChyb3dzQWZmZWN0ZWQgaW50NjQsIGVyciBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9IHt7IHByaW50ZiAiREVMRVRFIEZST00gJXMgV0hFUkUgYGlkYCA9ID8iIChzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSkgfCBnb19zdHJpbmcgfX0KICAgIGlmIGVyciA9IGJlZm9yZURlbGV0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXN1bHQsIGVyciA6PSBxdS5FeGVjQ29udGV4dChjdHgsIHN0bXQsIGlkKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICBpZiByb3dzQWZmZWN0ZWQsIGVyciA9IHJlc3VsdC5Sb3dzQWZmZWN0ZWQoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXR1cm4gcm93c0FmZmVjdGVkLCBhZnRlckRlbGV0ZShjdHgsIHF1LCB7ey5SZWNlaXZlcn19KQp9Cnt7LSBlbmQgfX0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBDb3VudCB0aGUgbnVtYmVyIG9mIHJvd3MgZnJvbSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKZnVuYyh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIENvdW50KHF1IFF1ZXJ5ZXIpIChjb3VudCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4ge3suUmVjZWl2ZXJ9fS5Db3VudENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpKQp9Cnt7IGVuZCB9fQovLyBDb3VudENvbnRleHQgY291bnRzIHRoZSBudW1iZXIgb2Ygcm93cyBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZQpmdW5jKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgQ291bnRDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSAoY291bnQgaW50NjQsIGVyciBlcnJvcikgewogICAgcmV0dXJuIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5e30uQ291bnRDb250ZXh0KGN0eCwgcXUpCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBFeGlzdHMgY2hlY2tzIGZvciB0aGUgaXRlbXMgZXhpc3RlbmNlIGluIHRoZSBkYXRhYmFzZSwgYmFzZWQgb24gaXQncyBpZC4KLy8gQW4gZXJyb3Igd2lsbCBvbmx5IGJlIHJldHVybmVkIGlmIGEgU1FMIHJlbGF0ZWQgZmFpbHVyZSBoYXBwZW5zLgovLyBJbiBhbGwgb3RoZXIgY2FzZXMsIGEgYm9vbCBhbmQgbmlsIHdpbGwgcmV0dXJuLgpmdW5jKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgRXhpc3RzKHF1IFF1ZXJ5ZXIsIGlkIGludDY0KSAoZXhpc3RzIGJvb2wsIGVyciBlcnJvcikgewogICAgcmV0dXJuIHt7LlJlY2VpdmVyfX0uRXhpc3RzQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYXNRdWVyeWVyQ29udGV4dChxdSksIGlkKQp9Cnt7IGVuZCB9fQovLyBFeGlzdHNDb250ZXh0IGNoZWNrcyBmb3IgdGhlIGl0ZW1zIGV4aXN0ZW5jZSBpbiB0aGUgZGF0YWJhc2UsIGJhc2VkIG9uIGl0J3MgaWQuCi8vIEFuIGVycm9yIHdpbGwgb25seSBiZSByZXR1cm5lZCBpZiBhIFNRTCByZWxhdGVkIGZhaWx1cmUgaGFwcGVucy4KLy8gSW4gYWxsIG90aGVyIGNhc2VzLCBhIGJvb2wgYW5kIG5pbCB3aWxsIHJldHVybi4KZnVuYyh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEV4aXN0c0NvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQsIGlkIGludDY0KSAoZXhpc3RzIGJvb2wsIGVyciBlcnJvcikgewogICAgY29uc3Qgc3RtdCA9IHt7IHByaW50ZiAiU0VMRUNUIEVYSVNUUyhTRUxFQ1QgMSBGUk9NICVzIFdIRVJFIGBpZGAgPSA/JXMgTElNSVQgMSkgQVMgYGV4aXN0c2AiIChzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSkgKGFuZF9ub3RfZGVsZXRlZCAuKSB8IGdvX3N0cmluZyB9fQogICAgdmFyIGNvdW50IGludAogICAgcm93IDo9IHF1LlF1ZXJ5Um93Q29udGV4dChjdHgsIHN0bXQsIGlkKQogICAgaWYgZXJyID0gcm93LlNjYW4oJmNvdW50KTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXR1cm4gY291bnQgPiAwLCBuaWwKfQoKLy8ge3suTW9kZWwuTmFtZX19Q29sdW1ucyBkZXNjcmliZXMgdGhlIGNvbHVtbnMgb2YgdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyB0byBidWlsZCBjb25kaXRpb25zLCBvcmRlcmluZ3MgYW5kIGFzc2lnbm1lbnRzIGZvciBhIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5Lgp2YXIge3suTW9kZWwuTmFtZX19Q29sdW1ucyA9IHN0cnVjdCB7CiAgICB7ey0gcmFuZ2UgJGssICR2Oj0gLk1vZGVsLkZpZWxkcyB9fQogICAge3sgJHYuTmFtZSB9fSB7eyBjb2x1bW5fdHlwZSAkdi5UeXBlIH19CiAgICB7ey0gZW5kIH19Cn17CiAgICB7ey0gcmFuZ2UgJGssICR2Oj0gLk1vZGVsLkZpZWxkcyB9fQogICAge3sgJHYuTmFtZSB9fToge3sgY29sdW1uX3R5cGUgJHYuVHlwZSB9fXsge3stIGlmIG5lIChjb2x1bW5fdHlwZSAkdi5UeXBlKSAiQ29sdW1uIiB9fUNvbHVtbnsge3sgc3FsX2lkZW50ICR2LkNvbHVtbk5hbWUgfCBnb19zdHJpbmcgfX0gfXt7IGVsc2UgfX17eyBzcWxfaWRlbnQgJHYuQ29sdW1uTmFtZSB8IGdvX3N0cmluZyB9fXt7IGVuZCAtfX0gfSwKICAgIHt7LSBlbmQgfX0KfQoKLy8ge3suTW9kZWwuTmFtZX19UXVlcnkgYnVpbGRzIGEgZmlsdGVyZWQgcXVlcnkgb3ZlciB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUsIGV4OgovLyAge3suTW9kZWwuTmFtZX19UXVlcnl7fS5XaGVyZSh7ey5Nb2RlbC5OYW1lfX1Db2x1bW5zLklELkd0KDEwKSkuT3JkZXJCeSh7ey5Nb2RlbC5OYW1lfX1Db2x1bW5zLklELkRlc2MoKSkuTGltaXQoMTApCi8vIEl0cyBtZXRob2RzIHJldHVybiBhIG1vZGlmaWVkIGNvcHksIHNvIGEgcXVlcnkgbWF5IGJlIHNhZmVseSByZXVzZWQuCnR5cGUge3suTW9kZWwuTmFtZX19UXVlcnkgc3RydWN0IHsKICAgIHF1ZXJ5Cn0KCi8vIFNlbGVjdCByZXN0cmljdHMgdGhlIHF1ZXJ5IHRvIHRoZSBnaXZlbiBjb2x1bW5zIG9mIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZS4KLy8gVGhlIGZpZWxkcyBvZiBvdGhlciBjb2x1bW5zIGFyZSBsZWZ0IHplcm8gdmFsdWVkIGluIHRoZSBsb2FkZWQgcm93cy4KZnVuYyAocSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSkgU2VsZWN0KGNvbHMgLi4uU2VsZWN0YWJsZSkge3suTW9kZWwuTmFtZX19UXVlcnkgewogICAgcS5xdWVyeSA9IHEuc2VsZWN0Q29sdW1ucyhjb2xzKQogICAgcmV0dXJuIHEKfQoKLy8gV2hlcmUgYWRkcyBjb25kaXRpb25zIHRvIHRoZSBxdWVyeSwgYWxsIG9mIHdoaWNoIG5lZWQgdG8gbWF0Y2guCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIFdoZXJlKGNvbmRzIC4uLkNvbmRpdGlvbikge3suTW9kZWwuTmFtZX19UXVlcnkgewogICAgcS5xdWVyeSA9IHEud2hlcmUoY29uZHMpCiAgICByZXR1cm4gcQp9CgovLyBPcmRlckJ5IGFkZHMgb3JkZXJpbmdzIHRvIHRoZSBxdWVyeS4KZnVuYyAocSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSkgT3JkZXJCeShvcmRlcnMgLi4uT3JkZXJpbmcpIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5IHsKICAgIHEucXVlcnkgPSBxLm9yZGVyQnkob3JkZXJzKQogICAgcmV0dXJuIHEKfQoKLy8gTGltaXQgc2V0cyB0aGUgcXVlcnkgbGltaXQKZnVuYyAocSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSkgTGltaXQobGltaXQgaW50KSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSB7CiAgICBxLmxpbWl0ID0gbGltaXQKICAgIHJldHVybiBxCn0KCi8vIE9mZnNldCBzZXRzIHRoZSBxdWVyeSBvZmZzZXQKZnVuYyAocSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSkgT2Zmc2V0KG9mZnNldCBpbnQpIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5IHsKICAgIHEub2Zmc2V0ID0gb2Zmc2V0CiAgICByZXR1cm4gcQp9Cgp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIExvYWQgdGhlIHt7Lk1vZGVsLk5hbWV9fSByb3dzIG1hdGNoaW5nIHRoZSBxdWVyeSBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZQpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBMb2FkKHF1IFF1ZXJ5ZXIpIChzZXQgW117ey5Nb2RlbC5OYW1lfX0sIGVyciBlcnJvcikgewogICAgcmV0dXJuIHEuTG9hZENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpKQp9Cnt7IGVuZCB9fQovLyBMb2FkQ29udGV4dCBsb2FkcyB0aGUge3suTW9kZWwuTmFtZX19IHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIExvYWRDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSAoc2V0IFtde3suTW9kZWwuTmFtZX19LCBlcnIgZXJyb3IpIHsKICAgIGVyciA9IHEuRWFjaENvbnRleHQoY3R4LCBxdSwgZnVuYyhyb3cgKnt7Lk1vZGVsLk5hbWV9fSkgZXJyb3IgewogICAgICAgIHNldCA9IGFwcGVuZChzZXQsICpyb3cpCiAgICAgICAgcmV0dXJuIG5pbAogICAgfSkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiBuaWwsIGVycgogICAgfQogICAgcmV0dXJuIHNldCwgbmlsCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBFYWNoIGNhbGxzIGZuIHdpdGggZXZlcnkge3suTW9kZWwuTmFtZX19IHJvdyBtYXRjaGluZyB0aGUgcXVlcnksIG9uZSByb3cgYXQgYSB0aW1lLAovLyB3aXRob3V0IGxvYWRpbmcgdGhlbSBhbGwgaW4gbWVtb3J5IGZpcnN0LiBJdGVyYXRpb24gc3RvcHMgYXQgdGhlIGZpcnN0IGVycm9yIGZuIHJldHVybnMsCi8vIHdoaWNoIEVhY2ggcmV0dXJucywgdW5sZXNzIGl0IGlzIEVyclN0b3AuCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIEVhY2gocXUgUXVlcnllciwgZm4gZnVuYygqe3suTW9kZWwuTmFtZX19KSBlcnJvcikgZXJyb3IgewogICAgcmV0dXJuIHEuRWFjaENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBmbikKfQp7eyBlbmQgfX0KLy8gRWFjaENvbnRleHQgY2FsbHMgZm4gd2l0aCBldmVyeSB7ey5Nb2RlbC5OYW1lfX0gcm93IG1hdGNoaW5nIHRoZSBxdWVyeSwgb25lIHJvdyBhdCBhIHRpbWUsCi8vIHdpdGhvdXQgbG9hZGluZyB0aGVtIGFsbCBpbiBtZW1vcnkgZmlyc3QuIEl0ZXJhdGlvbiBzdG9wcyBhdCB0aGUgZmlyc3QgZXJyb3IgZm4gcmV0dXJucywKLy8gd2hpY2ggRWFjaENvbnRleHQgcmV0dXJucywgdW5sZXNzIGl0IGlzIEVyclN0b3AsIG9yIG9uY2UgdGhlIGNvbnRleHQgaXMgZG9uZS4KZnVuYyAocSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSkgRWFjaENvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQsIGZuIGZ1bmMoKnt7Lk1vZGVsLk5hbWV9fSkgZXJyb3IpIGVycm9yIHsKICAgIGN1ciwgZXJyIDo9IHEuQ3Vyc29yQ29udGV4dChjdHgsIHF1KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIGVycgogICAgfQogICAgZGVmZXIgY3VyLkNsb3NlKCkKICAgIGZvciBjdXIuTmV4dCgpIHsKICAgICAgICBpZiBlcnIgOj0gZm4oY3VyLlJvdygpKTsgZXJyICE9IG5pbCB7CiAgICAgICAgICAgIGlmIGVyciA9PSBFcnJTdG9wIHsKICAgICAgICAgICAgICAgIHJldHVybiBjdXIuQ2xvc2UoKQogICAgICAgICAgICB9CiAgICAgICAgICAgIHJldHVybiBlcnIKICAgICAgICB9CiAgICB9CiAgICBpZiBlcnIgOj0gY3VyLkVycigpOyBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gZXJyCiAgICB9CiAgICByZXR1cm4gY3VyLkNsb3NlKCkKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIEN1cnNvciBydW5zIHRoZSBxdWVyeSBhbmQgcmV0dXJucyBhIGN1cnNvciBvdmVyIHRoZSB7ey5Nb2RlbC5OYW1lfX0gcm93cyBtYXRjaGluZyBpdCwgZXg6Ci8vICBjdXIsIGVyciA6PSBxLkN1cnNvcihxdSkKLy8gIGlmIGVyciAhPSBuaWwgewovLyAgCXJldHVybiBlcnIKLy8gIH0KLy8gIGRlZmVyIGN1ci5DbG9zZSgpCi8vICBmb3IgY3VyLk5leHQoKSB7Ci8vICAJcm93IDo9IGN1ci5Sb3coKQovLyAgfQovLyAgcmV0dXJuIGN1ci5FcnIoKQpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBDdXJzb3IocXUgUXVlcnllcikgKCp7ey5Nb2RlbC5OYW1lfX1DdXJzb3IsIGVycm9yKSB7CiAgICByZXR1cm4gcS5DdXJzb3JDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBhc1F1ZXJ5ZXJDb250ZXh0KHF1KSkKfQp7eyBlbmQgfX0KLy8gQ3Vyc29yQ29udGV4dCBydW5zIHRoZSBxdWVyeSBhbmQgcmV0dXJucyBhIGN1cnNvciBvdmVyIHRoZSB7ey5Nb2RlbC5OYW1lfX0gcm93cyBtYXRjaGluZyBpdC4KLy8gVGhlIGN1cnNvciBzdG9wcyBvbmNlIHRoZSBjb250ZXh0IGlzIGRvbmUsIHJlcG9ydGluZyBpdHMgZXJyb3IgdGhyb3VnaCBFcnIuCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIEN1cnNvckNvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQpICgqe3suTW9kZWwuTmFtZX19Q3Vyc29yLCBlcnJvcikgewogICAgY29uc3QgY29sdW1ucyA9IHt7IHNlbGVjdF9maWVsZHMgLk1vZGVsLkZpZWxkcyB8IGdvX3N0cmluZyB9fQogICAge3stIGlmIC5Tb2Z0RGVsZXRlIH19CiAgICBxLnF1ZXJ5ID0gcS5zY29wZWQoe3sgc3FsX2lkZW50IC5Tb2Z0RGVsZXRlIHwgZ29fc3RyaW5nIH19KQogICAge3stIGVuZCB9fQogICAgc3RtdCwgYXJncywgZXJyIDo9IHEuc2VsZWN0U3RtdCh7eyBzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSB8IGdvX3N0cmluZyB9fSwgY29sdW1ucykKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiBuaWwsIGVycgogICAgfQogICAgcm93cywgZXJyIDo9IHF1LlF1ZXJ5Q29udGV4dChjdHgsIHN0bXQsIGFyZ3MuLi4pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gbmlsLCBlcnIKICAgIH0KICAgIHJldHVybiAme3suTW9kZWwuTmFtZX19Q3Vyc29ye3Jvd3M6IHJvd3MsIGNvbHM6IHEuc2VsZWN0ZWR9LCBuaWwKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIExvYWRGb3JVcGRhdGUgbG9hZHMgdGhlIHt7Lk1vZGVsLk5hbWV9fSByb3dzIG1hdGNoaW5nIHRoZSBxdWVyeSBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gbG9ja2luZyB0aGVtIGFnYWluc3Qgb3RoZXIgd3JpdGVzIHVudGlsIHRoZSB0cmFuc2FjdGlvbiBlbmRzLgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBMb2FkRm9yVXBkYXRlKHR4IFR4UXVlcnllciwgb3B0cyAuLi5Mb2NrT3B0aW9uKSAoc2V0IFtde3suTW9kZWwuTmFtZX19LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiBxLkxvYWRGb3JVcGRhdGVDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCB0eCwgb3B0cy4uLikKfQp7eyBlbmQgfX0KLy8gTG9hZEZvclVwZGF0ZUNvbnRleHQgbG9hZHMgdGhlIHt7Lk1vZGVsLk5hbWV9fSByb3dzIG1hdGNoaW5nIHRoZSBxdWVyeSBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gbG9ja2luZyB0aGVtIGFnYWluc3Qgb3RoZXIgd3JpdGVzIHVudGlsIHRoZSB0cmFuc2FjdGlvbiBlbmRzLgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBMb2FkRm9yVXBkYXRlQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCB0eCBUeFF1ZXJ5ZXIsIG9wdHMgLi4uTG9ja09wdGlvbikgKHNldCBbXXt7Lk1vZGVsLk5hbWV9fSwgZXJyIGVycm9yKSB7CiAgICBpZiBxLnF1ZXJ5LCBlcnIgPSBxLmxvY2tlZChmYWxzZSwgb3B0cyk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgcmV0dXJuIHEuTG9hZENvbnRleHQoY3R4LCB0eCkKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIExvYWRGb3JTaGFyZSBsb2FkcyB0aGUge3suTW9kZWwuTmFtZX19IHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyBsb2NraW5nIHRoZW0gYWdhaW5zdCB3cml0ZXMgZnJvbSBvdGhlciB0cmFuc2FjdGlvbnMgdW50aWwgdGhlIHRyYW5zYWN0aW9uIGVuZHMuCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIExvYWRGb3JTaGFyZSh0eCBUeFF1ZXJ5ZXIsIG9wdHMgLi4uTG9ja09wdGlvbikgKHNldCBbXXt7Lk1vZGVsLk5hbWV9fSwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4gcS5Mb2FkRm9yU2hhcmVDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCB0eCwgb3B0cy4uLikKfQp7eyBlbmQgfX0KLy8gTG9hZEZvclNoYXJlQ29udGV4dCBsb2FkcyB0aGUge3suTW9kZWwuTmFtZX19IHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLAovLyBsb2NraW5nIHRoZW0gYWdhaW5zdCB3cml0ZXMgZnJvbSBvdGhlciB0cmFuc2FjdGlvbnMgdW50aWwgdGhlIHRyYW5zYWN0aW9uIGVuZHMuCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIExvYWRGb3JTaGFyZUNvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgdHggVHhRdWVyeWVyLCBvcHRzIC4uLkxvY2tPcHRpb24pIChzZXQgW117ey5Nb2RlbC5OYW1lfX0sIGVyciBlcnJvcikgewogICAgaWYgcS5xdWVyeSwgZXJyID0gcS5sb2NrZWQodHJ1ZSwgb3B0cyk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgcmV0dXJuIHEuTG9hZENvbnRleHQoY3R4LCB0eCkKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIENvdW50IHRoZSBudW1iZXIgb2Ygcm93cyBtYXRjaGluZyB0aGUgcXVlcnkgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIENvdW50KHF1IFF1ZXJ5ZXIpIChjb3VudCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4gcS5Db3VudENvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpKQp9Cnt7IGVuZCB9fQovLyBDb3VudENvbnRleHQgY291bnRzIHRoZSBudW1iZXIgb2Ygcm93cyBtYXRjaGluZyB0aGUgcXVlcnkgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIENvdW50Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgKGNvdW50IGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHt7LSBpZiAuU29mdERlbGV0ZSB9fQogICAgcS5xdWVyeSA9IHEuc2NvcGVkKHt7IHNxbF9pZGVudCAuU29mdERlbGV0ZSB8IGdvX3N0cmluZyB9fSkKICAgIHt7LSBlbmQgfX0KICAgIHN0bXQsIGFyZ3MgOj0gcS5jb3VudFN0bXQoe3sgc3FsX2lkZW50IC5Nb2RlbC5UYWJsZU5hbWUgfCBnb19zdHJpbmcgfX0pCiAgICBlcnIgPSBxdS5RdWVyeVJvd0NvbnRleHQoY3R4LCBzdG10LCBhcmdzLi4uKS5TY2FuKCZjb3VudCkKICAgIHJldHVybgp9Cnt7LSBpZiAuU29mdERlbGV0ZSB9fQoKLy8gV2l0aERlbGV0ZWQgaW5jbHVkZXMgc29mdCBkZWxldGVkIHJvd3MgaW4gdGhlIHF1ZXJ5LgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBXaXRoRGVsZXRlZCgpIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5IHsKICAgIHEuZGVsZXRlZCA9IHdpdGhEZWxldGVkCiAgICByZXR1cm4gcQp9CgovLyBPbmx5RGVsZXRlZCByZXN0cmljdHMgdGhlIHF1ZXJ5IHRvIHNvZnQgZGVsZXRlZCByb3dzLgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBPbmx5RGVsZXRlZCgpIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5IHsKICAgIHEuZGVsZXRlZCA9IG9ubHlEZWxldGVkCiAgICByZXR1cm4gcQp9Cnt7IGlmIG5vdCAuQ29udGV4dE9ubHkgfX0KLy8gRGVsZXRlIHRoZSByb3dzIG1hdGNoaW5nIHRoZSBxdWVyeSBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwgYnkgc2V0dGluZyB0aGVpciB7ey5Tb2Z0RGVsZXRlfX0gY29sdW1uLgovLyBBIHF1ZXJ5IHdpdGhvdXQgYW55IGNvbmRpdGlvbnMgaXMgcmVmdXNlZCwgcmF0aGVyIHRoYW4gZGVsZXRpbmcgZXZlcnkgcm93LgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBEZWxldGUocXUgUXVlcnllcikgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4gcS5EZWxldGVDb250ZXh0KGNvbnRleHQuQmFja2dyb3VuZCgpLCBhc1F1ZXJ5ZXJDb250ZXh0KHF1KSkKfQp7eyBlbmQgfX0KLy8gRGVsZXRlQ29udGV4dCBkZWxldGVzIHRoZSByb3dzIG1hdGNoaW5nIHRoZSBxdWVyeSBmcm9tIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwgYnkgc2V0dGluZyB0aGVpciB7ey5Tb2Z0RGVsZXRlfX0gY29sdW1uLgovLyBBIHF1ZXJ5IHdpdGhvdXQgYW55IGNvbmRpdGlvbnMgaXMgcmVmdXNlZCwgcmF0aGVyIHRoYW4gZGVsZXRpbmcgZXZlcnkgcm93LgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBEZWxldGVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHEuZGVsZXRlZCA9IHdpdGhvdXREZWxldGVkCiAgICBxLnF1ZXJ5ID0gcS5zY29wZWQoe3sgc3FsX2lkZW50IC5Tb2Z0RGVsZXRlIHwgZ29fc3RyaW5nIH19KQogICAgc2V0IDo9IFtdQXNzaWdubWVudHsge2V4cHI6IHt7IHByaW50ZiAiJXM9VVRDX1RJTUVTVEFNUCgpIiAoc3FsX2lkZW50IC5Tb2Z0RGVsZXRlKSB8IGdvX3N0cmluZyB9fX0gfQogICAgc3RtdCwgYXJncywgZXJyIDo9IHEudXBkYXRlU3RtdCh7eyBzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSB8IGdvX3N0cmluZyB9fSwgc2V0KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXN1bHQsIGVyciA6PSBxdS5FeGVjQ29udGV4dChjdHgsIHN0bXQsIGFyZ3MuLi4pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJldHVybiByZXN1bHQuUm93c0FmZmVjdGVkKCkKfQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIEhhcmREZWxldGUgcmVtb3ZlcyB0aGUgcm93cyBtYXRjaGluZyB0aGUgcXVlcnkgZnJvbSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUuCi8vIEEgcXVlcnkgd2l0aG91dCBhbnkgY29uZGl0aW9ucyBpcyByZWZ1c2VkLCByYXRoZXIgdGhhbiBkZWxldGluZyBldmVyeSByb3cuCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIEhhcmREZWxldGUocXUgUXVlcnllcikgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4gcS5IYXJkRGVsZXRlQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYXNRdWVyeWVyQ29udGV4dChxdSkpCn0Ke3sgZW5kIH19Ci8vIEhhcmREZWxldGVDb250ZXh0IHJlbW92ZXMgdGhlIHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLgovLyBBIHF1ZXJ5IHdpdGhvdXQgYW55IGNvbmRpdGlvbnMgaXMgcmVmdXNlZCwgcmF0aGVyIHRoYW4gZGVsZXRpbmcgZXZlcnkgcm93LgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBIYXJkRGVsZXRlQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICBxLnF1ZXJ5ID0gcS5zY29wZWQoe3sgc3FsX2lkZW50IC5Tb2Z0RGVsZXRlIHwgZ29fc3RyaW5nIH19KQp7ey0gZWxzZSB9fQp7eyBpZiBub3QgLkNvbnRleHRPbmx5IH19Ci8vIERlbGV0ZSB0aGUgcm93cyBtYXRjaGluZyB0aGUgcXVlcnkgZnJvbSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUuCi8vIEEgcXVlcnkgd2l0aG91dCBhbnkgY29uZGl0aW9ucyBpcyByZWZ1c2VkLCByYXRoZXIgdGhhbiBkZWxldGluZyBldmVyeSByb3cuCmZ1bmMgKHEge3suTW9kZWwuTmFtZX19UXVlcnkpIERlbGV0ZShxdSBRdWVyeWVyKSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiBxLkRlbGV0ZUNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpKQp9Cnt7IGVuZCB9fQovLyBEZWxldGVDb250ZXh0IGRlbGV0ZXMgdGhlIHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5IGZyb20gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlLgovLyBBIHF1ZXJ5IHdpdGhvdXQgYW55IGNvbmRpdGlvbnMgaXMgcmVmdXNlZCwgcmF0aGVyIHRoYW4gZGVsZXRpbmcgZXZlcnkgcm93LgpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBEZWxldGVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKe3stIGVuZCB9fQogICAgc3RtdCwgYXJncywgZXJyIDo9IHEuZGVsZXRlU3RtdCh7eyBzcWxfaWRlbnQgLk1vZGVsLlRhYmxlTmFtZSB8IGdvX3N0cmluZyB9fSkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgcmVzdWx0LCBlcnIgOj0gcXUuRXhlY0NvbnRleHQoY3R4LCBzdG10LCBhcmdzLi4uKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByZXR1cm4gcmVzdWx0LlJvd3NBZmZlY3RlZCgpCn0Ke3sgaWYgbm90IC5Db250ZXh0T25seSB9fQovLyBVcGRhdGUgdGhlIHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5IGluIHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSB3aXRoIHRoZSBhc3NpZ25tZW50cy4KLy8gQSBxdWVyeSB3aXRob3V0IGFueSBjb25kaXRpb25zIGlzIHJlZnVzZWQsIHJhdGhlciB0aGFuIHVwZGF0aW5nIGV2ZXJ5IHJvdy4Ke3stIGlmIC5WZXJzaW9uIH19Ci8vIFRoZSB7ey5WZXJzaW9ufX0gb2YgZXZlcnkgdXBkYXRlZCByb3cgaXMgaW5jcmVtZW50ZWQsIHNvIG1vZGVscyByZWFkIGJlZm9yZSBiZWNvbWUgc3RhbGUuCnt7LSBlbmQgfX0KZnVuYyAocSB7ey5Nb2RlbC5OYW1lfX1RdWVyeSkgVXBkYXRlKHF1IFF1ZXJ5ZXIsIHNldCAuLi5Bc3NpZ25tZW50KSAocm93c0FmZmVjdGVkIGludDY0LCBlcnIgZXJyb3IpIHsKICAgIHJldHVybiBxLlVwZGF0ZUNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBzZXQuLi4pCn0Ke3sgZW5kIH19Ci8vIFVwZGF0ZUNvbnRleHQgdXBkYXRlcyB0aGUgcm93cyBtYXRjaGluZyB0aGUgcXVlcnkgaW4gdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlIHdpdGggdGhlIGFzc2lnbm1lbnRzLgovLyBBIHF1ZXJ5IHdpdGhvdXQgYW55IGNvbmRpdGlvbnMgaXMgcmVmdXNlZCwgcmF0aGVyIHRoYW4gdXBkYXRpbmcgZXZlcnkgcm93Lgp7ey0gaWYgLlZlcnNpb24gfX0KLy8gVGhlIHt7LlZlcnNpb259fSBvZiBldmVyeSB1cGRhdGVkIHJvdyBpcyBpbmNyZW1lbnRlZCwgc28gbW9kZWxzIHJlYWQgYmVmb3JlIGJlY29tZSBzdGFsZS4Ke3stIGVuZCB9fQpmdW5jIChxIHt7Lk1vZGVsLk5hbWV9fVF1ZXJ5KSBVcGRhdGVDb250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBzZXQgLi4uQXNzaWdubWVudCkgKHJvd3NBZmZlY3RlZCBpbnQ2NCwgZXJyIGVycm9yKSB7CiAgICB7ey0gaWYgaGFzX2NvbHVtbiAuTW9kZWwuRmllbGRzICJ1cGRhdGVkX2F0IiB9fQogICAgc2V0ID0gYXBwZW5kKHNldFs6bGVuKHNldCk6bGVuKHNldCldLCBBc3NpZ25tZW50e2V4cHI6IHt7IHByaW50ZiAiJXM9VVRDX1RJTUVTVEFNUCgpIiAoc3FsX2lkZW50ICJ1cGRhdGVkX2F0IikgfCBnb19zdHJpbmcgfX19KQogICAge3stIGVuZCB9fQogICAge3stIGlmIC5WZXJzaW9uIH19CiAgICBzZXQgPSBhcHBlbmQoc2V0WzpsZW4oc2V0KTpsZW4oc2V0KV0sIEFzc2lnbm1lbnR7ZXhwcjoge3sgcHJpbnRmICIlWzFdcz0lWzFdcysxIiAoc3FsX2lkZW50IC5WZXJzaW9uKSB8IGdvX3N0cmluZyB9fX0pCiAgICB7ey0gZW5kIH19CiAgICB7ey0gaWYgLlNvZnREZWxldGUgfX0KICAgIHEucXVlcnkgPSBxLnNjb3BlZCh7eyBzcWxfaWRlbnQgLlNvZnREZWxldGUgfCBnb19zdHJpbmcgfX0pCiAgICB7ey0gZW5kIH19CiAgICBzdG10LCBhcmdzLCBlcnIgOj0gcS51cGRhdGVTdG10KHt7IHNxbF9pZGVudCAuTW9kZWwuVGFibGVOYW1lIHwgZ29fc3RyaW5nIH19LCBzZXQpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJlc3VsdCwgZXJyIDo9IHF1LkV4ZWNDb250ZXh0KGN0eCwgc3RtdCwgYXJncy4uLikKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgcmV0dXJuIHJlc3VsdC5Sb3dzQWZmZWN0ZWQoKQp9CgovLyB7ey5Nb2RlbC5OYW1lfX1DdXJzb3IgaXRlcmF0ZXMgb3ZlciB7ey5Nb2RlbC5OYW1lfX0gcm93cywgc2Nhbm5pbmcgb25lIHJvdyBhdCBhIHRpbWUuCi8vIEl0IG5lZWRzIHRvIGJlIGNsb3NlZCBvbmNlIGRvbmUgd2l0aCwgdGhvdWdoIGl0IGNsb3NlcyBieSBpdHNlbGYgd2hlbiByZWFjaGluZyB0aGUgbGFzdCByb3cuCnR5cGUge3suTW9kZWwuTmFtZX19Q3Vyc29yIHN0cnVjdCB7CiAgICByb3dzICpzcWwuUm93cwogICAgY29scyBbXUNvbHVtbgogICAgcm93ICAqe3suTW9kZWwuTmFtZX19CiAgICBlcnIgIGVycm9yCn0KCi8vIE5leHQgc2NhbnMgdGhlIG5leHQgcm93LCByZXR1cm5pbmcgZmFsc2Ugd2hlbiB0aGVyZSBhcmUgbm8gcm93cyBsZWZ0IG9yIHNjYW5uaW5nIGZhaWxlZCwKLy8gaW4gd2hpY2ggY2FzZSBFcnIgcmVwb3J0cyB3aHkuCmZ1bmMgKGMgKnt7Lk1vZGVsLk5hbWV9fUN1cnNvcikgTmV4dCgpIGJvb2wgewogICAgaWYgYy5lcnIgIT0gbmlsIHx8ICFjLnJvd3MuTmV4dCgpIHsKICAgICAgICByZXR1cm4gZmFsc2UKICAgIH0KICAgIHJvdyA6PSBuZXcoe3suTW9kZWwuTmFtZX19KQogICAgZGVzdCwgZXJyIDo9IHJvdy5maWVsZHNGb3IoYy5jb2xzKQogICAgaWYgZXJyID09IG5pbCB7CiAgICAgICAgZXJyID0gYy5yb3dzLlNjYW4oZGVzdC4uLikKICAgIH0KICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIGMuZXJyID0gZXJyCiAgICAgICAgYy5yb3dzLkNsb3NlKCkKICAgICAgICByZXR1cm4gZmFsc2UKICAgIH0KICAgIHJvdy5TbmFwc2hvdCgpCiAgICBjLnJvdyA9IHJvdwogICAgcmV0dXJuIHRydWUKfQoKLy8gUm93IHJldHVybnMgdGhlIHJvdyBzY2FubmVkIGJ5IHRoZSBsYXN0IGNhbGwgdG8gTmV4dC4KLy8gRXZlcnkgcm93IGlzIHNjYW5uZWQgaW50byBhIG5ldyB7ey5Nb2RlbC5OYW1lfX0sIHNvIGl0IG1heSBiZSBrZXB0IGFyb3VuZC4KZnVuYyAoYyAqe3suTW9kZWwuTmFtZX19Q3Vyc29yKSBSb3coKSAqe3suTW9kZWwuTmFtZX19IHsKICAgIHJldHVybiBjLnJvdwp9CgovLyBFcnIgcmV0dXJucyB0aGUgZXJyb3Igd2hpY2ggc3RvcHBlZCB0aGUgaXRlcmF0aW9uLCBpZiBhbnkuCmZ1bmMgKGMgKnt7Lk1vZGVsLk5hbWV9fUN1cnNvcikgRXJyKCkgZXJyb3IgewogICAgaWYgYy5lcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4gYy5lcnIKICAgIH0KICAgIHJldHVybiBjLnJvd3MuRXJyKCkKfQoKLy8gQ2xvc2UgY2xvc2VzIHRoZSBjdXJzb3IsIGl0IG1heSBiZSBjYWxsZWQgbW9yZSB0aGFuIG9uY2UuCmZ1bmMgKGMgKnt7Lk1vZGVsLk5hbWV9fUN1cnNvcikgQ2xvc2UoKSBlcnJvciB7CiAgICByZXR1cm4gYy5yb3dzLkNsb3NlKCkKfQoKLy8ge3suTW9kZWwuTmFtZX19UGFnZSBpcyBhIHBhZ2Ugb2Yge3suTW9kZWwuTmFtZX19IHJvd3MgbG9hZGVkIHRocm91Z2gga2V5c2V0IHBhZ2luYXRpb24uCnR5cGUge3suTW9kZWwuTmFtZX19UGFnZSBzdHJ1Y3QgewogICAgUm93cyBbXXt7Lk1vZGVsLk5hbWV9fQogICAgLy8gTmV4dCBpcyB0aGUgY3Vyc29yIHRvIGxvYWQgdGhlIGZvbGxvd2luZyBwYWdlIHdpdGgsCiAgICAvLyBpdCBzdGF5cyB1c2FibGUgdG8gcG9sbCBmb3IgbmV3IHJvd3Mgd2hlbiBIYXNNb3JlIGlzIGZhbHNlLgogICAgTmV4dCBzdHJpbmcKICAgIC8vIEhhc01vcmUgcmVwb3J0cyB3aGV0aGVyIGFueSByb3dzIGZvbGxvdyB0aGlzIHBhZ2UuCiAgICBIYXNNb3JlIGJvb2wKfQp7eyByYW5nZSAkaywgJGtleSA6PSAuTW9kZWwuS2V5cyB9fQp7ey0gaWYgbm90ICQuQ29udGV4dE9ubHkgfX0KLy8gTG9hZEFmdGVye3sgaWYgJGtleS5OYW1lIH19Qnl7eyAka2V5Lk5hbWUgfX17eyBlbmQgfX0gbG9hZHMgdXAgdG8gbiB7eyQuTW9kZWwuTmFtZX19IHJvd3MgbWF0Y2hpbmcgdGhlIHF1ZXJ5LCBvcmRlcmVkIGJ5IHt7IHJhbmdlICRpLCAkZiA6PSAka2V5LkZpZWxkcyB9fXt7IGlmICRpIH19LCB7eyBlbmQgfX17eyAkZi5Db2x1bW5OYW1lIH19e3sgZW5kIH19LAovLyBmb2xsb3dpbmcgdGhlIHJvdyB0aGUgY3Vyc29yIHBvaW50cyBhdC4gUGFzcyBhbiBlbXB0eSBjdXJzb3IgdG8gbG9hZCB0aGUgZmlyc3QgcGFnZS4KZnVuYyAocSB7eyQuTW9kZWwuTmFtZX19UXVlcnkpIExvYWRBZnRlcnt7IGlmICRrZXkuTmFtZSB9fUJ5e3sgJGtleS5OYW1lIH19e3sgZW5kIH19KHF1IFF1ZXJ5ZXIsIGN1cnNvciBzdHJpbmcsIG4gaW50KSAocGFnZSB7eyQuTW9kZWwuTmFtZX19UGFnZSwgZXJyIGVycm9yKSB7CiAgICByZXR1cm4gcS5Mb2FkQWZ0ZXJ7eyBpZiAka2V5Lk5hbWUgfX1CeXt7ICRrZXkuTmFtZSB9fXt7IGVuZCB9fUNvbnRleHQoY29udGV4dC5CYWNrZ3JvdW5kKCksIGFzUXVlcnllckNvbnRleHQocXUpLCBjdXJzb3IsIG4pCn0Ke3sgZW5kIH19Ci8vIExvYWRBZnRlcnt7IGlmICRrZXkuTmFtZSB9fUJ5e3sgJGtleS5OYW1lIH19e3sgZW5kIH19Q29udGV4dCBsb2FkcyB1cCB0byBuIHt7JC5Nb2RlbC5OYW1lfX0gcm93cyBtYXRjaGluZyB0aGUgcXVlcnksIG9yZGVyZWQgYnkge3sgcmFuZ2UgJGksICRmIDo9ICRrZXkuRmllbGRzIH19e3sgaWYgJGkgfX0sIHt7IGVuZCB9fXt7ICRmLkNvbHVtbk5hbWUgfX17eyBlbmQgfX0sCi8vIGZvbGxvd2luZyB0aGUgcm93IHRoZSBjdXJzb3IgcG9pbnRzIGF0LiBQYXNzIGFuIGVtcHR5IGN1cnNvciB0byBsb2FkIHRoZSBmaXJzdCBwYWdlLgpmdW5jIChxIHt7JC5Nb2RlbC5OYW1lfX1RdWVyeSkgTG9hZEFmdGVye3sgaWYgJGtleS5OYW1lIH19Qnl7eyAka2V5Lk5hbWUgfX17eyBlbmQgfX1Db250ZXh0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBjdXJzb3Igc3RyaW5nLCBuIGludCkgKHBhZ2Uge3skLk1vZGVsLk5hbWV9fVBhZ2UsIGVyciBlcnJvcikgewogICAgdHlwZSBrZXkgc3RydWN0IHsKICAgICAgICB7ey0gcmFuZ2UgJGksICRmIDo9ICRrZXkuRmllbGRzIH19CiAgICAgICAge3sgJGYuTmFtZSB9fSB7eyAkZi5UeXBlIH19IGBqc29uOiJ7eyAkZi5Db2x1bW5OYW1lIH19ImAKICAgICAgICB7ey0gZW5kIH19CiAgICB9CiAgICB2YXIgYWZ0ZXIgW11pbnRlcmZhY2V7fQogICAgaWYgY3Vyc29yICE9ICIiIHsKICAgICAgICB2YXIgayBrZXkKICAgICAgICBpZiBlcnIgPSBkZWNvZGVDdXJzb3IoY3Vyc29yLCAmayk7IGVyciAhPSBuaWwgewogICAgICAgICAgICByZXR1cm4KICAgICAgICB9CiAgICAgICAgYWZ0ZXIgPSBbXWludGVyZmFjZXt9eyB7ey0gcmFuZ2UgJGksICRmIDo9ICRrZXkuRmllbGRzIH19e3sgaWYgJGkgfX0sIHt7IGVuZCB9fWsue3sgJGYuTmFtZSB9fXt7IGVuZCAtfX0gfQogICAgfQogICAga2V5cyA6PSBbXUNvbHVtbnsge3stIHJhbmdlICRpLCAkZiA6PSAka2V5LkZpZWxkcyB9fXt7IGlmICRpIH19LCB7eyBlbmQgfX17eyQuTW9kZWwuTmFtZX19Q29sdW1ucy57eyAkZi5OYW1lIH19LkNvbHVtbnt7IGVuZCAtfX0gfQogICAgaWYgcS5xdWVyeSwgZXJyID0gcS5wYWdlKGtleXMsIGFmdGVyLCBuKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuCiAgICB9CiAgICBpZiBwYWdlLlJvd3MsIGVyciA9IHEuTG9hZENvbnRleHQoY3R4LCBxdSk7IGVyciAhPSBuaWwgewogICAgICAgIHJldHVybgogICAgfQogICAgaWYgbGVuKHBhZ2UuUm93cykgPiBuIHsKICAgICAgICBwYWdlLlJvd3MsIHBhZ2UuSGFzTW9yZSA9IHBhZ2UuUm93c1s6bl0sIHRydWUKICAgIH0KICAgIHBhZ2UuTmV4dCA9IGN1cnNvcgogICAgaWYgbGVuKHBhZ2UuUm93cykgPiAwIHsKICAgICAgICBsYXN0IDo9IHBhZ2UuUm93c1tsZW4ocGFnZS5Sb3dzKS0xXQogICAgICAgIHBhZ2UuTmV4dCwgZXJyID0gZW5jb2RlQ3Vyc29yKGtleXsge3stIHJhbmdlICRpLCAkZiA6PSAka2V5LkZpZWxkcyB9fXt7IGlmICRpIH19LCB7eyBlbmQgfX17eyAkZi5OYW1lIH19OiBsYXN0Lnt7ICRmLk5hbWUgfX17eyBlbmQgLX19IH0pCiAgICB9CiAgICByZXR1cm4KfQp7eyBlbmQgfX0KLy8gZmllbGRzRm9yIHJldHVybnMgdGhlIHNjYW4gZGVzdGluYXRpb25zIGZvciB0aGUgZ2l2ZW4gY29sdW1ucywKLy8gb3IgZm9yIGV2ZXJ5IGNvbHVtbiBpbiBzdHJ1Y3Qgb3JkZXIgaWYgbm9uZSBhcmUgZ2l2ZW4uCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgZmllbGRzRm9yKGNvbHMgW11Db2x1bW4pIChbXWludGVyZmFjZXt9LCBlcnJvcikgewogICAgaWYgbGVuKGNvbHMpID09IDAgewogICAgICAgIHJldHVybiBbXWludGVyZmFjZXt9eyB7eyAuIHwgc2Nhbl9maWVsZHMgfX0gfSwgbmlsCiAgICB9CiAgICBkZXN0IDo9IG1ha2UoW11pbnRlcmZhY2V7fSwgbGVuKGNvbHMpKQogICAgZm9yIHBvcywgY29sIDo9IHJhbmdlIGNvbHMgewogICAgICAgIHN3aXRjaCBjb2wubmFtZSB7CiAgICAgICAge3stIHJhbmdlICRrLCAkdiA6PSAuTW9kZWwuRmllbGRzIH19CiAgICAgICAgY2FzZSB7eyBzcWxfaWRlbnQgJHYuQ29sdW1uTmFtZSB8IGdvX3N0cmluZyB9fToKICAgICAgICAgICAgZGVzdFtwb3NdID0gJnt7JC5SZWNlaXZlcn19Lnt7ICR2Lk5hbWUgfX0KICAgICAgICB7ey0gZW5kIH19CiAgICAgICAgZGVmYXVsdDoKICAgICAgICAgICAgcmV0dXJuIG5pbCwgZm10LkVycm9yZigiY29sdW1uICVzIGlzIG5vdCBwYXJ0IG9mIHRoZSAlcyB0YWJsZSIsIGNvbC5uYW1lLCB7eyBnb19zdHJpbmcgLk1vZGVsLlRhYmxlTmFtZSB9fSkKICAgICAgICB9CiAgICB9CiAgICByZXR1cm4gZGVzdCwgbmlsCn0KCi8vIHZhbHVlc0ZvciByZXR1cm5zIHRoZSBmaWVsZCB2YWx1ZXMgZm9yIHRoZSBnaXZlbiBjb2x1bW5zLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIHZhbHVlc0Zvcihjb2xzIFtdQ29sdW1uKSAoW11pbnRlcmZhY2V7fSwgZXJyb3IpIHsKICAgIHZhbHVlcyA6PSBtYWtlKFtdaW50ZXJmYWNle30sIGxlbihjb2xzKSkKICAgIGZvciBwb3MsIGNvbCA6PSByYW5nZSBjb2xzIHsKICAgICAgICBzd2l0Y2ggY29sLm5hbWUgewogICAgICAgIHt7LSByYW5nZSAkaywgJHYgOj0gLk1vZGVsLkZpZWxkcyB9fQogICAgICAgIGNhc2Uge3sgc3FsX2lkZW50ICR2LkNvbHVtbk5hbWUgfCBnb19zdHJpbmcgfX06CiAgICAgICAgICAgIHZhbHVlc1twb3NdID0ge3skLlJlY2VpdmVyfX0ue3sgJHYuTmFtZSB9fQogICAgICAgIHt7LSBlbmQgfX0KICAgICAgICBkZWZhdWx0OgogICAgICAgICAgICByZXR1cm4gbmlsLCBmbXQuRXJyb3JmKCJjb2x1bW4gJXMgaXMgbm90IHBhcnQgb2YgdGhlICVzIHRhYmxlIiwgY29sLm5hbWUsIHt7IGdvX3N0cmluZyAuTW9kZWwuVGFibGVOYW1lIH19KQogICAgICAgIH0KICAgIH0KICAgIHJldHVybiB2YWx1ZXMsIG5pbAp9CgovLyBTbmFwc2hvdCByZWNvcmRzIHRoZSBjdXJyZW50IGZpZWxkIHZhbHVlcyBhcyB0aGUgb25lcyBzdG9yZWQgaW4gdGhlIHRhYmxlLAovLyB3aGljaCBEaXJ0eUNvbHVtbnMgY29tcGFyZXMgYWdhaW5zdC4gRmluZCwgTG9hZCwgVXBkYXRlLCBTYXZlIGFuZCBVcGRhdGVDb2x1bW5zIHRha2UgYSBzbmFwc2hvdCBieSB0aGVtc2VsdmVzLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIFNuYXBzaG90KCkgewogICAgc25hcCA6PSAqe3suUmVjZWl2ZXJ9fQogICAgc25hcC5zbmFwc2hvdCA9IG5pbAogICAge3stIHJhbmdlICRrLCAkdiA6PSAuTW9kZWwuRmllbGRzIH19CiAgICB7ey0gaWYgb3IgKGVxICR2LlR5cGUgIltdYnl0ZSIpIChlcSAkdi5UeXBlICJSYXdKU09OIikgfX0KICAgIHNuYXAue3sgJHYuTmFtZSB9fSA9IGFwcGVuZChzbmFwLnt7ICR2Lk5hbWUgfX1bOjA6MF0sIHt7JC5SZWNlaXZlcn19Lnt7ICR2Lk5hbWUgfX0uLi4pCiAgICB7ey0gZW5kIH19CiAgICB7ey0gZW5kIH19CiAgICB7ey5SZWNlaXZlcn19LnNuYXBzaG90ID0gJnNuYXAKfQoKLy8gRGlydHlDb2x1bW5zIHJldHVybnMgdGhlIGNvbHVtbnMgd2hvc2UgZmllbGRzIGNoYW5nZWQgc2luY2UgdGhlIGxhc3Qgc25hcHNob3QsCi8vIG9yIGV2ZXJ5IGNvbHVtbiBVcGRhdGUgd3JpdGVzIGlmIG5vIHNuYXBzaG90IHdhcyB0YWtlbi4KZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBEaXJ0eUNvbHVtbnMoKSBbXUNvbHVtbiB7CiAgICBzbmFwIDo9IHt7LlJlY2VpdmVyfX0uc25hcHNob3QKICAgIHZhciBjb2xzIFtdQ29sdW1uCiAgICB7ey0gcmFuZ2UgJGssICR2IDo9IHVwZGF0ZV9maWVsZHMgLiB9fQogICAge3stIGlmIG9yIChlcSAkdi5UeXBlICJbXWJ5dGUiKSAoZXEgJHYuVHlwZSAiUmF3SlNPTiIpIH19CiAgICBpZiBzbmFwID09IG5pbCB8fCBzdHJpbmcoe3skLlJlY2VpdmVyfX0ue3sgJHYuTmFtZSB9fSkgIT0gc3RyaW5nKHNuYXAue3sgJHYuTmFtZSB9fSkgewogICAge3stIGVsc2UgfX0KICAgIGlmIHNuYXAgPT0gbmlsIHx8IHt7JC5SZWNlaXZlcn19Lnt7ICR2Lk5hbWUgfX0gIT0gc25hcC57eyAkdi5OYW1lIH19IHsKICAgIHt7LSBlbmQgfX0KICAgICAgICBjb2xzID0gYXBwZW5kKGNvbHMsIENvbHVtbnsge3stIHNxbF9pZGVudCAkdi5Db2x1bW5OYW1lIHwgZ29fc3RyaW5nIC19fSB9KQogICAgfQogICAge3stIGVuZCB9fQogICAgcmV0dXJuIGNvbHMKfQoKLy8gVGFibGVOYW1lIHJldHVybnMgdGhlIHRhYmxlIG5hbWUKZnVuYyAoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBUYWJsZU5hbWUoKSBzdHJpbmcgewpyZXR1cm4ge3sgZ29fc3RyaW5nIC5Nb2RlbC5UYWJsZU5hbWUgfX0KfQp7e2VuZH19Cgo=\"")
+	packr.PackJSONBytes("./tmpl", "model_graphql.html", "\"e3tkZWZpbmUgIm1vZGVsZ3JhcGhxbCJ9fQpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImNvbnRleHQiCgkiZGF0YWJhc2Uvc3FsIgoKCWdyYXBocWwgImdpdGh1Yi5jb20vZ3JhcGgtZ29waGVycy9ncmFwaHFsLWdvIgopCgp7ey0gd2l0aCAuVHlwZSB9fQoKLy8ge3sgZ3JhcGhxbF9tZXRob2QgLlNpbmdsZSB9fSByZXNvbHZlcyB0aGUge3sgLlNpbmdsZSB9fSBxdWVyeSwgdGhlIHt7IC5Nb2RlbC5OYW1lIH19IHdpdGggdGhlIGdpdmVuIGlkLCBvciBudWxsIGlmIHRoZXJlIGlzIG5vbmUuCmZ1bmMgKHIgKlJlc29sdmVyKSB7eyBncmFwaHFsX21ldGhvZCAuU2luZ2xlIH19KGN0eCBjb250ZXh0LkNvbnRleHQsIGFyZ3Mgc3RydWN0eyBJRCBncmFwaHFsLklEIH0pICgqe3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlciwgZXJyb3IpIHsKCWlkLCBlcnIgOj0gcGFyc2VJRChhcmdzLklEKQoJaWYgZXJyICE9IG5pbCB7CgkJcmV0dXJuIG5pbCwgZXJyCgl9CglyZXR1cm4gci5maW5ke3sgLk1vZGVsLk5hbWUgfX0oY3R4LCBpZCkKfQoKLy8ge3sgZ3JhcGhxbF9tZXRob2QgLlBsdXJhbCB9fSByZXNvbHZlcyB0aGUge3sgLlBsdXJhbCB9fSBxdWVyeSwgcGFnaW5hdGluZyB0aHJvdWdoIGV2ZXJ5IHt7IC5Nb2RlbC5OYW1lIH19LgpmdW5jIChyICpSZXNvbHZlcikge3sgZ3JhcGhxbF9tZXRob2QgLlBsdXJhbCB9fShjdHggY29udGV4dC5Db250ZXh0LCBhcmdzIENvbm5lY3Rpb25BcmdzKSAoKnt7IC5Nb2RlbC5OYW1lIH19Q29ubmVjdGlvblJlc29sdmVyLCBlcnJvcikgewoJcmV0dXJuIHIue3sgLlNpbmdsZSB9fUNvbm5lY3Rpb24oY3R4LCB7eyAuTW9kZWwuTmFtZSB9fVF1ZXJ5e30sIGFyZ3MpCn0KCmZ1bmMgKHIgKlJlc29sdmVyKSBmaW5ke3sgLk1vZGVsLk5hbWUgfX0oY3R4IGNvbnRleHQuQ29udGV4dCwgaWQgaW50NjQpICgqe3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlciwgZXJyb3IpIHsKCXZhciByb3cge3sgLk1vZGVsLk5hbWUgfX0KCWlmIGVyciA6PSByb3cuRmluZENvbnRleHQoY3R4LCByLkRCLCBpZCk7IGVyciA9PSBzcWwuRXJyTm9Sb3dzIHsKCQlyZXR1cm4gbmlsLCBuaWwKCX0gZWxzZSBpZiBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gbmlsLCBlcnIKCX0KCXJldHVybiAme3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlcntyb290OiByLCBtOiAmcm93fSwgbmlsCn0KCmZ1bmMgKHIgKlJlc29sdmVyKSB7eyAuU2luZ2xlIH19Q29ubmVjdGlvbihjdHggY29udGV4dC5Db250ZXh0LCBxIHt7IC5Nb2RlbC5OYW1lIH19UXVlcnksIGFyZ3MgQ29ubmVjdGlvbkFyZ3MpICgqe3sgLk1vZGVsLk5hbWUgfX1Db25uZWN0aW9uUmVzb2x2ZXIsIGVycm9yKSB7CgluLCBhZnRlciwgZXJyIDo9IGFyZ3MucGFnZSgpCglpZiBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gbmlsLCBlcnIKCX0KCXBhZ2UsIGVyciA6PSBxLkxvYWRBZnRlckNvbnRleHQoY3R4LCByLkRCLCBhZnRlciwgbikKCWlmIGVyciAhPSBuaWwgewoJCXJldHVybiBuaWwsIGVycgoJfQoJcmV0dXJuICZ7eyAuTW9kZWwuTmFtZSB9fUNvbm5lY3Rpb25SZXNvbHZlcntyb290OiByLCBwYWdlOiBwYWdlfSwgbmlsCn0KCi8vIHt7IC5Nb2RlbC5OYW1lIH19UmVzb2x2ZXIgcmVzb2x2ZXMgdGhlIHt7IC5Nb2RlbC5OYW1lIH19IHR5cGUuCnR5cGUge3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlciBzdHJ1Y3QgewoJcm9vdCAqUmVzb2x2ZXIKCW0gICAgKnt7IC5Nb2RlbC5OYW1lIH19Cn0Ke3stIHJhbmdlIC5GaWVsZHMgfX0KCi8vIHt7IC5GaWVsZC5OYW1lIH19IHJlc29sdmVzIHRoZSB7eyAuTmFtZSB9fSBmaWVsZC4KZnVuYyAociAqe3sgJC5UeXBlLk1vZGVsLk5hbWUgfX1SZXNvbHZlcikge3sgLkZpZWxkLk5hbWUgfX0oKSB7eyAuR29UeXBlIH19IHsKCXJldHVybiB7eyAuVmFsdWUgfX0KfQp7ey0gZW5kIH19Cnt7LSByYW5nZSAuUmVmcyB9fQoKLy8ge3sgZ3JhcGhxbF9tZXRob2QgLk5hbWUgfX0gcmVzb2x2ZXMgdGhlIHt7IC5OYW1lIH19IGZpZWxkLCB0aGUge3sgLk1vZGVsLk5hbWUgfX0ge3sgLkNvbHVtbi5Db2x1bW5OYW1lIH19IHJlZmVyZW5jZXMuCmZ1bmMgKHIgKnt7ICQuVHlwZS5Nb2RlbC5OYW1lIH19UmVzb2x2ZXIpIHt7IGdyYXBocWxfbWV0aG9kIC5OYW1lIH19KGN0eCBjb250ZXh0LkNvbnRleHQpICgqe3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlciwgZXJyb3IpIHsKCXt7LSBpZiBlcSAuQ29sdW1uLlR5cGUgIk51bGxJbnQ2NCIgfX0KCWlmICFyLm0ue3sgLkNvbHVtbi5OYW1lIH19LlZhbGlkIHsKCQlyZXR1cm4gbmlsLCBuaWwKCX0KCXJldHVybiByLnJvb3QuZmluZHt7IC5Nb2RlbC5OYW1lIH19KGN0eCwgci5tLnt7IC5Db2x1bW4uTmFtZSB9fS5JbnQ2NCkKCXt7LSBlbHNlIH19CglyZXR1cm4gci5yb290LmZpbmR7eyAuTW9kZWwuTmFtZSB9fShjdHgsIHIubS57eyAuQ29sdW1uLk5hbWUgfX0pCgl7ey0gZW5kIH19Cn0Ke3stIGVuZCB9fQp7ey0gcmFuZ2UgLkxpc3RzIH19CgovLyB7eyBncmFwaHFsX21ldGhvZCAuTmFtZSB9fSByZXNvbHZlcyB0aGUge3sgLk5hbWUgfX0gZmllbGQsIHBhZ2luYXRpbmcgdGhyb3VnaCB0aGUge3sgLk1vZGVsLk5hbWUgfX0gcm93cyByZWZlcmVuY2luZyB0aGUge3sgJC5UeXBlLk1vZGVsLk5hbWUgfX0gYnkge3sgLkNvbHVtbi5Db2x1bW5OYW1lIH19LgpmdW5jIChyICp7eyAkLlR5cGUuTW9kZWwuTmFtZSB9fVJlc29sdmVyKSB7eyBncmFwaHFsX21ldGhvZCAuTmFtZSB9fShjdHggY29udGV4dC5Db250ZXh0LCBhcmdzIENvbm5lY3Rpb25BcmdzKSAoKnt7IC5Nb2RlbC5OYW1lIH19Q29ubmVjdGlvblJlc29sdmVyLCBlcnJvcikgewoJcSA6PSB7eyAuTW9kZWwuTmFtZSB9fVF1ZXJ5e30uV2hlcmUoe3sgLk1vZGVsLk5hbWUgfX1Db2x1bW5zLnt7IC5Db2x1bW4uTmFtZSB9fS5FcShyLm0uSUQpKQoJcmV0dXJuIHIucm9vdC57eyBncmFwaHFsX3NpbmdsZSAuTW9kZWwgfX1Db25uZWN0aW9uKGN0eCwgcSwgYXJncykKfQp7ey0gZW5kIH19CgovLyB7eyAuTW9kZWwuTmFtZSB9fUNvbm5lY3Rpb25SZXNvbHZlciByZXNvbHZlcyB0aGUge3sgLk1vZGVsLk5hbWUgfX1Db25uZWN0aW9uIHR5cGUuCnR5cGUge3sgLk1vZGVsLk5hbWUgfX1Db25uZWN0aW9uUmVzb2x2ZXIgc3RydWN0IHsKCXJvb3QgKlJlc29sdmVyCglwYWdlIHt7IC5Nb2RlbC5OYW1lIH19UGFnZQp9CgovLyBFZGdlcyByZXNvbHZlcyB0aGUgZWRnZXMgZmllbGQuCmZ1bmMgKHIgKnt7IC5Nb2RlbC5OYW1lIH19Q29ubmVjdGlvblJlc29sdmVyKSBFZGdlcygpIFtdKnt7IC5Nb2RlbC5OYW1lIH19RWRnZVJlc29sdmVyIHsKCWVkZ2VzIDo9IG1ha2UoW10qe3sgLk1vZGVsLk5hbWUgfX1FZGdlUmVzb2x2ZXIsIGxlbihyLnBhZ2UuUm93cykpCglmb3IgaSA6PSByYW5nZSByLnBhZ2UuUm93cyB7CgkJZWRnZXNbaV0gPSAme3sgLk1vZGVsLk5hbWUgfX1FZGdlUmVzb2x2ZXJ7bm9kZTogJnt7IC5Nb2RlbC5OYW1lIH19UmVzb2x2ZXJ7cm9vdDogci5yb290LCBtOiAmci5wYWdlLlJvd3NbaV19fQoJfQoJcmV0dXJuIGVkZ2VzCn0KCi8vIE5vZGVzIHJlc29sdmVzIHRoZSBub2RlcyBmaWVsZC4KZnVuYyAociAqe3sgLk1vZGVsLk5hbWUgfX1Db25uZWN0aW9uUmVzb2x2ZXIpIE5vZGVzKCkgW10qe3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlciB7Cglub2RlcyA6PSBtYWtlKFtdKnt7IC5Nb2RlbC5OYW1lIH19UmVzb2x2ZXIsIGxlbihyLnBhZ2UuUm93cykpCglmb3IgaSA6PSByYW5nZSByLnBhZ2UuUm93cyB7CgkJbm9kZXNbaV0gPSAme3sgLk1vZGVsLk5hbWUgfX1SZXNvbHZlcntyb290OiByLnJvb3QsIG06ICZyLnBhZ2UuUm93c1tpXX0KCX0KCXJldHVybiBub2Rlcwp9CgovLyBQYWdlSW5mbyByZXNvbHZlcyB0aGUgcGFnZUluZm8gZmllbGQuCmZ1bmMgKHIgKnt7IC5Nb2RlbC5OYW1lIH19Q29ubmVjdGlvblJlc29sdmVyKSBQYWdlSW5mbygpICpQYWdlSW5mb1Jlc29sdmVyIHsKCXJldHVybiAmUGFnZUluZm9SZXNvbHZlcntlbmQ6IHIucGFnZS5OZXh0LCBtb3JlOiByLnBhZ2UuSGFzTW9yZX0KfQoKLy8ge3sgLk1vZGVsLk5hbWUgfX1FZGdlUmVzb2x2ZXIgcmVzb2x2ZXMgdGhlIHt7IC5Nb2RlbC5OYW1lIH19RWRnZSB0eXBlLgp0eXBlIHt7IC5Nb2RlbC5OYW1lIH19RWRnZVJlc29sdmVyIHN0cnVjdCB7Cglub2RlICp7eyAuTW9kZWwuTmFtZSB9fVJlc29sdmVyCn0KCi8vIEN1cnNvciByZXNvbHZlcyB0aGUgY3Vyc29yIGZpZWxkLCB3aGljaCBsb2FkcyB0aGUgcm93cyBmb2xsb3dpbmcgdGhlIG5vZGUuCmZ1bmMgKHIgKnt7IC5Nb2RlbC5OYW1lIH19RWRnZVJlc29sdmVyKSBDdXJzb3IoKSAoc3RyaW5nLCBlcnJvcikgewoJcmV0dXJuIGlkQ3Vyc29yKHIubm9kZS5tLklEKQp9CgovLyBOb2RlIHJlc29sdmVzIHRoZSBub2RlIGZpZWxkLgpmdW5jIChyICp7eyAuTW9kZWwuTmFtZSB9fUVkZ2VSZXNvbHZlcikgTm9kZSgpICp7eyAuTW9kZWwuTmFtZSB9fVJlc29sdmVyIHsKCXJldHVybiByLm5vZGUKfQp7ey0gZW5kIH19Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "model_http.html", "\"e3tkZWZpbmUgIm1vZGVsaHR0cCJ9fQpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoiZGF0YWJhc2Uvc3FsIgoibmV0L2h0dHAiCiJzdHJjb252Igoic3RyaW5ncyIKKQoKLy8ge3suTW9kZWwuTmFtZX19SGFuZGxlciBzZXJ2ZXMgdGhlIHJvd3Mgb2YgdGhlIHt7Lk1vZGVsLlRhYmxlTmFtZX19IHRhYmxlIGFzIEpTT04sIHVuZGVyIGEgcGF0aCBwcmVmaXggc3VjaCBhcyAve3suTW9kZWwuVGFibGVOYW1lfX06Ci8vCi8vICAgR0VUICAgIC97ey5Nb2RlbC5UYWJsZU5hbWV9fT9saW1pdD0yMCZjdXJzb3I9ICAgbGlzdHMgcm93cyBvcmRlcmVkIGJ5IGlkLCBhIHBhZ2UgYXQgYSB0aW1lCi8vICAgUE9TVCAgIC97ey5Nb2RlbC5UYWJsZU5hbWV9fSAgICAgICAgICAgICAgICAgICAgY3JlYXRlcyBhIHJvdwovLyAgIEdFVCAgICAve3suTW9kZWwuVGFibGVOYW1lfX0ve2lkfSAgICAgICAgICAgICAgIGdldHMgYSByb3cKLy8gICBQVVQgICAgL3t7Lk1vZGVsLlRhYmxlTmFtZX19L3tpZH0gICAgICAgICAgICAgICByZXBsYWNlcyBldmVyeSBjb2x1bW4gb2YgYSByb3cKLy8gICBQQVRDSCAgL3t7Lk1vZGVsLlRhYmxlTmFtZX19L3tpZH0gICAgICAgICAgICAgICB1cGRhdGVzIHRoZSBjb2x1bW5zIHByZXNlbnQgaW4gdGhlIGJvZHkKLy8gICBERUxFVEUgL3t7Lk1vZGVsLlRhYmxlTmFtZX19L3tpZH0gICAgICAgICAgICAgICBkZWxldGVzIGEgcm93Cnt7LSBpZiAuVmVyc2lvbiB9fQovLwovLyBQVVQgYW5kIFBBVENIIGJvZGllcyBjYXJyeWluZyBhIHt7LlZlcnNpb259fSB3aGljaCBubyBsb25nZXIgbWF0Y2hlcyB0aGUgcm93IGFyZSByZWZ1c2VkIHdpdGggNDA5IENvbmZsaWN0Lgp7ey0gZW5kIH19CnR5cGUge3suTW9kZWwuTmFtZX19SGFuZGxlciBzdHJ1Y3QgewogICAgcXUgICAgIFF1ZXJ5ZXJDb250ZXh0CiAgICBwcmVmaXggc3RyaW5nCn0KCi8vIE5ld3t7Lk1vZGVsLk5hbWV9fUhhbmRsZXIgcmV0dXJucyBhIHt7Lk1vZGVsLk5hbWV9fUhhbmRsZXIgc2VydmluZyB0aGUgcm93cyBvZiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUgdW5kZXIgcHJlZml4LgpmdW5jIE5ld3t7Lk1vZGVsLk5hbWV9fUhhbmRsZXIocXUgUXVlcnllckNvbnRleHQsIHByZWZpeCBzdHJpbmcpICp7ey5Nb2RlbC5OYW1lfX1IYW5kbGVyIHsKICAgIHJldHVybiAme3suTW9kZWwuTmFtZX19SGFuZGxlcntxdTogcXUsIHByZWZpeDogIi8iICsgc3RyaW5ncy5UcmltKHByZWZpeCwgIi8iKX0KfQoKLy8gUmVnaXN0ZXIgbW91bnRzIHRoZSBoYW5kbGVyIG9uIG11eCwgdW5kZXIgaXRzIHByZWZpeC4KZnVuYyAoaCAqe3suTW9kZWwuTmFtZX19SGFuZGxlcikgUmVnaXN0ZXIobXV4ICpodHRwLlNlcnZlTXV4KSB7CiAgICBtdXguSGFuZGxlKGgucHJlZml4LCBoKQogICAgbXV4LkhhbmRsZShoLnByZWZpeCsiLyIsIGgpCn0KCi8vIFNlcnZlSFRUUCByb3V0ZXMgYSByZXF1ZXN0IHRvIHRoZSBoYW5kbGVyIG9mIGl0cyBtZXRob2QuCmZ1bmMgKGggKnt7Lk1vZGVsLk5hbWV9fUhhbmRsZXIpIFNlcnZlSFRUUCh3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIHIgKmh0dHAuUmVxdWVzdCkgewogICAgaWQsIGl0ZW0sIG9rIDo9IHJvdXRlSUQoaC5wcmVmaXgsIHIuVVJMLlBhdGgpCiAgICBzd2l0Y2ggewogICAgY2FzZSAhb2s6CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBzcWwuRXJyTm9Sb3dzKQogICAgY2FzZSAhaXRlbSAmJiByLk1ldGhvZCA9PSBodHRwLk1ldGhvZEdldDoKICAgICAgICBoLmxpc3QodywgcikKICAgIGNhc2UgIWl0ZW0gJiYgci5NZXRob2QgPT0gaHR0cC5NZXRob2RQb3N0OgogICAgICAgIGguY3JlYXRlKHcsIHIpCiAgICBjYXNlICFpdGVtOgogICAgICAgIG1ldGhvZE5vdEFsbG93ZWQodywgIkdFVCwgUE9TVCIpCiAgICBjYXNlIHIuTWV0aG9kID09IGh0dHAuTWV0aG9kR2V0OgogICAgICAgIGguZ2V0KHcsIHIsIGlkKQogICAgY2FzZSByLk1ldGhvZCA9PSBodHRwLk1ldGhvZFB1dDoKICAgICAgICBoLnVwZGF0ZSh3LCByLCBpZCkKICAgIGNhc2Ugci5NZXRob2QgPT0gaHR0cC5NZXRob2RQYXRjaDoKICAgICAgICBoLnBhdGNoKHcsIHIsIGlkKQogICAgY2FzZSByLk1ldGhvZCA9PSBodHRwLk1ldGhvZERlbGV0ZToKICAgICAgICBoLmRlbGV0ZSh3LCByLCBpZCkKICAgIGRlZmF1bHQ6CiAgICAgICAgbWV0aG9kTm90QWxsb3dlZCh3LCAiR0VULCBQVVQsIFBBVENILCBERUxFVEUiKQogICAgfQp9CgpmdW5jIChoICp7ey5Nb2RlbC5OYW1lfX1IYW5kbGVyKSBsaXN0KHcgaHR0cC5SZXNwb25zZVdyaXRlciwgciAqaHR0cC5SZXF1ZXN0KSB7CiAgICBjdXJzb3IsIG4sIGVyciA6PSBwYWdlQXJncyhyKQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBlcnIpCiAgICAgICAgcmV0dXJuCiAgICB9CiAgICBwYWdlLCBlcnIgOj0ge3suTW9kZWwuTmFtZX19UXVlcnl7fS5Mb2FkQWZ0ZXJDb250ZXh0KHIuQ29udGV4dCgpLCBoLnF1LCBjdXJzb3IsIG4pCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICB3cml0ZUVycm9yKHcsIHIsIGVycikKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJvd3MgOj0gcGFnZS5Sb3dzCiAgICBpZiByb3dzID09IG5pbCB7CiAgICAgICAgcm93cyA9IFtde3suTW9kZWwuTmFtZX19e30KICAgIH0KICAgIHdyaXRlSlNPTih3LCBodHRwLlN0YXR1c09LLCBodHRwUGFnZXtSb3dzOiByb3dzLCBOZXh0OiBwYWdlLk5leHQsIEhhc01vcmU6IHBhZ2UuSGFzTW9yZX0pCn0KCmZ1bmMgKGggKnt7Lk1vZGVsLk5hbWV9fUhhbmRsZXIpIGNyZWF0ZSh3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIHIgKmh0dHAuUmVxdWVzdCkgewogICAgdmFyIHJvdyB7ey5Nb2RlbC5OYW1lfX0KICAgIGlmIGVyciA6PSBkZWNvZGVKU09OKHcsIHIsICZyb3cpOyBlcnIgIT0gbmlsIHsKICAgICAgICB3cml0ZUVycm9yKHcsIHIsIGVycikKICAgICAgICByZXR1cm4KICAgIH0KICAgIGlkLCBlcnIgOj0gcm93Lkluc2VydENvbnRleHQoci5Db250ZXh0KCksIGgucXUpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICB3cml0ZUVycm9yKHcsIHIsIGVycikKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJvdy5JRCA9IGlkCiAgICB3LkhlYWRlcigpLlNldCgiTG9jYXRpb24iLCBoLnByZWZpeCsiLyIrc3RyY29udi5Gb3JtYXRJbnQoaWQsIDEwKSkKICAgIHdyaXRlSlNPTih3LCBodHRwLlN0YXR1c0NyZWF0ZWQsICZyb3cpCn0KCmZ1bmMgKGggKnt7Lk1vZGVsLk5hbWV9fUhhbmRsZXIpIGdldCh3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIHIgKmh0dHAuUmVxdWVzdCwgaWQgaW50NjQpIHsKICAgIHZhciByb3cge3suTW9kZWwuTmFtZX19CiAgICBpZiBlcnIgOj0gcm93LkZpbmRDb250ZXh0KHIuQ29udGV4dCgpLCBoLnF1LCBpZCk7IGVyciAhPSBuaWwgewogICAgICAgIHdyaXRlRXJyb3IodywgciwgZXJyKQogICAgICAgIHJldHVybgogICAgfQogICAgd3JpdGVKU09OKHcsIGh0dHAuU3RhdHVzT0ssICZyb3cpCn0KCmZ1bmMgKGggKnt7Lk1vZGVsLk5hbWV9fUhhbmRsZXIpIHVwZGF0ZSh3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIHIgKmh0dHAuUmVxdWVzdCwgaWQgaW50NjQpIHsKICAgIGV4aXN0cywgZXJyIDo9IG5ldyh7ey5Nb2RlbC5OYW1lfX0pLkV4aXN0c0NvbnRleHQoci5Db250ZXh0KCksIGgucXUsIGlkKQogICAgaWYgZXJyID09IG5pbCAmJiAhZXhpc3RzIHsKICAgICAgICBlcnIgPSBzcWwuRXJyTm9Sb3dzCiAgICB9CiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICB3cml0ZUVycm9yKHcsIHIsIGVycikKICAgICAgICByZXR1cm4KICAgIH0KICAgIHZhciByb3cge3suTW9kZWwuTmFtZX19CiAgICBpZiBlcnIgOj0gZGVjb2RlSlNPTih3LCByLCAmcm93KTsgZXJyICE9IG5pbCB7CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBlcnIpCiAgICAgICAgcmV0dXJuCiAgICB9CiAgICByb3cuSUQgPSBpZAogICAgaWYgXywgZXJyIDo9IHJvdy5VcGRhdGVDb250ZXh0KHIuQ29udGV4dCgpLCBoLnF1LCBpZCk7IGVyciAhPSBuaWwgewogICAgICAgIHdyaXRlRXJyb3IodywgciwgZXJyKQogICAgICAgIHJldHVybgogICAgfQogICAgd3JpdGVKU09OKHcsIGh0dHAuU3RhdHVzT0ssICZyb3cpCn0KCi8vIHBhdGNoIGRlY29kZXMgdGhlIGJvZHkgb3ZlciB0aGUgY3VycmVudCByb3csIHNvIG9ubHkgdGhlIGNvbHVtbnMgaXQgaG9sZHMgYXJlIGNoYW5nZWQgYW5kIHNhdmVkLgpmdW5jIChoICp7ey5Nb2RlbC5OYW1lfX1IYW5kbGVyKSBwYXRjaCh3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIHIgKmh0dHAuUmVxdWVzdCwgaWQgaW50NjQpIHsKICAgIHZhciByb3cge3suTW9kZWwuTmFtZX19CiAgICBpZiBlcnIgOj0gcm93LkZpbmRDb250ZXh0KHIuQ29udGV4dCgpLCBoLnF1LCBpZCk7IGVyciAhPSBuaWwgewogICAgICAgIHdyaXRlRXJyb3IodywgciwgZXJyKQogICAgICAgIHJldHVybgogICAgfQogICAge3stIGlmIC5WZXJzaW9uIH19CiAgICB2ZXJzaW9uIDo9IHJvdy57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0KICAgIHt7LSBlbmQgfX0KICAgIGlmIGVyciA6PSBkZWNvZGVKU09OKHcsIHIsICZyb3cpOyBlcnIgIT0gbmlsIHsKICAgICAgICB3cml0ZUVycm9yKHcsIHIsIGVycikKICAgICAgICByZXR1cm4KICAgIH0KICAgIHJvdy5JRCA9IGlkCiAgICB7ey0gaWYgLlZlcnNpb24gfX0KICAgIGlmIHJvdy57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0gIT0gdmVyc2lvbiB7CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBFcnJTdGFsZU9iamVjdCkKICAgICAgICByZXR1cm4KICAgIH0KICAgIHt7LSBlbmQgfX0KICAgIHt7LSBpZiAuVmFsaWRhdGUgfX0KICAgIGlmIGVyciA6PSByb3cuVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBlcnIpCiAgICAgICAgcmV0dXJuCiAgICB9CiAgICB7ey0gZW5kIH19CiAgICBpZiBfLCBlcnIgOj0gcm93LlNhdmVDb250ZXh0KHIuQ29udGV4dCgpLCBoLnF1KTsgZXJyICE9IG5pbCB7CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBlcnIpCiAgICAgICAgcmV0dXJuCiAgICB9CiAgICB3cml0ZUpTT04odywgaHR0cC5TdGF0dXNPSywgJnJvdykKfQoKZnVuYyAoaCAqe3suTW9kZWwuTmFtZX19SGFuZGxlcikgZGVsZXRlKHcgaHR0cC5SZXNwb25zZVdyaXRlciwgciAqaHR0cC5SZXF1ZXN0LCBpZCBpbnQ2NCkgewogICAgYWZmZWN0ZWQsIGVyciA6PSBuZXcoe3suTW9kZWwuTmFtZX19KS5EZWxldGVDb250ZXh0KHIuQ29udGV4dCgpLCBoLnF1LCBpZCkKICAgIGlmIGVyciA9PSBuaWwgJiYgYWZmZWN0ZWQgPT0gMCB7CiAgICAgICAgZXJyID0gc3FsLkVyck5vUm93cwogICAgfQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgd3JpdGVFcnJvcih3LCByLCBlcnIpCiAgICAgICAgcmV0dXJuCiAgICB9CiAgICB3LldyaXRlSGVhZGVyKGh0dHAuU3RhdHVzTm9Db250ZW50KQp9Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "model_proto.html", "\"e3tkZWZpbmUgIm1vZGVscHJvdG8ifX0KcGFja2FnZSB7eyAuUGFja2FnZU5hbWUgfX0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuIHwKfCAgICAgICAgRE8gTk9UIEVESVQuICAgICAgICB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKaW1wb3J0ICgKCXBiIHt7IGdvX3N0cmluZyAuUHJvdG8uUGFja2FnZSB9fQopCgovLyBUb1Byb3RvIGNvbnZlcnRzIHRoZSB7ey5Nb2RlbC5OYW1lfX0gdG8gaXRzIHByb3RvYnVmIG1lc3NhZ2UuCmZ1bmMgKHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgVG9Qcm90bygpICpwYi57ey5Nb2RlbC5OYW1lfX0gewoJcmV0dXJuICZwYi57ey5Nb2RlbC5OYW1lfX17Cgl7ey0gcmFuZ2UgLlByb3RvLkZpZWxkcyB9fQoJCXt7IC5Hb05hbWUgfX06IHt7IHRvX3Byb3RvIChwcmludGYgIiVzLiVzIiAkLlJlY2VpdmVyIC5GaWVsZC5OYW1lKSAuRmllbGQgfX0sCgl7ey0gZW5kIH19Cgl9Cn0KCi8vIEZyb21Qcm90byBzZXRzIHRoZSBmaWVsZHMgb2YgdGhlIHt7Lk1vZGVsLk5hbWV9fSBmcm9tIGl0cyBwcm90b2J1ZiBtZXNzYWdlLgpmdW5jICh7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIEZyb21Qcm90byhtc2cgKnBiLnt7Lk1vZGVsLk5hbWV9fSkgewoJe3stIHJhbmdlIC5Qcm90by5GaWVsZHMgfX0KCXt7JC5SZWNlaXZlcn19Lnt7IC5GaWVsZC5OYW1lIH19ID0ge3sgZnJvbV9wcm90byAocHJpbnRmICJtc2cuR2V0JXMoKSIgLkdvTmFtZSkgLkZpZWxkIH19Cgl7ey0gZW5kIH19Cn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "model_test.html", "\"e3tkZWZpbmUgIm1vZGVsdGVzdCJ9fQpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoiY29udGV4dCIKInRlc3RpbmciCikKCi8vIFRlc3R7ey5Nb2RlbC5OYW1lfX1fUm91bmRUcmlwIHdyaXRlcyBzYW1wbGUgdmFsdWVzIHRvIGV2ZXJ5IGNvbHVtbiBvZiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUKLy8gdGhyb3VnaCB0aGUgZ2VuZXJhdGVkIG1ldGhvZHMsIGFuZCBjaGVja3MgdGhleSBhcmUgcmVhZCBiYWNrIGFzIHRoZXkgd2VyZSB3cml0dGVuLgpmdW5jIFRlc3R7ey5Nb2RlbC5OYW1lfX1fUm91bmRUcmlwKHQgKnRlc3RpbmcuVCkgewogICAgdHgsIGRvbmUgOj0gdGVzdFR4KHQpCiAgICBkZWZlciBkb25lKCkKICAgIGN0eCA6PSBjb250ZXh0LkJhY2tncm91bmQoKQoKICAgIGluIDo9IHt7Lk1vZGVsLk5hbWV9fXsKICAgICAgICB7ey0gcmFuZ2UgJGssICR2IDo9IHVwZGF0ZV9maWVsZHMgLiB9fQogICAgICAgIHt7ICR2Lk5hbWUgfX06IHt7IHNhbXBsZV92YWx1ZSAkLk1vZGVsICR2IH19LAogICAgICAgIHt7LSBlbmQgfX0KICAgIH0KICAgIGlkLCBlcnIgOj0gaW4uSW5zZXJ0Q29udGV4dChjdHgsIHR4KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgdC5GYXRhbGYoImluc2VydDogJXYiLCBlcnIpCiAgICB9CiAgICB2YXIgZm91bmQge3suTW9kZWwuTmFtZX19CiAgICBpZiBlcnIgOj0gZm91bmQuRmluZENvbnRleHQoY3R4LCB0eCwgaWQpOyBlcnIgIT0gbmlsIHsKICAgICAgICB0LkZhdGFsZigiaW5zZXJ0OiBjYW5ub3QgZmluZCByb3cgJWQ6ICV2IiwgaWQsIGVycikKICAgIH0KICAgIHt7LSByYW5nZSAkaywgJHYgOj0gdXBkYXRlX2ZpZWxkcyAuIH19CiAgICBhc3NlcnRTYW1lKHQsICJpbnNlcnQiLCB7eyBnb19zdHJpbmcgJHYuQ29sdW1uTmFtZSB9fSwgaW4ue3sgJHYuTmFtZSB9fSwgZm91bmQue3sgJHYuTmFtZSB9fSkKICAgIHt7LSBlbmQgfX0KCiAgICAvLyB1cGRhdGUgbnVsbGFibGUgY29sdW1ucyB0byBOVUxMIGFuZCB0aGUgb3RoZXJzIHRvIG5ldyB2YWx1ZXMKICAgIHt7LSByYW5nZSAkaywgJHYgOj0gdXBkYXRlX2ZpZWxkcyAuIH19CiAgICBmb3VuZC57eyAkdi5OYW1lIH19ID0ge3sgaWYgJHYuTnVsbGFibGUgfX17eyBudWxsX3ZhbHVlICR2IH19e3sgZWxzZSB9fXt7IHNhbXBsZV92YWx1ZSAkLk1vZGVsICR2IH19e3sgZW5kIH19CiAgICB7ey0gZW5kIH19CiAgICBpZiBfLCBlcnIgOj0gZm91bmQuVXBkYXRlQ29udGV4dChjdHgsIHR4LCBpZCk7IGVyciAhPSBuaWwgewogICAgICAgIHQuRmF0YWxmKCJ1cGRhdGU6ICV2IiwgZXJyKQogICAgfQogICAgdmFyIHVwZGF0ZWQge3suTW9kZWwuTmFtZX19CiAgICBpZiBlcnIgOj0gdXBkYXRlZC5GaW5kQ29udGV4dChjdHgsIHR4LCBpZCk7IGVyciAhPSBuaWwgewogICAgICAgIHQuRmF0YWxmKCJ1cGRhdGU6IGNhbm5vdCBmaW5kIHJvdyAlZDogJXYiLCBpZCwgZXJyKQogICAgfQogICAge3stIHJhbmdlICRrLCAkdiA6PSB1cGRhdGVfZmllbGRzIC4gfX0KICAgIGFzc2VydFNhbWUodCwgInVwZGF0ZSIsIHt7IGdvX3N0cmluZyAkdi5Db2x1bW5OYW1lIH19LCBmb3VuZC57eyAkdi5OYW1lIH19LCB1cGRhdGVkLnt7ICR2Lk5hbWUgfX0pCiAgICB7ey0gZW5kIH19CgogICAge3stIHJhbmdlICRrLCAkdiA6PSB1cGRhdGVfZmllbGRzIC4gfX0KICAgIHVwZGF0ZWQue3sgJHYuTmFtZSB9fSA9IHt7IHNhbXBsZV92YWx1ZSAkLk1vZGVsICR2IH19CiAgICB7ey0gZW5kIH19CiAgICB1cHNlcnRJRCwgZXJyIDo9IHVwZGF0ZWQuVXBzZXJ0Q29udGV4dChjdHgsIHR4KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgdC5GYXRhbGYoInVwc2VydDogJXYiLCBlcnIpCiAgICB9CiAgICBpZiB1cHNlcnRJRCAhPSBpZCB7CiAgICAgICAgdC5FcnJvcmYoInVwc2VydDogZXhwZWN0ZWQgdGhlIGlkIG9mIHJvdyAlZCwgZ290ICVkIiwgaWQsIHVwc2VydElEKQogICAgfQogICAgdmFyIHVwc2VydGVkIHt7Lk1vZGVsLk5hbWV9fQogICAgaWYgZXJyIDo9IHVwc2VydGVkLkZpbmRDb250ZXh0KGN0eCwgdHgsIGlkKTsgZXJyICE9IG5pbCB7CiAgICAgICAgdC5GYXRhbGYoInVwc2VydDogY2Fubm90IGZpbmQgcm93ICVkOiAldiIsIGlkLCBlcnIpCiAgICB9CiAgICB7ey0gcmFuZ2UgJGssICR2IDo9IHVwZGF0ZV9maWVsZHMgLiB9fQogICAgYXNzZXJ0U2FtZSh0LCAidXBzZXJ0Iiwge3sgZ29fc3RyaW5nICR2LkNvbHVtbk5hbWUgfX0sIHVwZGF0ZWQue3sgJHYuTmFtZSB9fSwgdXBzZXJ0ZWQue3sgJHYuTmFtZSB9fSkKICAgIHt7LSBlbmQgfX0KCiAgICBpZiBuLCBlcnIgOj0gdXBzZXJ0ZWQuRGVsZXRlQ29udGV4dChjdHgsIHR4LCBpZCk7IGVyciAhPSBuaWwgfHwgbiAhPSAxIHsKICAgICAgICB0LkZhdGFsZigiZGVsZXRlOiBleHBlY3RlZCAxIHJvdyBhZmZlY3RlZCwgZ290ICVkOiAldiIsIG4sIGVycikKICAgIH0KICAgIGV4aXN0cywgZXJyIDo9IHVwc2VydGVkLkV4aXN0c0NvbnRleHQoY3R4LCB0eCwgaWQpCiAgICBpZiBlcnIgIT0gbmlsIHsKICAgICAgICB0LkZhdGFsZigiZXhpc3RzOiAldiIsIGVycikKICAgIH0KICAgIGlmIGV4aXN0cyB7CiAgICAgICAgdC5FcnJvcmYoImV4aXN0czogcm93ICVkIHN0aWxsIGV4aXN0cyBvbmNlIGRlbGV0ZWQiLCBpZCkKICAgIH0KfQp7ey0gd2l0aCB1cGRhdGVfZmllbGRzIC4gfX0KCi8vIFRlc3R7eyQuTW9kZWwuTmFtZX19X1VwZGF0ZUNvbHVtbnNTbmFwc2hvdCBjaGVja3MgVXBkYXRlQ29sdW1ucyBsZWF2ZXMgbm8gZGlydHkgY29sdW1ucyBiZWhpbmQuCmZ1bmMgVGVzdHt7JC5Nb2RlbC5OYW1lfX1fVXBkYXRlQ29sdW1uc1NuYXBzaG90KHQgKnRlc3RpbmcuVCkgewogICAgcm93IDo9IHt7JC5Nb2RlbC5OYW1lfX17CiAgICAgICAge3stIHJhbmdlICRrLCAkdiA6PSAuIH19CiAgICAgICAge3sgJHYuTmFtZSB9fToge3sgc2FtcGxlX3ZhbHVlICQuTW9kZWwgJHYgfX0sCiAgICAgICAge3stIGVuZCB9fQogICAgfQogICAgaWYgXywgZXJyIDo9IHJvdy5VcGRhdGVDb2x1bW5zQ29udGV4dChjb250ZXh0LkJhY2tncm91bmQoKSwgYWZmZWN0aW5nUXVlcnllcigxKSwgMSwge3skLk1vZGVsLk5hbWV9fUNvbHVtbnMue3sgKGluZGV4IC4gMCkuTmFtZSB9fSk7IGVyciAhPSBuaWwgewogICAgICAgIHQuRmF0YWxmKCJ1cGRhdGUgY29sdW1uczogJXYiLCBlcnIpCiAgICB9CiAgICBpZiBjb2xzIDo9IHJvdy5EaXJ0eUNvbHVtbnMoKTsgbGVuKGNvbHMpICE9IDAgewogICAgICAgIHQuRXJyb3JmKCJ1cGRhdGUgY29sdW1uczogZXhwZWN0ZWQgbm8gZGlydHkgY29sdW1ucywgZ290ICV2IiwgY29scykKICAgIH0KfQp7ey0gZW5kIH19Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "proto.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJInBhdGgiCgkic3RyaW5ncyIKKQoKLy8gUHJvdG9GaWVsZE5hbWUgcmV0dXJucyB0aGUgbmFtZSBvZiB0aGUgcHJvdG9idWYgZmllbGQgb2YgYSBjb2x1bW4sCi8vIHJlcGxhY2luZyB0aGUgY2hhcmFjdGVycyBhIHByb3RvIGlkZW50aWZpZXIgY2Fubm90IGhvbGQgd2l0aCB1bmRlcnNjb3Jlcy4KZnVuYyBQcm90b0ZpZWxkTmFtZShjb2x1bW4gc3RyaW5nKSBzdHJpbmcgewoJbmFtZSA6PSBbXWJ5dGUoc3RyaW5ncy5Ub0xvd2VyKGNvbHVtbikpCglmb3IgaSwgYyA6PSByYW5nZSBuYW1lIHsKCQlpZiAhaXNMb3dlcihjKSAmJiAhaXNEaWdpdChjKSAmJiBjICE9ICdfJyB7CgkJCW5hbWVbaV0gPSAnXycKCQl9Cgl9CglpZiBsZW4obmFtZSkgPT0gMCB8fCBpc0RpZ2l0KG5hbWVbMF0pIHsKCQluYW1lID0gYXBwZW5kKFtdYnl0ZXsnXyd9LCBuYW1lLi4uKQoJfQoJcmV0dXJuIHN0cmluZyhuYW1lKQp9CgovLyBQcm90b0dvTmFtZSByZXR1cm5zIHRoZSBuYW1lIHByb3RvYy1nZW4tZ28gZ2l2ZXMgdGhlIEdvIGZpZWxkIG9mIGEgcHJvdG9idWYgZmllbGQ6Ci8vIHVuZGVyc2NvcmVzIGZvbGxvd2VkIGJ5IGEgbG93ZXIgY2FzZSBsZXR0ZXIgYXJlIGRyb3BwZWQgYW5kIHRoZSBsZXR0ZXIgdXBwZXIgY2FzZWQuCmZ1bmMgUHJvdG9Hb05hbWUobmFtZSBzdHJpbmcpIHN0cmluZyB7Cgl2YXIgYiBbXWJ5dGUKCWkgOj0gMAoJaWYgc3RyaW5ncy5IYXNQcmVmaXgobmFtZSwgIl8iKSB7CgkJYiA9IGFwcGVuZChiLCAnWCcpCgkJaSsrCgl9Cglmb3IgOyBpIDwgbGVuKG5hbWUpOyBpKysgewoJCWMgOj0gbmFtZVtpXQoJCWlmIGMgPT0gJ18nICYmIGkrMSA8IGxlbihuYW1lKSAmJiBpc0xvd2VyKG5hbWVbaSsxXSkgewoJCQljb250aW51ZQoJCX0KCQlpZiBpc0RpZ2l0KGMpIHsKCQkJYiA9IGFwcGVuZChiLCBjKQoJCQljb250aW51ZQoJCX0KCQlpZiBpc0xvd2VyKGMpIHsKCQkJYyBePSAnICcKCQl9CgkJYiA9IGFwcGVuZChiLCBjKQoJCWZvciBpKzEgPCBsZW4obmFtZSkgJiYgaXNMb3dlcihuYW1lW2krMV0pIHsKCQkJaSsrCgkJCWIgPSBhcHBlbmQoYiwgbmFtZVtpXSkKCQl9Cgl9CglyZXR1cm4gc3RyaW5nKGIpCn0KCmZ1bmMgaXNMb3dlcihjIGJ5dGUpIGJvb2wgeyByZXR1cm4gJ2EnIDw9IGMgJiYgYyA8PSAneicgfQpmdW5jIGlzRGlnaXQoYyBieXRlKSBib29sIHsgcmV0dXJuICcwJyA8PSBjICYmIGMgPD0gJzknIH0KCi8vIEdldFByb3RvUGFja2FnZSByZXR1cm5zIHRoZSBwcm90byBwYWNrYWdlIG9mIHRoZSBtZXNzYWdlcyBnZW5lcmF0ZWQgaW50byBhIEdvIHBhY2thZ2UsCi8vIG5hbWVkIGFmdGVyIHRoZSBsYXN0IGVsZW1lbnQgb2YgaXRzIGltcG9ydCBwYXRoLgpmdW5jIEdldFByb3RvUGFja2FnZShpbXBvcnRQYXRoIHN0cmluZykgc3RyaW5nIHsKCXJldHVybiBQcm90b0ZpZWxkTmFtZShwYXRoLkJhc2UoaW1wb3J0UGF0aCkpCn0KCi8vIHByb3RvTnVsbGFibGUgcmVwb3J0cyB3aGV0aGVyIGEgZmllbGQgaG9sZHMgTlVMTCB2YWx1ZXMsIHdoaWNoIGFyZQovLyBlbmNvZGVkIGFzIHdlbGwga25vd24gd3JhcHBlciBtZXNzYWdlcyByYXRoZXIgdGhhbiBzY2FsYXJzLgpmdW5jIHByb3RvTnVsbGFibGUoZmwgVG1wbEZpZWxkKSBib29sIHsKCXN3aXRjaCBmbC5UeXBlIHsKCWNhc2UgIltdYnl0ZSIsICJSYXdKU09OIjoKCQlyZXR1cm4gZmwuTnVsbGFibGUKCWRlZmF1bHQ6CgkJcmV0dXJuIHN0cmluZ3MuSGFzUHJlZml4KGZsLlR5cGUsICJOdWxsIikKCX0KfQoKLy8gR2V0UHJvdG9UeXBlIHJldHVybnMgdGhlIHByb3RvYnVmIHR5cGUgb2YgYSBmaWVsZC4KZnVuYyBHZXRQcm90b1R5cGUoZmwgVG1wbEZpZWxkKSBzdHJpbmcgewoJc3dpdGNoIGZsLlR5cGUgewoJY2FzZSAiaW50NjQiOgoJCXJldHVybiAiaW50NjQiCgljYXNlICJOdWxsSW50NjQiOgoJCXJldHVybiAiZ29vZ2xlLnByb3RvYnVmLkludDY0VmFsdWUiCgljYXNlICJmbG9hdDY0IjoKCQlyZXR1cm4gImRvdWJsZSIKCWNhc2UgIk51bGxGbG9hdDY0IjoKCQlyZXR1cm4gImdvb2dsZS5wcm90b2J1Zi5Eb3VibGVWYWx1ZSIKCWNhc2UgImJvb2wiOgoJCXJldHVybiAiYm9vbCIKCWNhc2UgIk51bGxCb29sIjoKCQlyZXR1cm4gImdvb2dsZS5wcm90b2J1Zi5Cb29sVmFsdWUiCgljYXNlICJ0aW1lLlRpbWUiLCAiTnVsbFRpbWUiOgoJCXJldHVybiAiZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIKCWNhc2UgIltdYnl0ZSI6CgkJaWYgcHJvdG9OdWxsYWJsZShmbCkgewoJCQlyZXR1cm4gImdvb2dsZS5wcm90b2J1Zi5CeXRlc1ZhbHVlIgoJCX0KCQlyZXR1cm4gImJ5dGVzIgoJZGVmYXVsdDoKCQkvLyBzdHJpbmdzLCBpbmNsdWRpbmcgUmF3SlNPTiBkb2N1bWVudHMKCQlpZiBwcm90b051bGxhYmxlKGZsKSB7CgkJCXJldHVybiAiZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlIgoJCX0KCQlyZXR1cm4gInN0cmluZyIKCX0KfQoKLy8gR2V0UHJvdG9JbXBvcnRzIHJldHVybnMgdGhlIHdlbGwga25vd24gdHlwZXMgdGhlIHByb3RvYnVmIG1lc3NhZ2Ugb2YgYSBtb2RlbCBpbXBvcnRzLgpmdW5jIEdldFByb3RvSW1wb3J0cyhwIFRtcGxQcm90bykgW11zdHJpbmcgewoJdmFyIHRpbWVzdGFtcCwgd3JhcHBlcnMgYm9vbAoJZm9yIF8sIGYgOj0gcmFuZ2UgcC5GaWVsZHMgewoJCXN3aXRjaCB0eXAgOj0gR2V0UHJvdG9UeXBlKGYuRmllbGQpOyB7CgkJY2FzZSB0eXAgPT0gImdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiOgoJCQl0aW1lc3RhbXAgPSB0cnVlCgkJY2FzZSBzdHJpbmdzLkhhc1ByZWZpeCh0eXAsICJnb29nbGUucHJvdG9idWYuIik6CgkJCXdyYXBwZXJzID0gdHJ1ZQoJCX0KCX0KCXZhciBpbXBvcnRzIFtdc3RyaW5nCglpZiB0aW1lc3RhbXAgewoJCWltcG9ydHMgPSBhcHBlbmQoaW1wb3J0cywgImdvb2dsZS9wcm90b2J1Zi90aW1lc3RhbXAucHJvdG8iKQoJfQoJaWYgd3JhcHBlcnMgewoJCWltcG9ydHMgPSBhcHBlbmQoaW1wb3J0cywgImdvb2dsZS9wcm90b2J1Zi93cmFwcGVycy5wcm90byIpCgl9CglyZXR1cm4gaW1wb3J0cwp9CgovLyBHZXRUb1Byb3RvIHJldHVybnMgdGhlIGV4cHJlc3Npb24gY29udmVydGluZyB0aGUgdmFsdWUgb2YgYSBmaWVsZCB0byBpdHMgcHJvdG9idWYgdHlwZSwKLy8gbWFkZSBvZiB0aGUgY29udmVydGVycyBvZiB0aGUgZ2VuZXJhdGVkIHhfcHJvdG8uZ28gZmlsZS4KZnVuYyBHZXRUb1Byb3RvKHZhbHVlIHN0cmluZywgZmwgVG1wbEZpZWxkKSBzdHJpbmcgewoJc3dpdGNoIGZsLlR5cGUgewoJY2FzZSAiTnVsbEludDY0IjoKCQlyZXR1cm4gInRvSW50NjRWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCWNhc2UgIk51bGxGbG9hdDY0IjoKCQlyZXR1cm4gInRvRG91YmxlVmFsdWUoIiArIHZhbHVlICsgIikiCgljYXNlICJOdWxsQm9vbCI6CgkJcmV0dXJuICJ0b0Jvb2xWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCWNhc2UgIk51bGxTdHJpbmciOgoJCXJldHVybiAidG9TdHJpbmdWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCWNhc2UgInRpbWUuVGltZSI6CgkJcmV0dXJuICJ0b1RpbWVzdGFtcCgiICsgdmFsdWUgKyAiKSIKCWNhc2UgIk51bGxUaW1lIjoKCQlyZXR1cm4gInRvTnVsbFRpbWVzdGFtcCgiICsgdmFsdWUgKyAiKSIKCWNhc2UgIltdYnl0ZSI6CgkJaWYgcHJvdG9OdWxsYWJsZShmbCkgewoJCQlyZXR1cm4gInRvQnl0ZXNWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCQl9CgljYXNlICJSYXdKU09OIjoKCQlpZiBwcm90b051bGxhYmxlKGZsKSB7CgkJCXJldHVybiAidG9KU09OVmFsdWUoIiArIHZhbHVlICsgIikiCgkJfQoJCXJldHVybiAic3RyaW5nKCIgKyB2YWx1ZSArICIpIgoJfQoJcmV0dXJuIHZhbHVlCn0KCi8vIEdldEZyb21Qcm90byByZXR1cm5zIHRoZSBleHByZXNzaW9uIGNvbnZlcnRpbmcgYSBwcm90b2J1ZiB2YWx1ZSB0byB0aGUgdHlwZSBvZiBhIGZpZWxkLgpmdW5jIEdldEZyb21Qcm90byh2YWx1ZSBzdHJpbmcsIGZsIFRtcGxGaWVsZCkgc3RyaW5nIHsKCXN3aXRjaCBmbC5UeXBlIHsKCWNhc2UgIk51bGxJbnQ2NCI6CgkJcmV0dXJuICJmcm9tSW50NjRWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCWNhc2UgIk51bGxGbG9hdDY0IjoKCQlyZXR1cm4gImZyb21Eb3VibGVWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCWNhc2UgIk51bGxCb29sIjoKCQlyZXR1cm4gImZyb21Cb29sVmFsdWUoIiArIHZhbHVlICsgIikiCgljYXNlICJOdWxsU3RyaW5nIjoKCQlyZXR1cm4gImZyb21TdHJpbmdWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCWNhc2UgInRpbWUuVGltZSI6CgkJcmV0dXJuICJmcm9tVGltZXN0YW1wKCIgKyB2YWx1ZSArICIpIgoJY2FzZSAiTnVsbFRpbWUiOgoJCXJldHVybiAiZnJvbU51bGxUaW1lc3RhbXAoIiArIHZhbHVlICsgIikiCgljYXNlICJbXWJ5dGUiOgoJCWlmIHByb3RvTnVsbGFibGUoZmwpIHsKCQkJcmV0dXJuICJmcm9tQnl0ZXNWYWx1ZSgiICsgdmFsdWUgKyAiKSIKCQl9CgljYXNlICJSYXdKU09OIjoKCQlpZiBwcm90b051bGxhYmxlKGZsKSB7CgkJCXJldHVybiAiZnJvbUpTT05WYWx1ZSgiICsgdmFsdWUgKyAiKSIKCQl9CgkJcmV0dXJuICJSYXdKU09OKCIgKyB2YWx1ZSArICIpIgoJfQoJcmV0dXJuIHZhbHVlCn0K\"")
+	packr.PackJSONBytes("./tmpl", "proto.html", "\"e3tkZWZpbmUgInByb3RvIn19Ly8gQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4uIERPIE5PVCBFRElULgovLyBGaWVsZCBudW1iZXJzIGFyZSBrZXB0IGluIHByb3RvLmxvY2ssIHNvIHRoYXQgY29sdW1ucyBrZWVwIHRoZWlycyB3aGVuIHRoZSBzY2hlbWEgY2hhbmdlcy4KCnN5bnRheCA9ICJwcm90bzMiOwoKcGFja2FnZSB7eyBwcm90b19wYWNrYWdlIC5Qcm90by5QYWNrYWdlIH19OwoKb3B0aW9uIGdvX3BhY2thZ2UgPSB7eyBnb19zdHJpbmcgLlByb3RvLlBhY2thZ2UgfX07Cnt7IHdpdGggcHJvdG9faW1wb3J0cyAuUHJvdG8gfX0Ke3stIHJhbmdlIC4gfX0KaW1wb3J0IHt7IGdvX3N0cmluZyAuIH19Owp7ey0gZW5kIH19Cnt7IGVuZCB9fQovLyB7ey5Nb2RlbC5OYW1lfX0gaXMgYSByb3cgb2YgdGhlIHt7IGdvX2NvbW1lbnQgLk1vZGVsLlRhYmxlTmFtZSB9fSB0YWJsZS4KbWVzc2FnZSB7ey5Nb2RlbC5OYW1lfX0gewogIHt7LSB3aXRoIC5Qcm90by5SZXNlcnZlZCB9fQogIHJlc2VydmVkIHt7IHJhbmdlICRpLCAkbiA6PSAuIH19e3sgaWYgJGkgfX0sIHt7IGVuZCB9fXt7ICRuIH19e3sgZW5kIH19OwogIHt7LSBlbmQgfX0KICB7ey0gd2l0aCAuUHJvdG8uUmVzZXJ2ZWROYW1lcyB9fQogIHJlc2VydmVkIHt7IHJhbmdlICRpLCAkbiA6PSAuIH19e3sgaWYgJGkgfX0sIHt7IGVuZCB9fXt7IGdvX3N0cmluZyAkbiB9fXt7IGVuZCB9fTsKICB7ey0gZW5kIH19CiAge3stIHJhbmdlIC5Qcm90by5GaWVsZHMgfX0KICB7eyBwcm90b190eXBlIC5GaWVsZCB9fSB7eyAuTmFtZSB9fSA9IHt7IC5OdW1iZXIgfX07e3sgd2l0aCAuRmllbGQuQ29tbWVudCB9fSAvLyB7eyBnb19jb21tZW50IC4gfX17eyBlbmQgfX0KICB7ey0gZW5kIH19Cn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "proto_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgInRlc3RpbmciCgpmdW5jIFRlc3RQcm90b0dvTmFtZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQljb2x1bW4sIG5hbWUsIGdvTmFtZSBzdHJpbmcKCX17CgkJeyJpZCIsICJpZCIsICJJZCJ9LAoJCXsiY3JlYXRlZF9hdCIsICJjcmVhdGVkX2F0IiwgIkNyZWF0ZWRBdCJ9LAoJCXsiYWRkcmVzc19saW5lXzIiLCAiYWRkcmVzc19saW5lXzIiLCAiQWRkcmVzc0xpbmVfMiJ9LAoJCXsiT3JkZXItVG90YWwiLCAib3JkZXJfdG90YWwiLCAiT3JkZXJUb3RhbCJ9LAoJCXsiMmZhIiwgIl8yZmEiLCAiWDJGYSJ9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQluYW1lIDo9IFByb3RvRmllbGROYW1lKHR0LmNvbHVtbikKCQlpZiBuYW1lICE9IHR0Lm5hbWUgewoJCQl0LkVycm9yZigiUHJvdG9GaWVsZE5hbWUoJXEpID0gJXEsIHdhbnQgJXEiLCB0dC5jb2x1bW4sIG5hbWUsIHR0Lm5hbWUpCgkJfQoJCWlmIGdvTmFtZSA6PSBQcm90b0dvTmFtZShuYW1lKTsgZ29OYW1lICE9IHR0LmdvTmFtZSB7CgkJCXQuRXJyb3JmKCJQcm90b0dvTmFtZSglcSkgPSAlcSwgd2FudCAlcSIsIG5hbWUsIGdvTmFtZSwgdHQuZ29OYW1lKQoJCX0KCX0KfQo=\"")
+	packr.PackJSONBytes("./tmpl", "repository.html", "\"e3tkZWZpbmUgInJlcG9zaXRvcnkifX0KcGFja2FnZSB7eyAuUGFja2FnZU5hbWUgfX0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuIHwKfCAgICAgICAgRE8gTk9UIEVESVQuICAgICAgICB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKaW1wb3J0ICgKImNvbnRleHQiCiJkYXRhYmFzZS9zcWwiCiJzb3J0Igoic3luYyIKKQoKLy8ge3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSBzdG9yZXMge3suTW9kZWwuTmFtZX19IHJvd3MuCi8vIE5ld3t7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkgcmV0dXJucyBvbmUgYmFja2VkIGJ5IHRoZSB7ey5Nb2RlbC5UYWJsZU5hbWV9fSB0YWJsZSwKLy8gd2hpbGUgTmV3RmFrZXt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkgcmV0dXJucyBhbiBpbi1tZW1vcnkgb25lIHRvIHRlc3Qgd2l0aC4KLy8gTWlzc2luZyByb3dzIGFyZSByZXBvcnRlZCBhcyBzcWwuRXJyTm9Sb3dzLCBhbmQgcm93cyBkdXBsaWNhdGluZyBhIHVuaXF1ZSBrZXkgYXMgRXJyRHVwbGljYXRlS2V5Lgp0eXBlIHt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkgaW50ZXJmYWNlIHsKICAgIC8vIEZpbmQgcmV0dXJucyB0aGUgcm93IHdpdGggdGhlIGdpdmVuIGlkLgogICAgRmluZChjdHggY29udGV4dC5Db250ZXh0LCBpZCBpbnQ2NCkgKCp7ey5Nb2RlbC5OYW1lfX0sIGVycm9yKQogICAgLy8gTG9hZCByZXR1cm5zIGV2ZXJ5IHJvdywgb3JkZXJlZCBieSBpZC4KICAgIExvYWQoY3R4IGNvbnRleHQuQ29udGV4dCkgKFtde3suTW9kZWwuTmFtZX19LCBlcnJvcikKICAgIC8vIENvdW50IHJldHVybnMgdGhlIG51bWJlciBvZiByb3dzLgogICAgQ291bnQoY3R4IGNvbnRleHQuQ29udGV4dCkgKGludDY0LCBlcnJvcikKICAgIC8vIEV4aXN0cyByZXBvcnRzIHdoZXRoZXIgYSByb3cgd2l0aCB0aGUgZ2l2ZW4gaWQgZXhpc3RzLgogICAgRXhpc3RzKGN0eCBjb250ZXh0LkNvbnRleHQsIGlkIGludDY0KSAoYm9vbCwgZXJyb3IpCiAgICAvLyBJbnNlcnQgc3RvcmVzIGEgbmV3IHJvdywgc2V0dGluZyBpdHMgYXV0byBpbmNyZW1lbnRlZCBpZCBvbiB0aGUgbW9kZWwuCiAgICBJbnNlcnQoY3R4IGNvbnRleHQuQ29udGV4dCwge3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSAoaW50NjQsIGVycm9yKQogICAgLy8gVXBkYXRlIG92ZXJ3cml0ZXMgdGhlIHJvdyB3aXRoIHRoZSBpZCBvZiB0aGUgbW9kZWwuCiAgICB7ey0gaWYgLlZlcnNpb24gfX0KICAgIC8vIEl0IHJldHVybnMgRXJyU3RhbGVPYmplY3QgaWYgdGhlIHt7LlZlcnNpb259fSBvZiB0aGUgcm93IG5vIGxvbmdlciBtYXRjaGVzIHRoZSBtb2RlbC4KICAgIHt7LSBlbmQgfX0KICAgIFVwZGF0ZShjdHggY29udGV4dC5Db250ZXh0LCB7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIGVycm9yCiAgICAvLyBEZWxldGUgcmVtb3ZlcyB0aGUgcm93IHdpdGggdGhlIGdpdmVuIGlkLgogICAgRGVsZXRlKGN0eCBjb250ZXh0LkNvbnRleHQsIGlkIGludDY0KSBlcnJvcgp9CgovLyBOZXd7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5IHJldHVybnMgYSB7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5IGJhY2tlZCBieSB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGUuCmZ1bmMgTmV3e3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeShxdSBRdWVyeWVyQ29udGV4dCkge3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSB7CiAgICByZXR1cm4gJnNxbHt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnl7cXU6IHF1fQp9Cgp0eXBlIHNxbHt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkgc3RydWN0IHsKICAgIHF1IFF1ZXJ5ZXJDb250ZXh0Cn0KCmZ1bmMgKHJlcG8gKnNxbHt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkpIEZpbmQoY3R4IGNvbnRleHQuQ29udGV4dCwgaWQgaW50NjQpICgqe3suTW9kZWwuTmFtZX19LCBlcnJvcikgewogICAgdmFyIHt7LlJlY2VpdmVyfX0ge3suTW9kZWwuTmFtZX19CiAgICBpZiBlcnIgOj0ge3suUmVjZWl2ZXJ9fS5GaW5kQ29udGV4dChjdHgsIHJlcG8ucXUsIGlkKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIG5pbCwgZXJyCiAgICB9CiAgICByZXR1cm4gJnt7LlJlY2VpdmVyfX0sIG5pbAp9CgpmdW5jIChyZXBvICpzcWx7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5KSBMb2FkKGN0eCBjb250ZXh0LkNvbnRleHQpIChbXXt7Lk1vZGVsLk5hbWV9fSwgZXJyb3IpIHsKICAgIHJldHVybiB7ey5Nb2RlbC5OYW1lfX1RdWVyeXt9Lk9yZGVyQnkoe3suTW9kZWwuTmFtZX19Q29sdW1ucy5JRC5Bc2MoKSkuTG9hZENvbnRleHQoY3R4LCByZXBvLnF1KQp9CgpmdW5jIChyZXBvICpzcWx7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5KSBDb3VudChjdHggY29udGV4dC5Db250ZXh0KSAoaW50NjQsIGVycm9yKSB7CiAgICByZXR1cm4ge3suTW9kZWwuTmFtZX19UXVlcnl7fS5Db3VudENvbnRleHQoY3R4LCByZXBvLnF1KQp9CgpmdW5jIChyZXBvICpzcWx7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5KSBFeGlzdHMoY3R4IGNvbnRleHQuQ29udGV4dCwgaWQgaW50NjQpIChib29sLCBlcnJvcikgewogICAgcmV0dXJuIG5ldyh7ey5Nb2RlbC5OYW1lfX0pLkV4aXN0c0NvbnRleHQoY3R4LCByZXBvLnF1LCBpZCkKfQoKZnVuYyAocmVwbyAqc3Fse3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSkgSW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgKGludDY0LCBlcnJvcikgewogICAgaWQsIGVyciA6PSB7ey5SZWNlaXZlcn19Lkluc2VydENvbnRleHQoY3R4LCByZXBvLnF1KQogICAgaWYgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGR1cGxpY2F0ZUtleShlcnIpCiAgICB9CiAgICB7ey5SZWNlaXZlcn19LklEID0gaWQKICAgIHJldHVybiBpZCwgbmlsCn0KCmZ1bmMgKHJlcG8gKnNxbHt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkpIFVwZGF0ZShjdHggY29udGV4dC5Db250ZXh0LCB7ey5SZWNlaXZlcn19ICp7ey5Nb2RlbC5OYW1lfX0pIGVycm9yIHsKICAgIGFmZmVjdGVkLCBlcnIgOj0ge3suUmVjZWl2ZXJ9fS5VcGRhdGVDb250ZXh0KGN0eCwgcmVwby5xdSwge3suUmVjZWl2ZXJ9fS5JRCkKICAgIGlmIGVyciA9PSBuaWwgJiYgYWZmZWN0ZWQgPiAwIHsKICAgICAgICByZXR1cm4gbmlsCiAgICB9CiAgICBpZiBlcnIgIT0gbmlsICYmIGVyciAhPSBFcnJTdGFsZU9iamVjdCB7CiAgICAgICAgcmV0dXJuIGR1cGxpY2F0ZUtleShlcnIpCiAgICB9CiAgICAvLyBNeVNRTCByZXBvcnRzIG5vIHJvd3MgYWZmZWN0ZWQgZm9yIGEgbWlzc2luZyByb3csCiAgICAvLyBidXQgYWxzbyBmb3Igb25lIHRoZSB1cGRhdGUgbGVmdCB1bmNoYW5nZWQgb3IgYSBzdGFsZSBvbmUuCiAgICBleGlzdHMsIHhlcnIgOj0ge3suUmVjZWl2ZXJ9fS5FeGlzdHNDb250ZXh0KGN0eCwgcmVwby5xdSwge3suUmVjZWl2ZXJ9fS5JRCkKICAgIGlmIHhlcnIgIT0gbmlsIHsKICAgICAgICByZXR1cm4geGVycgogICAgfQogICAgaWYgIWV4aXN0cyB7CiAgICAgICAgcmV0dXJuIHNxbC5FcnJOb1Jvd3MKICAgIH0KICAgIHJldHVybiBlcnIKfQoKZnVuYyAocmVwbyAqc3Fse3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSkgRGVsZXRlKGN0eCBjb250ZXh0LkNvbnRleHQsIGlkIGludDY0KSBlcnJvciB7CiAgICBhZmZlY3RlZCwgZXJyIDo9IG5ldyh7ey5Nb2RlbC5OYW1lfX0pLkRlbGV0ZUNvbnRleHQoY3R4LCByZXBvLnF1LCBpZCkKICAgIGlmIGVyciAhPSBuaWwgewogICAgICAgIHJldHVybiBlcnIKICAgIH0KICAgIGlmIGFmZmVjdGVkID09IDAgewogICAgICAgIHJldHVybiBzcWwuRXJyTm9Sb3dzCiAgICB9CiAgICByZXR1cm4gbmlsCn0KCi8vIEZha2V7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5IGlzIGFuIGluLW1lbW9yeSB7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5IHRvIHRlc3Qgd2l0aCwgc2FmZSBmb3IgY29uY3VycmVudCB1c2UuCi8vIEl0IGVuZm9yY2VzIHRoZSBwcmltYXJ5IGFuZCB1bmlxdWUga2V5cyBvZiB0aGUge3suTW9kZWwuVGFibGVOYW1lfX0gdGFibGV7eyBpZiAuU29mdERlbGV0ZSB9fSwKLy8gc29mdCBkZWxldGVzIHJvd3N7eyBlbmQgfX17eyBpZiAuVmVyc2lvbiB9fSwgY2hlY2tzIHRoZWlyIHt7LlZlcnNpb259fXt7IGVuZCB9fSBhbmQgYXV0byBpbmNyZW1lbnRzIGlkcyBsaWtlIE15U1FMIGRvZXMsCi8vIHRob3VnaCBpdCBkb2VzIG5vdCBjYWxsIGhvb2tzLgp0eXBlIEZha2V7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5IHN0cnVjdCB7CiAgICBtdSAgICAgc3luYy5NdXRleAogICAgcm93cyAgIG1hcFtpbnQ2NF17ey5Nb2RlbC5OYW1lfX0KICAgIGxhc3RJRCBpbnQ2NAp9CgovLyBOZXdGYWtle3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSByZXR1cm5zIGFuIGVtcHR5IGluLW1lbW9yeSB7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5LgpmdW5jIE5ld0Zha2V7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5KCkgKkZha2V7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5IHsKICAgIHJldHVybiAmRmFrZXt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnl7cm93czogbWFrZShtYXBbaW50NjRde3suTW9kZWwuTmFtZX19KX0KfQoKLy8gRmluZCByZXR1cm5zIHRoZSByb3cgd2l0aCB0aGUgZ2l2ZW4gaWQuCmZ1bmMgKHJlcG8gKkZha2V7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5KSBGaW5kKGN0eCBjb250ZXh0LkNvbnRleHQsIGlkIGludDY0KSAoKnt7Lk1vZGVsLk5hbWV9fSwgZXJyb3IpIHsKICAgIHJlcG8ubXUuTG9jaygpCiAgICBkZWZlciByZXBvLm11LlVubG9jaygpCiAgICB7ey5SZWNlaXZlcn19LCBvayA6PSByZXBvLnJvd3NbaWRdCiAgICBpZiAhb2sge3stIGlmIC5Tb2Z0RGVsZXRlIH19IHx8IHt7LlJlY2VpdmVyfX0ue3sgZmllbGRfbmFtZSAuTW9kZWwuRmllbGRzIC5Tb2Z0RGVsZXRlIH19LlZhbGlke3sgZW5kIH19IHsKICAgICAgICByZXR1cm4gbmlsLCBzcWwuRXJyTm9Sb3dzCiAgICB9CiAgICBmb3VuZCA6PSBjbG9uZXt7Lk1vZGVsLk5hbWV9fSgme3suUmVjZWl2ZXJ9fSkKICAgIGZvdW5kLlNuYXBzaG90KCkKICAgIHJldHVybiAmZm91bmQsIG5pbAp9CgovLyBMb2FkIHJldHVybnMgZXZlcnkgcm93LCBvcmRlcmVkIGJ5IGlkLgpmdW5jIChyZXBvICpGYWtle3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSkgTG9hZChjdHggY29udGV4dC5Db250ZXh0KSAoW117ey5Nb2RlbC5OYW1lfX0sIGVycm9yKSB7CiAgICByZXBvLm11LkxvY2soKQogICAgZGVmZXIgcmVwby5tdS5VbmxvY2soKQogICAgdmFyIHNldCBbXXt7Lk1vZGVsLk5hbWV9fQogICAgZm9yIF8sIHt7LlJlY2VpdmVyfX0gOj0gcmFuZ2UgcmVwby5yb3dzIHsKICAgICAgICB7ey0gaWYgLlNvZnREZWxldGUgfX0KICAgICAgICBpZiB7ey5SZWNlaXZlcn19Lnt7IGZpZWxkX25hbWUgLk1vZGVsLkZpZWxkcyAuU29mdERlbGV0ZSB9fS5WYWxpZCB7CiAgICAgICAgICAgIGNvbnRpbnVlCiAgICAgICAgfQogICAgICAgIHt7LSBlbmQgfX0KICAgICAgICByb3cgOj0gY2xvbmV7ey5Nb2RlbC5OYW1lfX0oJnt7LlJlY2VpdmVyfX0pCiAgICAgICAgcm93LlNuYXBzaG90KCkKICAgICAgICBzZXQgPSBhcHBlbmQoc2V0LCByb3cpCiAgICB9CiAgICBzb3J0LlNsaWNlKHNldCwgZnVuYyhpLCBqIGludCkgYm9vbCB7IHJldHVybiBzZXRbaV0uSUQgPCBzZXRbal0uSUQgfSkKICAgIHJldHVybiBzZXQsIG5pbAp9CgovLyBDb3VudCByZXR1cm5zIHRoZSBudW1iZXIgb2Ygcm93cy4KZnVuYyAocmVwbyAqRmFrZXt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkpIENvdW50KGN0eCBjb250ZXh0LkNvbnRleHQpIChpbnQ2NCwgZXJyb3IpIHsKICAgIHJlcG8ubXUuTG9jaygpCiAgICBkZWZlciByZXBvLm11LlVubG9jaygpCiAgICB7ey0gaWYgLlNvZnREZWxldGUgfX0KICAgIHZhciBjb3VudCBpbnQ2NAogICAgZm9yIF8sIHt7LlJlY2VpdmVyfX0gOj0gcmFuZ2UgcmVwby5yb3dzIHsKICAgICAgICBpZiAhe3suUmVjZWl2ZXJ9fS57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlNvZnREZWxldGUgfX0uVmFsaWQgewogICAgICAgICAgICBjb3VudCsrCiAgICAgICAgfQogICAgfQogICAgcmV0dXJuIGNvdW50LCBuaWwKICAgIHt7LSBlbHNlIH19CiAgICByZXR1cm4gaW50NjQobGVuKHJlcG8ucm93cykpLCBuaWwKICAgIHt7LSBlbmQgfX0KfQoKLy8gRXhpc3RzIHJlcG9ydHMgd2hldGhlciBhIHJvdyB3aXRoIHRoZSBnaXZlbiBpZCBleGlzdHMuCmZ1bmMgKHJlcG8gKkZha2V7ey5Nb2RlbC5OYW1lfX1SZXBvc2l0b3J5KSBFeGlzdHMoY3R4IGNvbnRleHQuQ29udGV4dCwgaWQgaW50NjQpIChib29sLCBlcnJvcikgewogICAgcmVwby5tdS5Mb2NrKCkKICAgIGRlZmVyIHJlcG8ubXUuVW5sb2NrKCkKICAgIHt7LSBpZiAuU29mdERlbGV0ZSB9fQogICAge3suUmVjZWl2ZXJ9fSwgb2sgOj0gcmVwby5yb3dzW2lkXQogICAgcmV0dXJuIG9rICYmICF7ey5SZWNlaXZlcn19Lnt7IGZpZWxkX25hbWUgLk1vZGVsLkZpZWxkcyAuU29mdERlbGV0ZSB9fS5WYWxpZCwgbmlsCiAgICB7ey0gZWxzZSB9fQogICAgXywgb2sgOj0gcmVwby5yb3dzW2lkXQogICAgcmV0dXJuIG9rLCBuaWwKICAgIHt7LSBlbmQgfX0KfQoKLy8gSW5zZXJ0IHN0b3JlcyBhIG5ldyByb3csIHNldHRpbmcgaXRzIGF1dG8gaW5jcmVtZW50ZWQgaWQgb24gdGhlIG1vZGVsLgovLyBMaWtlIHRoZSBnZW5lcmF0ZWQgSW5zZXJ0LCBpdCBpZ25vcmVzIHRoZSBpZCB0aGUgbW9kZWwgaGFkLgpmdW5jIChyZXBvICpGYWtle3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSkgSW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgKGludDY0LCBlcnJvcikgewogICAge3stIGlmIC5WYWxpZGF0ZSB9fQogICAgaWYgZXJyIDo9IHt7LlJlY2VpdmVyfX0uVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIDAsIGVycgogICAgfQogICAge3stIGVuZCB9fQogICAgcmVwby5tdS5Mb2NrKCkKICAgIGRlZmVyIHJlcG8ubXUuVW5sb2NrKCkKICAgIHJvdyA6PSBjbG9uZXt7Lk1vZGVsLk5hbWV9fSh7ey5SZWNlaXZlcn19KQogICAgcm93LklEID0gcmVwby5sYXN0SUQgKyAxCiAgICB7ey0gcmFuZ2UgJGssICR2IDo9IC5Nb2RlbC5GaWVsZHMgfX0KICAgIHt7LSBpZiBlcSAkdi5Db2x1bW5OYW1lICJjcmVhdGVkX2F0IiB9fQogICAge3stIGlmIGVxICR2LlR5cGUgInRpbWUuVGltZSIgfX0KICAgIHJvdy57eyAkdi5OYW1lIH19ID0gbm93KCkKICAgIHt7LSBlbHNlIGlmIGVxICR2LlR5cGUgIk51bGxUaW1lIiB9fQogICAgcm93Lnt7ICR2Lk5hbWUgfX0gPSBUb051bGxUaW1lKG5vdygpKQogICAge3stIGVuZCB9fQogICAge3stIGVuZCB9fQogICAge3stIGVuZCB9fQogICAgaWYgcmVwby5kdXBsaWNhdGVzKCZyb3cpIHsKICAgICAgICByZXR1cm4gMCwgRXJyRHVwbGljYXRlS2V5CiAgICB9CiAgICByZXBvLmxhc3RJRCA9IHJvdy5JRAogICAgcmVwby5yb3dzW3Jvdy5JRF0gPSByb3cKICAgIHt7LlJlY2VpdmVyfX0uSUQgPSByb3cuSUQKICAgIHJldHVybiByb3cuSUQsIG5pbAp9CgovLyBVcGRhdGUgb3ZlcndyaXRlcyB0aGUgcm93IHdpdGggdGhlIGlkIG9mIHRoZSBtb2RlbC4Ke3stIGlmIC5WZXJzaW9uIH19Ci8vIEl0IHJldHVybnMgRXJyU3RhbGVPYmplY3QgaWYgdGhlIHt7LlZlcnNpb259fSBvZiB0aGUgcm93IG5vIGxvbmdlciBtYXRjaGVzIHRoZSBtb2RlbC4Ke3stIGVuZCB9fQpmdW5jIChyZXBvICpGYWtle3suTW9kZWwuTmFtZX19UmVwb3NpdG9yeSkgVXBkYXRlKGN0eCBjb250ZXh0LkNvbnRleHQsIHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkgZXJyb3IgewogICAge3stIGlmIC5WYWxpZGF0ZSB9fQogICAgaWYgZXJyIDo9IHt7LlJlY2VpdmVyfX0uVmFsaWRhdGUoKTsgZXJyICE9IG5pbCB7CiAgICAgICAgcmV0dXJuIGVycgogICAgfQogICAge3stIGVuZCB9fQogICAgcmVwby5tdS5Mb2NrKCkKICAgIGRlZmVyIHJlcG8ubXUuVW5sb2NrKCkKICAgIHJvdywgb2sgOj0gcmVwby5yb3dzW3t7LlJlY2VpdmVyfX0uSURdCiAgICBpZiAhb2sgewogICAgICAgIHJldHVybiBzcWwuRXJyTm9Sb3dzCiAgICB9CiAgICB7ey0gaWYgLlZlcnNpb24gfX0KICAgIGlmIHJvdy57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0gIT0ge3suUmVjZWl2ZXJ9fS57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlZlcnNpb24gfX0gewogICAgICAgIHJldHVybiBFcnJTdGFsZU9iamVjdAogICAgfQogICAgcm93Lnt7IGZpZWxkX25hbWUgLk1vZGVsLkZpZWxkcyAuVmVyc2lvbiB9fSsrCiAgICB7ey0gZW5kIH19CiAgICB7ey0gcmFuZ2UgJGssICR2IDo9IHVwZGF0ZV9maWVsZHMgLiB9fQogICAgcm93Lnt7ICR2Lk5hbWUgfX0gPSB7eyQuUmVjZWl2ZXJ9fS57eyAkdi5OYW1lIH19CiAgICB7ey0gZW5kIH19CiAgICB7ey0gcmFuZ2UgJGssICR2IDo9IC5Nb2RlbC5GaWVsZHMgfX0KICAgIHt7LSBpZiBlcSAkdi5OYW1lICJVcGRhdGVkQXQiIH19CiAgICB7ey0gaWYgZXEgJHYuVHlwZSAidGltZS5UaW1lIiB9fQogICAgcm93Lnt7ICR2Lk5hbWUgfX0gPSBub3coKQogICAge3stIGVsc2UgaWYgZXEgJHYuVHlwZSAiTnVsbFRpbWUiIH19CiAgICByb3cue3sgJHYuTmFtZSB9fSA9IFRvTnVsbFRpbWUobm93KCkpCiAgICB7ey0gZW5kIH19CiAgICB7ey0gZW5kIH19CiAgICB7ey0gZW5kIH19CiAgICByb3cgPSBjbG9uZXt7Lk1vZGVsLk5hbWV9fSgmcm93KQogICAgaWYgcmVwby5kdXBsaWNhdGVzKCZyb3cpIHsKICAgICAgICByZXR1cm4gRXJyRHVwbGljYXRlS2V5CiAgICB9CiAgICByZXBvLnJvd3Nbcm93LklEXSA9IHJvdwogICAge3stIGlmIC5WZXJzaW9uIH19CiAgICB7ey5SZWNlaXZlcn19Lnt7IGZpZWxkX25hbWUgLk1vZGVsLkZpZWxkcyAuVmVyc2lvbiB9fSsrCiAgICB7ey0gZW5kIH19CiAgICB7ey5SZWNlaXZlcn19LlNuYXBzaG90KCkKICAgIHJldHVybiBuaWwKfQoKLy8gRGVsZXRlIHJlbW92ZXMgdGhlIHJvdyB3aXRoIHRoZSBnaXZlbiBpZC4KZnVuYyAocmVwbyAqRmFrZXt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkpIERlbGV0ZShjdHggY29udGV4dC5Db250ZXh0LCBpZCBpbnQ2NCkgZXJyb3IgewogICAgcmVwby5tdS5Mb2NrKCkKICAgIGRlZmVyIHJlcG8ubXUuVW5sb2NrKCkKICAgIHt7LSBpZiAuU29mdERlbGV0ZSB9fQogICAgcm93LCBvayA6PSByZXBvLnJvd3NbaWRdCiAgICBpZiAhb2sgfHwgcm93Lnt7IGZpZWxkX25hbWUgLk1vZGVsLkZpZWxkcyAuU29mdERlbGV0ZSB9fS5WYWxpZCB7CiAgICAgICAgcmV0dXJuIHNxbC5FcnJOb1Jvd3MKICAgIH0KICAgIHJvdy57eyBmaWVsZF9uYW1lIC5Nb2RlbC5GaWVsZHMgLlNvZnREZWxldGUgfX0gPSBUb051bGxUaW1lKG5vdygpKQogICAgcmVwby5yb3dzW2lkXSA9IHJvdwogICAge3stIGVsc2UgfX0KICAgIGlmIF8sIG9rIDo9IHJlcG8ucm93c1tpZF07ICFvayB7CiAgICAgICAgcmV0dXJuIHNxbC5FcnJOb1Jvd3MKICAgIH0KICAgIGRlbGV0ZShyZXBvLnJvd3MsIGlkKQogICAge3stIGVuZCB9fQogICAgcmV0dXJuIG5pbAp9CgovLyBkdXBsaWNhdGVzIHJlcG9ydHMgd2hldGhlciBhbm90aGVyIHJvdyBoYXMgdGhlIHNhbWUgdW5pcXVlIGtleSB2YWx1ZXMgYXMgdGhlIGdpdmVuIG9uZS4KZnVuYyAocmVwbyAqRmFrZXt7Lk1vZGVsLk5hbWV9fVJlcG9zaXRvcnkpIGR1cGxpY2F0ZXMoe3suUmVjZWl2ZXJ9fSAqe3suTW9kZWwuTmFtZX19KSBib29sIHsKICAgIHt7LSBpZiAuTW9kZWwuVW5pcXVlcyB9fQogICAgZm9yIGlkLCBvdGhlciA6PSByYW5nZSByZXBvLnJvd3MgewogICAgICAgIGlmIGlkID09IHt7LlJlY2VpdmVyfX0uSUQgewogICAgICAgICAgICBjb250aW51ZQogICAgICAgIH0KICAgICAgICB7ey0gcmFuZ2UgJGssICRrZXkgOj0gLk1vZGVsLlVuaXF1ZXMgfX0KICAgICAgICBpZiB7eyB1bmlxdWVfbWF0Y2ggJGtleSAib3RoZXIiICQuUmVjZWl2ZXIgfX0gewogICAgICAgICAgICByZXR1cm4gdHJ1ZQogICAgICAgIH0KICAgICAgICB7ey0gZW5kIH19CiAgICB9CiAgICB7ey0gZW5kIH19CiAgICByZXR1cm4gZmFsc2UKfQoKLy8gY2xvbmV7ey5Nb2RlbC5OYW1lfX0gY29waWVzIGEgcm93LCBzbyB0aGUgZmFrZSByZXBvc2l0b3J5IG5ldmVyIHNoYXJlcyBtZW1vcnkgd2l0aCB0aGUgbW9kZWxzIGl0IGlzIGdpdmVuLgpmdW5jIGNsb25le3suTW9kZWwuTmFtZX19KHt7LlJlY2VpdmVyfX0gKnt7Lk1vZGVsLk5hbWV9fSkge3suTW9kZWwuTmFtZX19IHsKICAgIHJvdyA6PSAqe3suUmVjZWl2ZXJ9fQogICAgcm93LnNuYXBzaG90ID0gbmlsCiAgICB7ey0gcmFuZ2UgJGssICR2IDo9IC5Nb2RlbC5GaWVsZHMgfX0KICAgIHt7LSBpZiBvciAoZXEgJHYuVHlwZSAiW11ieXRlIikgKGVxICR2LlR5cGUgIlJhd0pTT04iKSB9fQogICAgcm93Lnt7ICR2Lk5hbWUgfX0gPSBhcHBlbmQocm93Lnt7ICR2Lk5hbWUgfX1bOjA6MF0sIHt7JC5SZWNlaXZlcn19Lnt7ICR2Lk5hbWUgfX0uLi4pCiAgICB7ey0gZW5kIH19CiAgICB7ey0gZW5kIH19CiAgICByZXR1cm4gcm93Cn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "schema.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImJ5dGVzIgoJImVuY29kaW5nL2pzb24iCgkic3RyY29udiIKCSJzdHJpbmdzIgopCgovLyBTY2hlbWEgaXMgYSBKU09OIFNjaGVtYSwgb3IgYW4gT3BlbkFQSSAzIHNjaGVtYSBvYmplY3QsIGRlc2NyaWJpbmcKLy8gdGhlIEpTT04gZW5jb2Rpbmcgb2YgYSBnZW5lcmF0ZWQgbW9kZWwgb3Igb25lIG9mIGl0cyBmaWVsZHMuCnR5cGUgU2NoZW1hIHN0cnVjdCB7CglTY2hlbWEgICAgICAgICAgICAgICBzdHJpbmcgICAgICAgIGBqc29uOiIkc2NoZW1hLG9taXRlbXB0eSJgCglUaXRsZSAgICAgICAgICAgICAgICBzdHJpbmcgICAgICAgIGBqc29uOiJ0aXRsZSxvbWl0ZW1wdHkiYAoJRGVzY3JpcHRpb24gICAgICAgICAgc3RyaW5nICAgICAgICBganNvbjoiZGVzY3JpcHRpb24sb21pdGVtcHR5ImAKCVR5cGUgICAgICAgICAgICAgICAgIGludGVyZmFjZXt9ICAgYGpzb246InR5cGUsb21pdGVtcHR5ImAKCUZvcm1hdCAgICAgICAgICAgICAgIHN0cmluZyAgICAgICAgYGpzb246ImZvcm1hdCxvbWl0ZW1wdHkiYAoJQ29udGVudEVuY29kaW5nICAgICAgc3RyaW5nICAgICAgICBganNvbjoiY29udGVudEVuY29kaW5nLG9taXRlbXB0eSJgCglOdWxsYWJsZSAgICAgICAgICAgICBib29sICAgICAgICAgIGBqc29uOiJudWxsYWJsZSxvbWl0ZW1wdHkiYAoJRW51bSAgICAgICAgICAgICAgICAgW11pbnRlcmZhY2V7fSBganNvbjoiZW51bSxvbWl0ZW1wdHkiYAoJTWF4TGVuZ3RoICAgICAgICAgICAgKmludCAgICAgICAgICBganNvbjoibWF4TGVuZ3RoLG9taXRlbXB0eSJgCglNaW5pbXVtICAgICAgICAgICAgICAqaW50NjQgICAgICAgIGBqc29uOiJtaW5pbXVtLG9taXRlbXB0eSJgCglNYXhpbXVtICAgICAgICAgICAgICAqaW50NjQgICAgICAgIGBqc29uOiJtYXhpbXVtLG9taXRlbXB0eSJgCglQcm9wZXJ0aWVzICAgICAgICAgICBQcm9wZXJ0aWVzICAgIGBqc29uOiJwcm9wZXJ0aWVzLG9taXRlbXB0eSJgCglSZXF1aXJlZCAgICAgICAgICAgICBbXXN0cmluZyAgICAgIGBqc29uOiJyZXF1aXJlZCxvbWl0ZW1wdHkiYAoJQWRkaXRpb25hbFByb3BlcnRpZXMgKmJvb2wgICAgICAgICBganNvbjoiYWRkaXRpb25hbFByb3BlcnRpZXMsb21pdGVtcHR5ImAKfQoKLy8gUHJvcGVydHkgaXMgYSBuYW1lZCBwcm9wZXJ0eSBvZiBhbiBvYmplY3Qgc2NoZW1hLgp0eXBlIFByb3BlcnR5IHN0cnVjdCB7CglOYW1lICAgc3RyaW5nCglTY2hlbWEgU2NoZW1hCn0KCi8vIFByb3BlcnRpZXMgaG9sZHMgdGhlIHByb3BlcnRpZXMgb2YgYW4gb2JqZWN0IHNjaGVtYSwKLy8gZW5jb2RlZCBpbiB0aGUgb3JkZXIgb2YgdGhlIGNvbHVtbnMgcmF0aGVyIHRoYW4gYWxwaGFiZXRpY2FsbHkuCnR5cGUgUHJvcGVydGllcyBbXVByb3BlcnR5CgovLyBNYXJzaGFsSlNPTiBlbmNvZGVzIHRoZSBwcm9wZXJ0aWVzIGFzIGEgSlNPTiBvYmplY3QuCmZ1bmMgKHAgUHJvcGVydGllcykgTWFyc2hhbEpTT04oKSAoW11ieXRlLCBlcnJvcikgewoJYnVmIDo9IG5ldyhieXRlcy5CdWZmZXIpCglidWYuV3JpdGVCeXRlKCd7JykKCWZvciBpLCBwcm9wIDo9IHJhbmdlIHAgewoJCWlmIGkgPiAwIHsKCQkJYnVmLldyaXRlQnl0ZSgnLCcpCgkJfQoJCWVuYyA6PSBqc29uLk5ld0VuY29kZXIoYnVmKQoJCS8vIGNvbW1lbnRzIG9mdGVuIGhvbGQgPCwgPiBhbmQgJiwgd2hpY2ggbmVlZCBubyBlc2NhcGluZyBvdXRzaWRlIG9mIEhUTUwKCQllbmMuU2V0RXNjYXBlSFRNTChmYWxzZSkKCQlpZiBlcnIgOj0gZW5jLkVuY29kZShwcm9wLk5hbWUpOyBlcnIgIT0gbmlsIHsKCQkJcmV0dXJuIG5pbCwgZXJyCgkJfQoJCWJ1Zi5Xcml0ZUJ5dGUoJzonKQoJCWlmIGVyciA6PSBlbmMuRW5jb2RlKHByb3AuU2NoZW1hKTsgZXJyICE9IG5pbCB7CgkJCXJldHVybiBuaWwsIGVycgoJCX0KCX0KCWJ1Zi5Xcml0ZUJ5dGUoJ30nKQoJcmV0dXJuIGJ1Zi5CeXRlcygpLCBuaWwKfQoKLy8gR2V0TW9kZWxTY2hlbWEgcmV0dXJucyB0aGUgc2NoZW1hIG9mIHRoZSBKU09OIGVuY29kaW5nIG9mIGEgbW9kZWwsIGFuIG9iamVjdCBob2xkaW5nCi8vIGEgcHJvcGVydHkgcGVyIGZpZWxkIG5hbWVkIGFmdGVyIGl0cyBKU09OIHRhZy4gRXZlcnkgcHJvcGVydHkgaXMgcmVxdWlyZWQsIGFzCi8vIHRoZSBnZW5lcmF0ZWQgc3RydWN0cyBhbHdheXMgZW5jb2RlIGV2ZXJ5IGZpZWxkLiBUaGUgb3BlbmFwaSBmbGFnIHN3aXRjaGVzCi8vIHRvIHRoZSBPcGVuQVBJIDMuMCBkaWFsZWN0LCB3aGljaCBtYXJrcyBudWxsYWJsZSB2YWx1ZXMgd2l0aCBudWxsYWJsZQovLyByYXRoZXIgdGhhbiB3aXRoIGEgbnVsbCB0eXBlLgpmdW5jIEdldE1vZGVsU2NoZW1hKG0gVG1wbFN0cnVjdCwgb3BlbmFwaSBib29sKSBTY2hlbWEgewoJY2xvc2VkIDo9IGZhbHNlCglzIDo9IFNjaGVtYXsKCQlUaXRsZTogICAgICAgICAgICAgICAgbS5OYW1lLAoJCVR5cGU6ICAgICAgICAgICAgICAgICAib2JqZWN0IiwKCQlBZGRpdGlvbmFsUHJvcGVydGllczogJmNsb3NlZCwKCX0KCWZvciBfLCBmbCA6PSByYW5nZSBtLkZpZWxkcyB7CgkJcy5Qcm9wZXJ0aWVzID0gYXBwZW5kKHMuUHJvcGVydGllcywgUHJvcGVydHl7TmFtZTogZmwuQ29sdW1uTmFtZSwgU2NoZW1hOiBHZXRGaWVsZFNjaGVtYShmbCwgb3BlbmFwaSl9KQoJCXMuUmVxdWlyZWQgPSBhcHBlbmQocy5SZXF1aXJlZCwgZmwuQ29sdW1uTmFtZSkKCX0KCXJldHVybiBzCn0KCi8vIEdldEZpZWxkU2NoZW1hIHJldHVybnMgdGhlIHNjaGVtYSBvZiB0aGUgSlNPTiBlbmNvZGluZyBvZiBhIGZpZWxkLgpmdW5jIEdldEZpZWxkU2NoZW1hKGZsIFRtcGxGaWVsZCwgb3BlbmFwaSBib29sKSBTY2hlbWEgewoJYmFzZSwgYXJncywgdW5zaWduZWQgOj0gcGFyc2VDb2x1bW5UeXBlKGZsLkNvbHVtblR5cGUpCglzIDo9IFNjaGVtYXtEZXNjcmlwdGlvbjogQ29tbWVudFRleHQoZmwuQ29tbWVudCl9CgoJdmFyIHR5cCBzdHJpbmcKCXN3aXRjaCBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKSB7CgljYXNlICJSYXdKU09OIjoKCQkvLyBhbnkgSlNPTiB2YWx1ZSwgaW5jbHVkaW5nIG51bGwKCQlyZXR1cm4gcwoJY2FzZSAiW11ieXRlIjoKCQl0eXAgPSAic3RyaW5nIgoJCWlmIG9wZW5hcGkgewoJCQlzLkZvcm1hdCA9ICJieXRlIgoJCX0gZWxzZSB7CgkJCXMuQ29udGVudEVuY29kaW5nID0gImJhc2U2NCIKCQl9CgljYXNlICJzdHJpbmciLCAiU3RyaW5nIjoKCQl0eXAgPSAic3RyaW5nIgoJCXN3aXRjaCBiYXNlIHsKCQljYXNlICJlbnVtIjoKCQkJZm9yIF8sIG1lbWJlciA6PSByYW5nZSBxdW90ZWRWYWx1ZXMoYXJncykgewoJCQkJcy5FbnVtID0gYXBwZW5kKHMuRW51bSwgbWVtYmVyKQoJCQl9CgkJY2FzZSAiY2hhciIsICJ2YXJjaGFyIjoKCQkJaWYgbiwgZXJyIDo9IHN0cmNvbnYuQXRvaShhcmdzKTsgZXJyID09IG5pbCB7CgkJCQlzLk1heExlbmd0aCA9ICZuCgkJCQlpZiBiYXNlID09ICJjaGFyIiAmJiBuID09IDM2IHsKCQkJCQlzLkZvcm1hdCA9ICJ1dWlkIgoJCQkJfQoJCQl9CgkJfQoJY2FzZSAiaW50NjQiLCAiSW50NjQiOgoJCXR5cCA9ICJpbnRlZ2VyIgoJCWlmIG9wZW5hcGkgewoJCQlzLkZvcm1hdCA9ICJpbnQ2NCIKCQl9CgkJaWYgaGksIG9rIDo9IGludFJhbmdlc1tiYXNlXTsgb2sgJiYgYmFzZSAhPSAiYmlnaW50IiB7CgkJCWxvIDo9IC1oaSAtIDEKCQkJaWYgdW5zaWduZWQgewoJCQkJbG8sIGhpID0gMCwgaGkqMisxCgkJCX0KCQkJcy5NaW5pbXVtLCBzLk1heGltdW0gPSAmbG8sICZoaQoJCX0gZWxzZSBpZiB1bnNpZ25lZCB7CgkJCWxvIDo9IGludDY0KDApCgkJCXMuTWluaW11bSA9ICZsbwoJCX0KCWNhc2UgImZsb2F0NjQiLCAiRmxvYXQ2NCI6CgkJdHlwID0gIm51bWJlciIKCQlpZiBvcGVuYXBpIHsKCQkJcy5Gb3JtYXQgPSAiZG91YmxlIgoJCX0KCWNhc2UgImJvb2wiLCAiQm9vbCI6CgkJdHlwID0gImJvb2xlYW4iCgljYXNlICJ0aW1lLlRpbWUiLCAiVGltZSI6CgkJdHlwID0gInN0cmluZyIKCQlzLkZvcm1hdCA9ICJkYXRlLXRpbWUiCglkZWZhdWx0OgoJCXR5cCA9ICJzdHJpbmciCgl9CgoJLy8gTnVsbFggdHlwZXMgYW5kIG5pbCBieXRlIHNsaWNlcyBlbmNvZGUgYXMgbnVsbAoJaWYgIXN0cmluZ3MuSGFzUHJlZml4KGZsLlR5cGUsICJOdWxsIikgJiYgZmwuVHlwZSAhPSAiW11ieXRlIiB7CgkJcy5UeXBlID0gdHlwCgkJcmV0dXJuIHMKCX0KCWlmIHMuRW51bSAhPSBuaWwgewoJCXMuRW51bSA9IGFwcGVuZChzLkVudW0sIG5pbCkKCX0KCWlmIG9wZW5hcGkgewoJCXMuVHlwZSwgcy5OdWxsYWJsZSA9IHR5cCwgdHJ1ZQoJfSBlbHNlIHsKCQlzLlR5cGUgPSBbXXN0cmluZ3t0eXAsICJudWxsIn0KCX0KCXJldHVybiBzCn0K\"")
+	packr.PackJSONBytes("./tmpl", "schema_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImVuY29kaW5nL2pzb24iCgkidGVzdGluZyIKKQoKZnVuYyBUZXN0R2V0TW9kZWxTY2hlbWEodCAqdGVzdGluZy5UKSB7CgltIDo9IFRtcGxTdHJ1Y3R7CgkJTmFtZTogIlVzZXIiLAoJCUZpZWxkczogW11UbXBsRmllbGR7CgkJCXtOYW1lOiAiSUQiLCBUeXBlOiAiaW50NjQiLCBDb2x1bW5OYW1lOiAiaWQiLCBDb2x1bW5UeXBlOiAiaW50KDEwKSB1bnNpZ25lZCJ9LAoJCQl7TmFtZTogIlVVSUQiLCBUeXBlOiAic3RyaW5nIiwgQ29sdW1uTmFtZTogInV1aWQiLCBDb2x1bW5UeXBlOiAiY2hhcigzNikifSwKCQkJe05hbWU6ICJTdGF0dXMiLCBUeXBlOiAiTnVsbFN0cmluZyIsIENvbHVtbk5hbWU6ICJzdGF0dXMiLCBDb2x1bW5UeXBlOiAiZW51bSgnb24nLCdvZmYnKSIsIENvbW1lbnQ6ICJjdXJyZW50XG5zdGF0dXMifSwKCQkJe05hbWU6ICJBdmF0YXIiLCBUeXBlOiAiW11ieXRlIiwgQ29sdW1uTmFtZTogImF2YXRhciIsIENvbHVtblR5cGU6ICJibG9iIn0sCgkJCXtOYW1lOiAiQ3JlYXRlZEF0IiwgVHlwZTogInRpbWUuVGltZSIsIENvbHVtbk5hbWU6ICJjcmVhdGVkX2F0IiwgQ29sdW1uVHlwZTogImRhdGV0aW1lIn0sCgkJfSwKCX0KCXRlc3RzIDo9IFtdc3RydWN0IHsKCQlvcGVuYXBpIGJvb2wKCQl3YW50ICAgIHN0cmluZwoJfXsKCQl7ZmFsc2UsIGB7InRpdGxlIjoiVXNlciIsInR5cGUiOiJvYmplY3QiLCJwcm9wZXJ0aWVzIjp7YCArCgkJCWAiaWQiOnsidHlwZSI6ImludGVnZXIiLCJtaW5pbXVtIjowLCJtYXhpbXVtIjo0Mjk0OTY3Mjk1fSxgICsKCQkJYCJ1dWlkIjp7InR5cGUiOiJzdHJpbmciLCJmb3JtYXQiOiJ1dWlkIiwibWF4TGVuZ3RoIjozNn0sYCArCgkJCWAic3RhdHVzIjp7ImRlc2NyaXB0aW9uIjoiY3VycmVudCBzdGF0dXMiLCJ0eXBlIjpbInN0cmluZyIsIm51bGwiXSwiZW51bSI6WyJvbiIsIm9mZiIsbnVsbF19LGAgKwoJCQlgImF2YXRhciI6eyJ0eXBlIjpbInN0cmluZyIsIm51bGwiXSwiY29udGVudEVuY29kaW5nIjoiYmFzZTY0In0sYCArCgkJCWAiY3JlYXRlZF9hdCI6eyJ0eXBlIjoic3RyaW5nIiwiZm9ybWF0IjoiZGF0ZS10aW1lIn19LGAgKwoJCQlgInJlcXVpcmVkIjpbImlkIiwidXVpZCIsInN0YXR1cyIsImF2YXRhciIsImNyZWF0ZWRfYXQiXSwiYWRkaXRpb25hbFByb3BlcnRpZXMiOmZhbHNlfWB9LAoJCXt0cnVlLCBgeyJ0aXRsZSI6IlVzZXIiLCJ0eXBlIjoib2JqZWN0IiwicHJvcGVydGllcyI6e2AgKwoJCQlgImlkIjp7InR5cGUiOiJpbnRlZ2VyIiwiZm9ybWF0IjoiaW50NjQiLCJtaW5pbXVtIjowLCJtYXhpbXVtIjo0Mjk0OTY3Mjk1fSxgICsKCQkJYCJ1dWlkIjp7InR5cGUiOiJzdHJpbmciLCJmb3JtYXQiOiJ1dWlkIiwibWF4TGVuZ3RoIjozNn0sYCArCgkJCWAic3RhdHVzIjp7ImRlc2NyaXB0aW9uIjoiY3VycmVudCBzdGF0dXMiLCJ0eXBlIjoic3RyaW5nIiwibnVsbGFibGUiOnRydWUsImVudW0iOlsib24iLCJvZmYiLG51bGxdfSxgICsKCQkJYCJhdmF0YXIiOnsidHlwZSI6InN0cmluZyIsImZvcm1hdCI6ImJ5dGUiLCJudWxsYWJsZSI6dHJ1ZX0sYCArCgkJCWAiY3JlYXRlZF9hdCI6eyJ0eXBlIjoic3RyaW5nIiwiZm9ybWF0IjoiZGF0ZS10aW1lIn19LGAgKwoJCQlgInJlcXVpcmVkIjpbImlkIiwidXVpZCIsInN0YXR1cyIsImF2YXRhciIsImNyZWF0ZWRfYXQiXSwiYWRkaXRpb25hbFByb3BlcnRpZXMiOmZhbHNlfWB9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQliLCBlcnIgOj0ganNvbi5NYXJzaGFsKEdldE1vZGVsU2NoZW1hKG0sIHR0Lm9wZW5hcGkpKQoJCWlmIGVyciAhPSBuaWwgewoJCQl0LkZhdGFsKGVycikKCQl9CgkJaWYgc3RyaW5nKGIpICE9IHR0LndhbnQgewoJCQl0LkVycm9yZigiR2V0TW9kZWxTY2hlbWEob3BlbmFwaT0ldilcbmdvdDogICVzXG53YW50OiAlcyIsIHR0Lm9wZW5hcGksIGIsIHR0LndhbnQpCgkJfQoJfQp9Cg==\"")
+	packr.PackJSONBytes("./tmpl", "tmpl.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImZtdCIKCSJyZWdleHAiCgkic3RyY29udiIKCSJzdHJpbmdzIgoJInRleHQvdGVtcGxhdGUiCikKCnZhciBGdW5jTWFwID0gdGVtcGxhdGUuRnVuY01hcHsKCSJpbnNlcnRfZmllbGRzIjogICAgICAgR2V0SW5zZXJ0RmllbGRzLAoJImluc2VydF92YWx1ZXMiOiAgICAgICBHZXRJbnNlcnRWYWx1ZXMsCgkiaW5zZXJ0X2FyZ3MiOiAgICAgICAgIEdldEluc2VydEFyZ3MsCgkiaW5zZXJ0X2FyZ19saXN0IjogICAgIEdldEluc2VydEFyZ0xpc3QsCgkic2Nhbl9maWVsZHMiOiAgICAgICAgIEdldFNjYW5GaWVsZHMsCgkic2VsZWN0X2ZpZWxkcyI6ICAgICAgIEdldFNlbGVjdEZpZWxkcywKCSJ1cGRhdGVfYXJncyI6ICAgICAgICAgR2V0VXBkYXRlQXJncywKCSJ1cGRhdGVfdmFsdWVzIjogICAgICAgR2V0VXBkYXRlVmFsdWVzLAoJInVwc2VydF9maWVsZHMiOiAgICAgICBHZXRVcHNlcnRGaWVsZHMsCgkidXBzZXJ0X3ZhbHVlcyI6ICAgICAgIEdldFVwc2VydFZhbHVlcywKCSJ1cHNlcnRfb25fZHVwbGljYXRlIjogR2V0VXBzZXJ0T25EdXBsaWNhdGUsCgkidXBzZXJ0X2FyZ3MiOiAgICAgICAgIEdldFVwc2VydEFyZ3MsCgkid2l0aF9yZWNlaXZlciI6ICAgICAgIFdpdGhSZWNlaXZlciwKCSJzcWxfaWRlbnQiOiAgICAgICAgICAgUXVvdGVJZGVudCwKCSJnb19zdHJpbmciOiAgICAgICAgICAgUXVvdGVTdHJpbmcsCgkiZ29fY29tbWVudCI6ICAgICAgICAgIENvbW1lbnRUZXh0LAoJImZpZWxkX2NvbW1lbnQiOiAgICAgICBHZXRGaWVsZENvbW1lbnQsCgkiY29sdW1uX3R5cGUiOiAgICAgICAgIEdldENvbHVtblR5cGUsCgkiaGFzX2NvbHVtbiI6ICAgICAgICAgIEhhc0NvbHVtbiwKCSJhbmRfbm90X2RlbGV0ZWQiOiAgICAgR2V0QW5kTm90RGVsZXRlZCwKCSJhbmRfdmVyc2lvbiI6ICAgICAgICAgR2V0QW5kVmVyc2lvbiwKCSJmaWVsZF9uYW1lIjogICAgICAgICAgR2V0RmllbGROYW1lLAoJInVwZGF0ZV9maWVsZHMiOiAgICAgICBHZXRVcGRhdGVGaWVsZHMsCgkic2FtcGxlX3ZhbHVlIjogICAgICAgIEdldFNhbXBsZVZhbHVlLAoJIm51bGxfdmFsdWUiOiAgICAgICAgICBHZXROdWxsVmFsdWUsCgkidW5pcXVlX21hdGNoIjogICAgICAgIEdldFVuaXF1ZU1hdGNoLAoJInZhbGlkYXRpb25fcnVsZXMiOiAgICBHZXRWYWxpZGF0aW9uUnVsZXMsCgkiZGF0YWJhc2VfY2hlY2tzIjogICAgIEdldERhdGFiYXNlQ2hlY2tzLAoJInByb3RvX3BhY2thZ2UiOiAgICAgICBHZXRQcm90b1BhY2thZ2UsCgkicHJvdG9fdHlwZSI6ICAgICAgICAgIEdldFByb3RvVHlwZSwKCSJwcm90b19pbXBvcnRzIjogICAgICAgR2V0UHJvdG9JbXBvcnRzLAoJInRvX3Byb3RvIjogICAgICAgICAgICBHZXRUb1Byb3RvLAoJImZyb21fcHJvdG8iOiAgICAgICAgICBHZXRGcm9tUHJvdG8sCgkiZ3JhcGhxbF9zdHJpbmciOiAgICAgIEdyYXBoUUxTdHJpbmcsCgkiZ3JhcGhxbF9tZXRob2QiOiAgICAgIEdyYXBoUUxGaWVsZE1ldGhvZCwKCSJncmFwaHFsX3NpbmdsZSI6ICAgICAgR2V0R3JhcGhRTFNpbmdsZSwKCSJ0c190eXBlIjogICAgICAgICAgICAgR2V0VHlwZVNjcmlwdFR5cGUsCgkidHNfcHJvcGVydHkiOiAgICAgICAgIFR5cGVTY3JpcHRQcm9wZXJ0eSwKCSJ0c19jb21tZW50IjogICAgICAgICAgR2V0VHlwZVNjcmlwdENvbW1lbnQsCn0KCi8vIFdpdGhSZWNlaXZlciByZXR1cm5zIHRoZSB0ZW1wbGF0ZSBkYXRhIHdpdGggdGhlIGZpZWxkcyByZWZlcmVuY2VkIHRocm91Z2ggYW5vdGhlcgovLyB2YXJpYWJsZSB0aGFuIHRoZSByZWNlaXZlciwgc3VjaCBhcyB0aGUgcm93cyBvZiBhIGJhdGNoIGxvb3BlZCBvdmVyIHdpdGhpbiBhIG1ldGhvZC4KZnVuYyBXaXRoUmVjZWl2ZXIobSBTdHJ1Y3RUbXBsRGF0YSwgcmVjZWl2ZXIgc3RyaW5nKSBTdHJ1Y3RUbXBsRGF0YSB7CgltLlJlY2VpdmVyID0gcmVjZWl2ZXIKCXJldHVybiBtCn0KCi8vIFF1b3RlSWRlbnQgcXVvdGVzIGEgTXlTUUwgaWRlbnRpZmllciB3aXRoIGJhY2t0aWNrcywKLy8gZXNjYXBpbmcgYW55IGJhY2t0aWNrIGNvbnRhaW5lZCBpbiB0aGUgbmFtZSBpdHNlbGYuCmZ1bmMgUXVvdGVJZGVudChuYW1lIHN0cmluZykgc3RyaW5nIHsKCXJldHVybiAiYCIgKyBzdHJpbmdzLlJlcGxhY2UobmFtZSwgImAiLCAiYGAiLCAtMSkgKyAiYCIKfQoKLy8gUXVvdGVTdHJpbmcgcmV0dXJucyBzIGFzIGEgZG91YmxlIHF1b3RlZCBHbyBzdHJpbmcgbGl0ZXJhbCwKLy8gc2FmZSB0byBlbWJlZCBhbnl3aGVyZSBhbiBleHByZXNzaW9uIGlzIGV4cGVjdGVkIGluIGdlbmVyYXRlZCBjb2RlLgpmdW5jIFF1b3RlU3RyaW5nKHMgc3RyaW5nKSBzdHJpbmcgewoJcmV0dXJuIHN0cmNvbnYuUXVvdGUocykKfQoKLy8gQ29tbWVudFRleHQgZmxhdHRlbnMgcyBvbnRvIGEgc2luZ2xlIGxpbmUgc28gaXQgY2FuIGZvbGxvdwovLyBhIC8vIGNvbW1lbnQgbWFya2VyIGluIGdlbmVyYXRlZCBjb2RlIHdpdGhvdXQgYnJlYWtpbmcgb3V0IG9mIGl0LgpmdW5jIENvbW1lbnRUZXh0KHMgc3RyaW5nKSBzdHJpbmcgewoJcmV0dXJuIHN0cmluZ3MuSm9pbihzdHJpbmdzLkZpZWxkcyhzKSwgIiAiKQp9CgovLyBHZXRGaWVsZENvbW1lbnQgcmV0dXJucyBhIHRyYWlsaW5nIGxpbmUgY29tbWVudCBkb2N1bWVudGluZyB0aGUgY29sdW1uCi8vIGNvbW1lbnQgYW5kIGRlZmF1bHQgdmFsdWUgb2YgYSBmaWVsZCwgb3Igbm90aGluZyBpZiBpdCBoYXMgbmVpdGhlci4KZnVuYyBHZXRGaWVsZENvbW1lbnQoZmwgVG1wbEZpZWxkKSBzdHJpbmcgewoJdmFyIHBhcnRzIFtdc3RyaW5nCglpZiBmbC5Db21tZW50ICE9ICIiIHsKCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgQ29tbWVudFRleHQoZmwuQ29tbWVudCkpCgl9CglpZiBmbC5IYXNEZWZhdWx0IHsKCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgImRlZmF1bHQ6ICIrUXVvdGVTdHJpbmcoZmwuRGVmYXVsdCkpCgl9CglpZiBsZW4ocGFydHMpID09IDAgewoJCXJldHVybiAiIgoJfQoJcmV0dXJuICIvLyAiICsgc3RyaW5ncy5Kb2luKHBhcnRzLCAiICIpCn0KCi8vIEdldENvbHVtblR5cGUgcmV0dXJucyB0aGUgcXVlcnkgY29sdW1uIGRlc2NyaXB0b3IgdHlwZSBtYXRjaGluZyBhIGZpZWxkIHR5cGUuCmZ1bmMgR2V0Q29sdW1uVHlwZSh0eXAgc3RyaW5nKSBzdHJpbmcgewoJc3dpdGNoIHR5cCB7CgljYXNlICJpbnQ2NCIsICJOdWxsSW50NjQiOgoJCXJldHVybiAiSW50NjRDb2x1bW4iCgljYXNlICJmbG9hdDY0IiwgIk51bGxGbG9hdDY0IjoKCQlyZXR1cm4gIkZsb2F0NjRDb2x1bW4iCgljYXNlICJzdHJpbmciLCAiTnVsbFN0cmluZyI6CgkJcmV0dXJuICJTdHJpbmdDb2x1bW4iCgljYXNlICJib29sIiwgIk51bGxCb29sIjoKCQlyZXR1cm4gIkJvb2xDb2x1bW4iCgljYXNlICJ0aW1lLlRpbWUiLCAiTnVsbFRpbWUiOgoJCXJldHVybiAiVGltZUNvbHVtbiIKCWNhc2UgIltdYnl0ZSI6CgkJcmV0dXJuICJCeXRlc0NvbHVtbiIKCWNhc2UgIlJhd0pTT04iOgoJCXJldHVybiAiSlNPTkNvbHVtbiIKCWRlZmF1bHQ6CgkJcmV0dXJuICJDb2x1bW4iCgl9Cn0KCi8vIEhhc0NvbHVtbiByZXBvcnRzIHdoZXRoZXIgb25lIG9mIHRoZSBmaWVsZHMgbWFwcyB0byB0aGUgbmFtZWQgY29sdW1uLgpmdW5jIEhhc0NvbHVtbihmaWVsZHMgW11UbXBsRmllbGQsIG5hbWUgc3RyaW5nKSBib29sIHsKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCWlmIGZsLkNvbHVtbk5hbWUgPT0gbmFtZSB7CgkJCXJldHVybiB0cnVlCgkJfQoJfQoJcmV0dXJuIGZhbHNlCn0KCi8vIEdldEFuZE5vdERlbGV0ZWQgcmV0dXJucyB0aGUgY29uZGl0aW9uIGV4Y2x1ZGluZyBzb2Z0IGRlbGV0ZWQgcm93cywKLy8gdG8gYXBwZW5kIHRvIGEgV0hFUkUgY2xhdXNlLCBvciBub3RoaW5nIGlmIHRoZSBtb2RlbCBoYXMgbm8gc29mdCBkZWxldGUgY29sdW1uLgpmdW5jIEdldEFuZE5vdERlbGV0ZWQobSBTdHJ1Y3RUbXBsRGF0YSkgc3RyaW5nIHsKCWlmIG0uU29mdERlbGV0ZSA9PSAiIiB7CgkJcmV0dXJuICIiCgl9CglyZXR1cm4gIiBBTkQgIiArIFF1b3RlSWRlbnQobS5Tb2Z0RGVsZXRlKSArICIgSVMgTlVMTCIKfQoKLy8gR2V0QW5kVmVyc2lvbiByZXR1cm5zIHRoZSBjb25kaXRpb24gbWF0Y2hpbmcgdGhlIHZlcnNpb24gdGhlIHJvdyB3YXMgcmVhZCBhdCwKLy8gdG8gYXBwZW5kIHRvIGEgV0hFUkUgY2xhdXNlLCBvciBub3RoaW5nIGlmIHRoZSBtb2RlbCBoYXMgbm8gdmVyc2lvbiBjb2x1bW4uCmZ1bmMgR2V0QW5kVmVyc2lvbihtIFN0cnVjdFRtcGxEYXRhKSBzdHJpbmcgewoJaWYgbS5WZXJzaW9uID09ICIiIHsKCQlyZXR1cm4gIiIKCX0KCXJldHVybiAiIEFORCAiICsgUXVvdGVJZGVudChtLlZlcnNpb24pICsgIiA9ID8iCn0KCi8vIEdldEZpZWxkTmFtZSByZXR1cm5zIHRoZSBuYW1lIG9mIHRoZSBmaWVsZCBtYXBwaW5nIHRvIHRoZSBuYW1lZCBjb2x1bW4uCmZ1bmMgR2V0RmllbGROYW1lKGZpZWxkcyBbXVRtcGxGaWVsZCwgY29sdW1uIHN0cmluZykgc3RyaW5nIHsKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCWlmIGZsLkNvbHVtbk5hbWUgPT0gY29sdW1uIHsKCQkJcmV0dXJuIGZsLk5hbWUKCQl9Cgl9CglyZXR1cm4gIiIKfQoKLy8gR2V0U2FtcGxlVmFsdWUgcmV0dXJucyBhbiBleHByZXNzaW9uIGdlbmVyYXRpbmcgYSByYW5kb20gdmFsdWUgZml0dGluZyB0aGUgY29sdW1uIG9mIGEgZmllbGQKLy8gb2YgYSBtb2RlbCwgYW5kIHRoZSBDSEVDSyBjb25zdHJhaW50cyBjb21wYXJpbmcgaXQgd2l0aCBhIG51bWJlciwgbWFkZSBvZiB0aGUgc2FtcGxlIGZ1bmN0aW9ucwovLyBvZiB0aGUgZ2VuZXJhdGVkIGludGVncmF0aW9uIHRlc3RzLgpmdW5jIEdldFNhbXBsZVZhbHVlKG0gVG1wbFN0cnVjdCwgZmwgVG1wbEZpZWxkKSBzdHJpbmcgewoJYmFzZSwgYXJncywgdW5zaWduZWQgOj0gcGFyc2VDb2x1bW5UeXBlKGZsLkNvbHVtblR5cGUpCgl2YXIgZXhwciBzdHJpbmcKCXN3aXRjaCBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKSB7CgljYXNlICJpbnQ2NCIsICJJbnQ2NCI6CgkJbG8sIGhpIDo9IGludDY0KDEpLCBpbnRSYW5nZXNbYmFzZV0KCQlpZiBiYXNlID09ICJ5ZWFyIiB7CgkJCWxvLCBoaSA9IDE5MDEsIDIxNTUKCQl9IGVsc2UgaWYgdW5zaWduZWQgewoJCQloaSA9IGhpKjIgKyAxCgkJfQoJCWlmIGhpID09IDAgewoJCQloaSA9IDEyNwoJCX0KCQlsbywgaGkgPSBjaGVja0JvdW5kcyhtLkNoZWNrcywgZmwuQ29sdW1uTmFtZSwgbG8sIGhpKQoJCWV4cHIgPSBmbXQuU3ByaW50Zigic2FtcGxlSW50KCVkLCAlZCkiLCBsbywgaGkpCgljYXNlICJmbG9hdDY0IiwgIkZsb2F0NjQiOgoJCWRpZ2l0cywgc2NhbGUgOj0gMywgMgoJCWlmIGJhc2UgPT0gImRlY2ltYWwiIHsKCQkJaWYgcCwgcywgb2sgOj0gcGFyc2VQcmVjaXNpb24oYXJncyk7IG9rIHsKCQkJCWRpZ2l0cywgc2NhbGUgPSBwLXMsIHMKCQkJfQoJCX0KCQlleHByID0gZm10LlNwcmludGYoInNhbXBsZUZsb2F0KCVkLCAlZCkiLCBtaW5JbnQoZGlnaXRzLCA2KSwgbWluSW50KHNjYWxlLCA2KSkKCWNhc2UgImJvb2wiLCAiQm9vbCI6CgkJZXhwciA9ICJzYW1wbGVCb29sKCkiCgljYXNlICJzdHJpbmciLCAiU3RyaW5nIjoKCQlzd2l0Y2ggYmFzZSB7CgkJY2FzZSAiZW51bSIsICJzZXQiOgoJCQlleHByID0gUXVvdGVTdHJpbmcoZmlyc3RRdW90ZWQoYXJncykpCgkJY2FzZSAidGltZSI6CgkJCWV4cHIgPSAic2FtcGxlQ2xvY2soKSIKCQljYXNlICJjaGFyIiwgInZhcmNoYXIiOgoJCQluLCBfIDo9IHN0cmNvbnYuQXRvaShhcmdzKQoJCQlleHByID0gZm10LlNwcmludGYoInNhbXBsZVN0cmluZyglZCkiLCBtaW5JbnQobiwgMTYpKQoJCWRlZmF1bHQ6CgkJCWV4cHIgPSAic2FtcGxlU3RyaW5nKDE2KSIKCQl9CgljYXNlICJbXWJ5dGUiOgoJCW4sIF8gOj0gc3RyY29udi5BdG9pKGFyZ3MpCgkJc3dpdGNoIGJhc2UgewoJCWNhc2UgImJpdCI6CgkJCXJldHVybiAiW11ieXRlezF9IgoJCWNhc2UgImJpbmFyeSI6CgkJCS8vIGJpbmFyeSBjb2x1bW5zIHBhZCBzaG9ydGVyIHZhbHVlcywgc28gZmlsbCB0aGVtIHVwCgkJCXJldHVybiBmbXQuU3ByaW50ZigiW11ieXRlKHNhbXBsZVN0cmluZyglZCkpIiwgbikKCQljYXNlICJ2YXJiaW5hcnkiOgoJCQlyZXR1cm4gZm10LlNwcmludGYoIltdYnl0ZShzYW1wbGVTdHJpbmcoJWQpKSIsIG1pbkludChuLCAxNikpCgkJZGVmYXVsdDoKCQkJcmV0dXJuICJbXWJ5dGUoc2FtcGxlU3RyaW5nKDE2KSkiCgkJfQoJY2FzZSAiUmF3SlNPTiI6CgkJcmV0dXJuICJzYW1wbGVKU09OKCkiCgljYXNlICJ0aW1lLlRpbWUiLCAiVGltZSI6CgkJaWYgYmFzZSA9PSAiZGF0ZSIgewoJCQlleHByID0gInNhbXBsZURhdGUoKSIKCQl9IGVsc2UgewoJCQlleHByID0gInNhbXBsZVRpbWUoKSIKCQl9CglkZWZhdWx0OgoJCXJldHVybiBHZXROdWxsVmFsdWUoZmwpCgl9CglpZiBzdHJpbmdzLkhhc1ByZWZpeChmbC5UeXBlLCAiTnVsbCIpIHsKCQlmaWVsZCA6PSBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKQoJCXJldHVybiBmbXQuU3ByaW50ZigiJXN7JXM6ICVzLCBWYWxpZDogdHJ1ZX0iLCBmbC5UeXBlLCBmaWVsZCwgZXhwcikKCX0KCXJldHVybiBleHByCn0KCi8vIEdldE51bGxWYWx1ZSByZXR1cm5zIHRoZSBleHByZXNzaW9uIG9mIGEgTlVMTCB2YWx1ZSBmb3IgYSBmaWVsZC4KZnVuYyBHZXROdWxsVmFsdWUoZmwgVG1wbEZpZWxkKSBzdHJpbmcgewoJc3dpdGNoIGZsLlR5cGUgewoJY2FzZSAiW11ieXRlIiwgIlJhd0pTT04iOgoJCXJldHVybiAibmlsIgoJZGVmYXVsdDoKCQlyZXR1cm4gZmwuVHlwZSArICJ7fSIKCX0KfQoKLy8gR2V0VW5pcXVlTWF0Y2ggcmV0dXJucyB0aGUgY29uZGl0aW9uIHVuZGVyIHdoaWNoIHR3byByb3dzIGNsYXNoIG9uIGEgdW5pcXVlIGtleSwgYXMgTXlTUUwgc2VlcyBpdDoKLy8gZXZlcnkgY29sdW1uIGhvbGRzIHRoZSBzYW1lIHZhbHVlIGluIGJvdGgsIGFuZCBub25lIG9mIHRoZW0gaXMgTlVMTCwgTlVMTCBuZXZlciBjbGFzaGluZy4KZnVuYyBHZXRVbmlxdWVNYXRjaChrZXkgVG1wbEtleSwgcm93LCBvdGhlciBzdHJpbmcpIHN0cmluZyB7Cgl2YXIgY29uZHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBrZXkuRmllbGRzIHsKCQlhLCBiIDo9IHJvdysiLiIrZmwuTmFtZSwgb3RoZXIrIi4iK2ZsLk5hbWUKCQlzd2l0Y2ggewoJCWNhc2UgZmwuVHlwZSA9PSAiW11ieXRlIiAmJiBmbC5OdWxsYWJsZToKCQkJY29uZHMgPSBhcHBlbmQoY29uZHMsIGErIiAhPSBuaWwiLCBiKyIgIT0gbmlsIikKCQljYXNlIGZsLlR5cGUgPT0gIlJhd0pTT04iICYmIGZsLk51bGxhYmxlOgoJCQljb25kcyA9IGFwcGVuZChjb25kcywgImxlbigiK2ErIikgIT0gMCIsICJsZW4oIitiKyIpICE9IDAiKQoJCWNhc2Ugc3RyaW5ncy5IYXNQcmVmaXgoZmwuVHlwZSwgIk51bGwiKToKCQkJY29uZHMgPSBhcHBlbmQoY29uZHMsIGErIi5WYWxpZCIsIGIrIi5WYWxpZCIpCgkJfQoJCWlmIGZsLlR5cGUgPT0gIltdYnl0ZSIgfHwgZmwuVHlwZSA9PSAiUmF3SlNPTiIgewoJCQljb25kcyA9IGFwcGVuZChjb25kcywgInN0cmluZygiK2ErIikgPT0gc3RyaW5nKCIrYisiKSIpCgkJfSBlbHNlIHsKCQkJY29uZHMgPSBhcHBlbmQoY29uZHMsIGErIiA9PSAiK2IpCgkJfQoJfQoJcmV0dXJuIHN0cmluZ3MuSm9pbihjb25kcywgIiAmJiAiKQp9CgovLyBpbnRSYW5nZXMgaG9sZHMgdGhlIG1heGltdW0gdmFsdWUgb2YgdGhlIHNpZ25lZCBpbnRlZ2VyIGNvbHVtbiB0eXBlcy4gVGhhdCBvZiBiaWdpbnQgaXMgY2FwcGVkCi8vIGF0IDJeNTMsIHRoZSBsYXJnZXN0IGludGVnZXIgYSBmbG9hdDY0IGhvbGRzIGV4YWN0bHksIGFzIHRoZSBib3VuZHMgb2YgQ0hFQ0sgY29uc3RyYWludHMgYXJlIHBhcnNlZAovLyBhcyBmbG9hdDY0LCBhbmQgc28gdGhhdCBkb3VibGluZyBpdCBmb3IgdW5zaWduZWQgY29sdW1ucyBjYW5ub3Qgb3ZlcmZsb3cgYW4gaW50NjQuCnZhciBpbnRSYW5nZXMgPSBtYXBbc3RyaW5nXWludDY0ewoJInRpbnlpbnQiOiAgIDEyNywKCSJzbWFsbGludCI6ICAzMjc2NywKCSJtZWRpdW1pbnQiOiA4Mzg4NjA3LAoJImludCI6ICAgICAgIDIxNDc0ODM2NDcsCgkiYmlnaW50IjogICAgMSA8PCA1MywKfQoKLy8gcGFyc2VDb2x1bW5UeXBlIHNwbGl0cyBhIGNvbHVtbiB0eXBlLCBzdWNoIGFzICJpbnQoMTApIHVuc2lnbmVkIiwKLy8gaW50byBpdHMgYmFzZSB0eXBlLCB0aGUgYXJndW1lbnRzIGJldHdlZW4gaXRzIHBhcmVudGhlc2VzIGFuZCB3aGV0aGVyIGl0IGlzIHVuc2lnbmVkLgpmdW5jIHBhcnNlQ29sdW1uVHlwZSh0eXAgc3RyaW5nKSAoYmFzZSwgYXJncyBzdHJpbmcsIHVuc2lnbmVkIGJvb2wpIHsKCXR5cCA9IHN0cmluZ3MuVG9Mb3dlcih0eXApCgl1bnNpZ25lZCA9IHN0cmluZ3MuQ29udGFpbnModHlwLCAiIHVuc2lnbmVkIikKCWJhc2UgPSB0eXAKCWlmIGkgOj0gc3RyaW5ncy5JbmRleEFueSh0eXAsICIoICIpOyBpID49IDAgewoJCWJhc2UgPSB0eXBbOmldCgl9CglpZiBpLCBqIDo9IHN0cmluZ3MuSW5kZXgodHlwLCAiKCIpLCBzdHJpbmdzLkxhc3RJbmRleCh0eXAsICIpIik7IGkgPj0gMCAmJiBqID4gaSB7CgkJYXJncyA9IHR5cFtpKzEgOiBqXQoJfQoJcmV0dXJuIGJhc2UsIGFyZ3MsIHVuc2lnbmVkCn0KCi8vIHBhcnNlUHJlY2lzaW9uIHBhcnNlcyB0aGUgcHJlY2lzaW9uIGFuZCBzY2FsZSBhcmd1bWVudHMgb2YgYSBkZWNpbWFsIGNvbHVtbi4KZnVuYyBwYXJzZVByZWNpc2lvbihhcmdzIHN0cmluZykgKHByZWNpc2lvbiwgc2NhbGUgaW50LCBvayBib29sKSB7CglwYXJ0cyA6PSBzdHJpbmdzLlNwbGl0KGFyZ3MsICIsIikKCXByZWNpc2lvbiwgZXJyIDo9IHN0cmNvbnYuQXRvaShzdHJpbmdzLlRyaW1TcGFjZShwYXJ0c1swXSkpCglpZiBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gMCwgMCwgZmFsc2UKCX0KCWlmIGxlbihwYXJ0cykgPiAxIHsKCQlpZiBzY2FsZSwgZXJyID0gc3RyY29udi5BdG9pKHN0cmluZ3MuVHJpbVNwYWNlKHBhcnRzWzFdKSk7IGVyciAhPSBuaWwgewoJCQlyZXR1cm4gMCwgMCwgZmFsc2UKCQl9Cgl9CglyZXR1cm4gcHJlY2lzaW9uLCBzY2FsZSwgdHJ1ZQp9CgovLyBmaXJzdFF1b3RlZCByZXR1cm5zIHRoZSBmaXJzdCBzaW5nbGUgcXVvdGVkIHZhbHVlIG9mIHRoZSBhcmd1bWVudHMgb2YgYW4gZW51bSBvciBzZXQgY29sdW1uLgpmdW5jIGZpcnN0UXVvdGVkKGFyZ3Mgc3RyaW5nKSBzdHJpbmcgewoJaWYgdmFsdWVzIDo9IHF1b3RlZFZhbHVlcyhhcmdzKTsgbGVuKHZhbHVlcykgPiAwIHsKCQlyZXR1cm4gdmFsdWVzWzBdCgl9CglyZXR1cm4gIiIKfQoKLy8gcXVvdGVkVmFsdWVzIHJldHVybnMgdGhlIHNpbmdsZSBxdW90ZWQgdmFsdWVzIG9mIHRoZSBhcmd1bWVudHMgb2YgYW4gZW51bSBvciBzZXQgY29sdW1uLgpmdW5jIHF1b3RlZFZhbHVlcyhhcmdzIHN0cmluZykgW11zdHJpbmcgewoJdmFyIHZhbHVlcyBbXXN0cmluZwoJZm9yIGkgOj0gMDsgaSA8IGxlbihhcmdzKTsgaSsrIHsKCQlpZiBhcmdzW2ldICE9ICdcJycgewoJCQljb250aW51ZQoJCX0KCQl2YWx1ZSA6PSBbXWJ5dGV7fQoJCWZvciBpKys7IGkgPCBsZW4oYXJncyk7IGkrKyB7CgkJCWlmIGFyZ3NbaV0gPT0gJ1wnJyB7CgkJCQlpZiBpKzEgPCBsZW4oYXJncykgJiYgYXJnc1tpKzFdID09ICdcJycgewoJCQkJCXZhbHVlID0gYXBwZW5kKHZhbHVlLCAnXCcnKQoJCQkJCWkrKwoJCQkJCWNvbnRpbnVlCgkJCQl9CgkJCQlicmVhawoJCQl9CgkJCXZhbHVlID0gYXBwZW5kKHZhbHVlLCBhcmdzW2ldKQoJCX0KCQl2YWx1ZXMgPSBhcHBlbmQodmFsdWVzLCBzdHJpbmcodmFsdWUpKQoJfQoJcmV0dXJuIHZhbHVlcwp9CgovLyBHZXRWYWxpZGF0aW9uUnVsZXMgcmV0dXJucyB0aGUgcnVsZXMgdGhlIGZpZWxkcyBvZiBhIG1vZGVsIG11c3QgZm9sbG93IHRvIGZpdCB0aGVpciBjb2x1bW5zLAovLyBkZXJpdmVkIGZyb20gdGhlIGNvbHVtbiB0eXBlcyBhbmQgdGhlIENIRUNLIGNvbnN0cmFpbnRzIHNpbXBsZSBlbm91Z2ggdG8gZXZhbHVhdGUgaW4gR28uCi8vIFRoZSBjb2x1bW5zIHRoZSBnZW5lcmF0ZWQgbWV0aG9kcyBzZXQgdGhlbXNlbHZlcyBhcmUgbGVmdCBvdXQuCmZ1bmMgR2V0VmFsaWRhdGlvblJ1bGVzKG0gU3RydWN0VG1wbERhdGEpIFtdVG1wbFJ1bGUgewoJdmFyIHJ1bGVzIFtdVG1wbFJ1bGUKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJaWYgZmwuQXV0b0luYyB7CgkJCWNvbnRpbnVlCgkJfQoJCXN3aXRjaCBmbC5Db2x1bW5OYW1lIHsKCQljYXNlICJpZCIsICJjcmVhdGVkX2F0IiwgInVwZGF0ZWRfYXQiLCBtLlNvZnREZWxldGUsIG0uVmVyc2lvbjoKCQkJY29udGludWUKCQl9CgkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIGNvbHVtblJ1bGVzKG0uUmVjZWl2ZXIsIGZsKS4uLikKCX0KCWZvciBfLCBjaGVjayA6PSByYW5nZSBtLk1vZGVsLkNoZWNrcyB7CgkJaWYgcnVsZSwgb2sgOj0gY2hlY2tSdWxlKG0uUmVjZWl2ZXIsIG0uTW9kZWwuRmllbGRzLCBjaGVjayk7IG9rIHsKCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUpCgkJfQoJfQoJcmV0dXJuIHJ1bGVzCn0KCi8vIEdldERhdGFiYXNlQ2hlY2tzIHJldHVybnMgdGhlIENIRUNLIGNvbnN0cmFpbnRzIG9mIGEgbW9kZWwgd2hpY2ggb25seSB0aGUgZGF0YWJhc2UgY2FuIGV2YWx1YXRlLgpmdW5jIEdldERhdGFiYXNlQ2hlY2tzKG0gU3RydWN0VG1wbERhdGEpIFtdVG1wbENoZWNrIHsKCXZhciBjaGVja3MgW11UbXBsQ2hlY2sKCWZvciBfLCBjaGVjayA6PSByYW5nZSBtLk1vZGVsLkNoZWNrcyB7CgkJaWYgXywgb2sgOj0gY2hlY2tSdWxlKG0uUmVjZWl2ZXIsIG0uTW9kZWwuRmllbGRzLCBjaGVjayk7ICFvayB7CgkJCWNoZWNrcyA9IGFwcGVuZChjaGVja3MsIGNoZWNrKQoJCX0KCX0KCXJldHVybiBjaGVja3MKfQoKLy8gdGV4dFNpemVzIGhvbGRzIHRoZSBtYXhpbXVtIHNpemUgaW4gYnl0ZXMgb2YgdGhlIHRleHQgYW5kIGJsb2IgY29sdW1uIHR5cGVzLgp2YXIgdGV4dFNpemVzID0gbWFwW3N0cmluZ11pbnR7CgkidGlueXRleHQiOiAgIDI1NSwKCSJ0ZXh0IjogICAgICAgNjU1MzUsCgkibWVkaXVtdGV4dCI6IDE2Nzc3MjE1LAoJInRpbnlibG9iIjogICAyNTUsCgkiYmxvYiI6ICAgICAgIDY1NTM1LAoJIm1lZGl1bWJsb2IiOiAxNjc3NzIxNSwKfQoKLy8gY29sdW1uUnVsZXMgcmV0dXJucyB0aGUgcnVsZXMgZm9sbG93aW5nIGZyb20gdGhlIHR5cGUgb2YgdGhlIGNvbHVtbiBvZiBhIGZpZWxkLgpmdW5jIGNvbHVtblJ1bGVzKHJlY2VpdmVyIHN0cmluZywgZmwgVG1wbEZpZWxkKSBbXVRtcGxSdWxlIHsKCWJhc2UsIGFyZ3MsIHVuc2lnbmVkIDo9IHBhcnNlQ29sdW1uVHlwZShmbC5Db2x1bW5UeXBlKQoJdmFsdWUgOj0gcmVjZWl2ZXIgKyAiLiIgKyBmbC5OYW1lCglydWxlIDo9IGZ1bmMoZm9ybWF0IHN0cmluZywgYSAuLi5pbnRlcmZhY2V7fSkgZnVuYyhzdHJpbmcpIFRtcGxSdWxlIHsKCQlpbnZhbGlkIDo9IGZtdC5TcHJpbnRmKGZvcm1hdCwgYS4uLikKCQlyZXR1cm4gZnVuYyhtZXNzYWdlIHN0cmluZykgVG1wbFJ1bGUgewoJCQlyZXR1cm4gVG1wbFJ1bGV7RmllbGQ6IGZsLCBJbnZhbGlkOiBpbnZhbGlkLCBNZXNzYWdlOiBtZXNzYWdlfQoJCX0KCX0KCWlmIHN0cmluZ3MuSGFzUHJlZml4KGZsLlR5cGUsICJOdWxsIikgewoJCS8vIE5VTEwgYWx3YXlzIGZpdHMgYSBudWxsYWJsZSBjb2x1bW4sIG9ubHkgY2hlY2sgdmFsaWQgdmFsdWVzCgkJaW5uZXIgOj0gdmFsdWUgKyAiLiIgKyBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKQoJCXJ1bGVzIDo9IGNvbHVtblJ1bGVzKHJlY2VpdmVyLCBUbXBsRmllbGR7TmFtZTogZmwuTmFtZSwgVHlwZTogc3RyaW5ncy5Ub0xvd2VyKHN0cmluZ3MuVHJpbVByZWZpeChmbC5UeXBlLCAiTnVsbCIpKSwgQ29sdW1uVHlwZTogZmwuQ29sdW1uVHlwZX0pCgkJZm9yIGkgOj0gcmFuZ2UgcnVsZXMgewoJCQlydWxlc1tpXS5GaWVsZCA9IGZsCgkJCXJ1bGVzW2ldLkludmFsaWQgPSB2YWx1ZSArICIuVmFsaWQgJiYgKCIgKyBzdHJpbmdzLlJlcGxhY2UocnVsZXNbaV0uSW52YWxpZCwgdmFsdWUsIGlubmVyLCAtMSkgKyAiKSIKCQl9CgkJcmV0dXJuIHJ1bGVzCgl9CgoJdmFyIHJ1bGVzIFtdVG1wbFJ1bGUKCXN3aXRjaCBmbC5UeXBlIHsKCWNhc2UgIltdYnl0ZSIsICJSYXdKU09OIjoKCQlpZiAhZmwuTnVsbGFibGUgJiYgIWZsLkhhc0RlZmF1bHQgJiYgZmwuQ29sdW1uVHlwZSAhPSAiIiB7CgkJCS8vIGJvdGggYXJlIHdyaXR0ZW4gYXMgTlVMTCB3aGVuIGVtcHR5CgkJCWlmIGZsLlR5cGUgPT0gIlJhd0pTT04iIHsKCQkJCXJ1bGVzID0gYXBwZW5kKHJ1bGVzLCBydWxlKCJsZW4oJXMpID09IDAiLCB2YWx1ZSkoImlzIHJlcXVpcmVkIikpCgkJCX0gZWxzZSB7CgkJCQlydWxlcyA9IGFwcGVuZChydWxlcywgcnVsZSgiJXMgPT0gbmlsIiwgdmFsdWUpKCJpcyByZXF1aXJlZCIpKQoJCQl9CgkJfQoJCW4sIF8gOj0gc3RyY29udi5BdG9pKGFyZ3MpCgkJc3dpdGNoIHsKCQljYXNlIGJhc2UgPT0gImJpdCIgJiYgbiA+IDA6CgkJCXJ1bGVzID0gYXBwZW5kKHJ1bGVzLCBydWxlKCJsZW4oJXMpID4gJWQiLCB2YWx1ZSwgKG4rNykvOCkoZm10LlNwcmludGYoIm11c3QgZml0IGluIGEgYml0KCVkKSBjb2x1bW4iLCBuKSkpCgkJY2FzZSAoYmFzZSA9PSAiYmluYXJ5IiB8fCBiYXNlID09ICJ2YXJiaW5hcnkiKSAmJiBuID4gMDoKCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUoImxlbiglcykgPiAlZCIsIHZhbHVlLCBuKShmbXQuU3ByaW50ZigibXVzdCBiZSBhdCBtb3N0ICVkIGJ5dGVzIiwgbikpKQoJCWNhc2UgdGV4dFNpemVzW2Jhc2VdID4gMDoKCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUoImxlbiglcykgPiAlZCIsIHZhbHVlLCB0ZXh0U2l6ZXNbYmFzZV0pKGZtdC5TcHJpbnRmKCJtdXN0IGJlIGF0IG1vc3QgJWQgYnl0ZXMiLCB0ZXh0U2l6ZXNbYmFzZV0pKSkKCQl9CgljYXNlICJzdHJpbmciOgoJCXN3aXRjaCBiYXNlIHsKCQljYXNlICJjaGFyIiwgInZhcmNoYXIiOgoJCQlpZiBuLCBfIDo9IHN0cmNvbnYuQXRvaShhcmdzKTsgbiA+IDAgewoJCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUoImNoYXJMZW5ndGgoJXMpID4gJWQiLCB2YWx1ZSwgbikoZm10LlNwcmludGYoIm11c3QgYmUgYXQgbW9zdCAlZCBjaGFyYWN0ZXJzIiwgbikpKQoJCQl9CgkJY2FzZSAiZW51bSIsICJzZXQiOgoJCQltZW1iZXJzIDo9IHF1b3RlZFZhbHVlcyhhcmdzKQoJCQlxdW90ZWQgOj0gbWFrZShbXXN0cmluZywgbGVuKG1lbWJlcnMpKQoJCQlmb3IgaSwgbWVtYmVyIDo9IHJhbmdlIG1lbWJlcnMgewoJCQkJcXVvdGVkW2ldID0gUXVvdGVTdHJpbmcobWVtYmVyKQoJCQl9CgkJCWNoZWNrLCBtZXNzYWdlIDo9ICJvbmVPZiIsICJtdXN0IGJlIG9uZSBvZiAiCgkJCWlmIGJhc2UgPT0gInNldCIgewoJCQkJY2hlY2ssIG1lc3NhZ2UgPSAic2V0T2YiLCAibXVzdCBiZSBhIGNvbW1hIHNlcGFyYXRlZCBsaXN0IG9mICIKCQkJfQoJCQlydWxlcyA9IGFwcGVuZChydWxlcywgcnVsZSgiISVzKCVzLCAlcykiLCBjaGVjaywgdmFsdWUsIHN0cmluZ3MuSm9pbihxdW90ZWQsICIsICIpKShtZXNzYWdlK3N0cmluZ3MuSm9pbihtZW1iZXJzLCAiLCAiKSkpCgkJZGVmYXVsdDoKCQkJaWYgdGV4dFNpemVzW2Jhc2VdID4gMCB7CgkJCQlydWxlcyA9IGFwcGVuZChydWxlcywgcnVsZSgibGVuKCVzKSA+ICVkIiwgdmFsdWUsIHRleHRTaXplc1tiYXNlXSkoZm10LlNwcmludGYoIm11c3QgYmUgYXQgbW9zdCAlZCBieXRlcyIsIHRleHRTaXplc1tiYXNlXSkpKQoJCQl9CgkJfQoJY2FzZSAiaW50NjQiOgoJCWhpLCBvayA6PSBpbnRSYW5nZXNbYmFzZV0KCQlzd2l0Y2ggewoJCWNhc2UgYmFzZSA9PSAieWVhciI6CgkJCXJ1bGVzID0gYXBwZW5kKHJ1bGVzLCBydWxlKCIlcyAhPSAwICYmICglcyA8IDE5MDEgfHwgJXMgPiAyMTU1KSIsIHZhbHVlLCB2YWx1ZSwgdmFsdWUpKCJtdXN0IGJlIGJldHdlZW4gMTkwMSBhbmQgMjE1NSIpKQoJCWNhc2UgYmFzZSA9PSAiYmlnaW50IiAmJiB1bnNpZ25lZDoKCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUoIiVzIDwgMCIsIHZhbHVlKSgibXVzdCBub3QgYmUgbmVnYXRpdmUiKSkKCQljYXNlIGJhc2UgPT0gImJpZ2ludCIgfHwgIW9rOgoJCWNhc2UgdW5zaWduZWQ6CgkJCXJ1bGVzID0gYXBwZW5kKHJ1bGVzLCBydWxlKCIlcyA8IDAgfHwgJXMgPiAlZCIsIHZhbHVlLCB2YWx1ZSwgaGkqMisxKShmbXQuU3ByaW50ZigibXVzdCBiZSBiZXR3ZWVuIDAgYW5kICVkIiwgaGkqMisxKSkpCgkJZGVmYXVsdDoKCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUoIiVzIDwgJWQgfHwgJXMgPiAlZCIsIHZhbHVlLCAtaGktMSwgdmFsdWUsIGhpKShmbXQuU3ByaW50ZigibXVzdCBiZSBiZXR3ZWVuICVkIGFuZCAlZCIsIC1oaS0xLCBoaSkpKQoJCX0KCWNhc2UgImZsb2F0NjQiOgoJCWlmIGJhc2UgPT0gImRlY2ltYWwiIHsKCQkJaWYgcCwgcywgb2sgOj0gcGFyc2VQcmVjaXNpb24oYXJncyk7IG9rIHsKCQkJCXJ1bGVzID0gYXBwZW5kKHJ1bGVzLCBydWxlKCJleGNlZWRzRGlnaXRzKCVzLCAlZCkiLCB2YWx1ZSwgcC1zKShmbXQuU3ByaW50ZigibXVzdCBoYXZlIGF0IG1vc3QgJWQgZGlnaXRzIGJlZm9yZSB0aGUgZGVjaW1hbCBwb2ludCIsIHAtcykpKQoJCQl9CgkJfQoJCWlmIHVuc2lnbmVkIHsKCQkJcnVsZXMgPSBhcHBlbmQocnVsZXMsIHJ1bGUoIiVzIDwgMCIsIHZhbHVlKSgibXVzdCBub3QgYmUgbmVnYXRpdmUiKSkKCQl9Cgl9CglyZXR1cm4gcnVsZXMKfQoKLy8gcGFyc2VDaGVjayBzcGxpdHMgYSBDSEVDSyBjb25zdHJhaW50IGNvbXBhcmluZyBhIGNvbHVtbiwgb3IgdGhlIGNoYXJhY3RlciBsZW5ndGggb2Ygb25lLAovLyB3aXRoIGEgbnVtYmVyIGludG8gdGhlIGNvbHVtbiwgdGhlIEdvIGNvbXBhcmlzb24gb3BlcmF0b3IgYW5kIHRoZSBudW1iZXIuCmZ1bmMgcGFyc2VDaGVjayhjaGVjayBUbXBsQ2hlY2spIChjb2x1bW4gc3RyaW5nLCBsZW5ndGggYm9vbCwgb3AsIG51bWJlciBzdHJpbmcsIG9rIGJvb2wpIHsKCWNsYXVzZSA6PSBzdHJpbmdzLlRyaW1TcGFjZShjaGVjay5DbGF1c2UpCglmb3IgZW5jbG9zZWQoY2xhdXNlKSB7CgkJY2xhdXNlID0gc3RyaW5ncy5UcmltU3BhY2UoY2xhdXNlWzEgOiBsZW4oY2xhdXNlKS0xXSkKCX0KCXBhcnRzIDo9IHN0cmluZ3MuRmllbGRzKGNsYXVzZSkKCWlmIGxlbihwYXJ0cykgIT0gMyB7CgkJcmV0dXJuICIiLCBmYWxzZSwgIiIsICIiLCBmYWxzZQoJfQoJb3BlcmFuZCwgb3AsIG51bWJlciA6PSBwYXJ0c1swXSwgY2hlY2tPcGVyYXRvcnNbcGFydHNbMV1dLCBwYXJ0c1syXQoJaWYgb3AgPT0gIiIgewoJCXJldHVybiAiIiwgZmFsc2UsICIiLCAiIiwgZmFsc2UKCX0KCWlmICFjaGVja051bWJlci5NYXRjaFN0cmluZyhudW1iZXIpIHsKCQlyZXR1cm4gIiIsIGZhbHNlLCAiIiwgIiIsIGZhbHNlCgl9CgoJbGVuZ3RoID0gc3RyaW5ncy5IYXNQcmVmaXgob3BlcmFuZCwgImNoYXJfbGVuZ3RoKCIpICYmIHN0cmluZ3MuSGFzU3VmZml4KG9wZXJhbmQsICIpIikKCWlmIGxlbmd0aCB7CgkJb3BlcmFuZCA9IG9wZXJhbmRbbGVuKCJjaGFyX2xlbmd0aCgiKSA6IGxlbihvcGVyYW5kKS0xXQoJfQoJaWYgbGVuKG9wZXJhbmQpIDwgMiB8fCBvcGVyYW5kWzBdICE9ICdgJyB8fCBvcGVyYW5kW2xlbihvcGVyYW5kKS0xXSAhPSAnYCcgewoJCXJldHVybiAiIiwgZmFsc2UsICIiLCAiIiwgZmFsc2UKCX0KCXJldHVybiBzdHJpbmdzLlRvTG93ZXIob3BlcmFuZFsxIDogbGVuKG9wZXJhbmQpLTFdKSwgbGVuZ3RoLCBvcCwgbnVtYmVyLCB0cnVlCn0KCi8vIGNoZWNrTnVtYmVyIG1hdGNoZXMgdGhlIGRlY2ltYWwgbnVtYmVycyBhIENIRUNLIGNvbnN0cmFpbnQgY2FuIGNvbXBhcmUgYSBjb2x1bW4gd2l0aCwKLy8gd2hpY2ggYXJlIHZhbGlkIEdvIGxpdGVyYWxzIGFzIHdlbGwuCnZhciBjaGVja051bWJlciA9IHJlZ2V4cC5NdXN0Q29tcGlsZShgXi0/KFxkKyhcLlxkKik/fFwuXGQrKShbZUVdWy0rXT9cZCspPyRgKQoKLy8gY2hlY2tPcGVyYXRvcnMgbWFwcyB0aGUgY29tcGFyaXNvbiBvcGVyYXRvcnMgb2YgU1FMIHRvIHRob3NlIG9mIEdvLgp2YXIgY2hlY2tPcGVyYXRvcnMgPSBtYXBbc3RyaW5nXXN0cmluZ3sKCSI9IjogIj09IiwgIjw+IjogIiE9IiwgIiE9IjogIiE9IiwgIjwiOiAiPCIsICI8PSI6ICI8PSIsICI+IjogIj4iLCAiPj0iOiAiPj0iLAp9CgovLyBjaGVja1J1bGUgdHJhbnNsYXRlcyBhIENIRUNLIGNvbnN0cmFpbnQgY29tcGFyaW5nIGEgbnVtZXJpYyBjb2x1bW4sIG9yIHRoZSBjaGFyYWN0ZXIgbGVuZ3RoCi8vIG9mIGEgc3RyaW5nIGNvbHVtbiwgd2l0aCBhIG51bWJlciwgc3VjaCBhcyAiKGBwcmljZWAgPiAwKSIgb3IgIihjaGFyX2xlbmd0aChgbmFtZWApID49IDIpIi4KLy8gSXQgcmVwb3J0cyBmYWxzZSBmb3IgYW55IG90aGVyIGNvbnN0cmFpbnQuCmZ1bmMgY2hlY2tSdWxlKHJlY2VpdmVyIHN0cmluZywgZmllbGRzIFtdVG1wbEZpZWxkLCBjaGVjayBUbXBsQ2hlY2spIChUbXBsUnVsZSwgYm9vbCkgewoJY29sdW1uLCBsZW5ndGgsIG9wLCBudW1iZXIsIG9rIDo9IHBhcnNlQ2hlY2soY2hlY2spCglpZiAhb2sgewoJCXJldHVybiBUbXBsUnVsZXt9LCBmYWxzZQoJfQoKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCWlmIGZsLkNvbHVtbk5hbWUgIT0gY29sdW1uIHsKCQkJY29udGludWUKCQl9CgkJdmFsdWUgOj0gcmVjZWl2ZXIgKyAiLiIgKyBmbC5OYW1lCgkJZ3VhcmQgOj0gIiIKCQlpZiBzdHJpbmdzLkhhc1ByZWZpeChmbC5UeXBlLCAiTnVsbCIpIHsKCQkJLy8gYSBDSEVDSyBjb25zdHJhaW50IGlzIG1ldCBieSBOVUxMCgkJCWd1YXJkID0gdmFsdWUgKyAiLlZhbGlkICYmICIKCQkJdmFsdWUgKz0gIi4iICsgc3RyaW5ncy5UcmltUHJlZml4KGZsLlR5cGUsICJOdWxsIikKCQl9CgkJaW50ZWdlciA6PSBsZW5ndGgKCQlzd2l0Y2ggdHlwIDo9IHN0cmluZ3MuVHJpbVByZWZpeChmbC5UeXBlLCAiTnVsbCIpOyB7CgkJY2FzZSBsZW5ndGggJiYgKHR5cCA9PSAic3RyaW5nIiB8fCB0eXAgPT0gIlN0cmluZyIpOgoJCQl2YWx1ZSA9ICJjaGFyTGVuZ3RoKCIgKyB2YWx1ZSArICIpIgoJCWNhc2UgbGVuZ3RoIHx8ICh0eXAgIT0gImludDY0IiAmJiB0eXAgIT0gIkludDY0IiAmJiB0eXAgIT0gImZsb2F0NjQiICYmIHR5cCAhPSAiRmxvYXQ2NCIpOgoJCQlyZXR1cm4gVG1wbFJ1bGV7fSwgZmFsc2UKCQljYXNlIHR5cCA9PSAiaW50NjQiIHx8IHR5cCA9PSAiSW50NjQiOgoJCQlpbnRlZ2VyID0gdHJ1ZQoJCX0KCQlpZiBfLCBlcnIgOj0gc3RyY29udi5QYXJzZUludChudW1iZXIsIDEwLCA2NCk7IGludGVnZXIgJiYgZXJyICE9IG5pbCB7CgkJCS8vIGNvbXBhcmVkIGFzIHRoZSBkYXRhYmFzZSBkb2VzLCByYXRoZXIgdGhhbiB3aXRoIGEgbGl0ZXJhbCBHbyBjYW5ub3QgY29udmVydAoJCQl2YWx1ZSA9ICJmbG9hdDY0KCIgKyB2YWx1ZSArICIpIgoJCX0KCQlyZXR1cm4gVG1wbFJ1bGV7CgkJCUZpZWxkOiAgIGZsLAoJCQlJbnZhbGlkOiBmbXQuU3ByaW50ZigiJXMhKCVzICVzICVzKSIsIGd1YXJkLCB2YWx1ZSwgb3AsIG51bWJlciksCgkJCU1lc3NhZ2U6IGZtdC5TcHJpbnRmKCJtdXN0IHNhdGlzZnkgdGhlICVzIGNoZWNrOiAlcyIsIGNoZWNrLk5hbWUsIENvbW1lbnRUZXh0KGNoZWNrLkNsYXVzZSkpLAoJCX0sIHRydWUKCX0KCXJldHVybiBUbXBsUnVsZXt9LCBmYWxzZQp9CgovLyBlbmNsb3NlZCByZXBvcnRzIHdoZXRoZXIgcyBpcyB3cmFwcGVkIGluIGEgcGFpciBvZiBtYXRjaGluZyBwYXJlbnRoZXNlcy4KZnVuYyBlbmNsb3NlZChzIHN0cmluZykgYm9vbCB7CglpZiAhc3RyaW5ncy5IYXNQcmVmaXgocywgIigiKSB7CgkJcmV0dXJuIGZhbHNlCgl9CglkZXB0aCA6PSAwCglmb3IgaSwgciA6PSByYW5nZSBzIHsKCQlzd2l0Y2ggciB7CgkJY2FzZSAnKCc6CgkJCWRlcHRoKysKCQljYXNlICcpJzoKCQkJZGVwdGgtLQoJCQlpZiBkZXB0aCA9PSAwIHsKCQkJCXJldHVybiBpID09IGxlbihzKS0xCgkJCX0KCQl9Cgl9CglyZXR1cm4gZmFsc2UKfQoKZnVuYyBtaW5JbnQoYSwgYiBpbnQpIGludCB7CglpZiBhIDwgYiB7CgkJcmV0dXJuIGEKCX0KCXJldHVybiBiCn0KCmZ1bmMgR2V0SW5zZXJ0RmllbGRzKGZpZWxkcyBbXVRtcGxGaWVsZCkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIGZpZWxkcyB7CgkJaWYgZmwuQ29sdW1uTmFtZSA9PSAiaWQiIHsKCQkJY29udGludWUKCQl9CgkJcGFydHMgPSBhcHBlbmQocGFydHMsIFF1b3RlSWRlbnQoZmwuQ29sdW1uTmFtZSkpCgl9CglyZXR1cm4gc3RyaW5ncy5Kb2luKHBhcnRzLCAiLCAiKQp9CgpmdW5jIEdldEluc2VydFZhbHVlcyhmaWVsZHMgW11UbXBsRmllbGQpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCXN3aXRjaCBmbC5Db2x1bW5OYW1lIHsKCQljYXNlICJpZCI6CgkJCWNvbnRpbnVlCgkJY2FzZSAiY3JlYXRlZF9hdCI6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCAiTk9XKCkiKQoJCQljb250aW51ZQoJCWRlZmF1bHQ6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCAiPyIpCgkJfQoJfQoJcmV0dXJuIHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikKfQoKZnVuYyBHZXRJbnNlcnRBcmdzKG0gU3RydWN0VG1wbERhdGEpIHN0cmluZyB7CglpZiBsaXN0IDo9IEdldEluc2VydEFyZ0xpc3QobSk7IGxpc3QgIT0gIiIgewoJCXJldHVybiAiLCAiICsgbGlzdAoJfQoJcmV0dXJuICIiCn0KCmZ1bmMgR2V0SW5zZXJ0QXJnTGlzdChtIFN0cnVjdFRtcGxEYXRhKSBzdHJpbmcgewoJdmFyIHBhcnRzIFtdc3RyaW5nCglmb3IgXywgZmwgOj0gcmFuZ2UgbS5Nb2RlbC5GaWVsZHMgewoJCXN3aXRjaCBmbC5OYW1lIHsKCQljYXNlICJJRCIsICJDcmVhdGVkQXQiOgoJCQljb250aW51ZQoJCX0KCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgZm10LlNwcmludGYoIiVzLiVzIiwgbS5SZWNlaXZlciwgZmwuTmFtZSkpCgl9CglyZXR1cm4gc3RyaW5ncy5Kb2luKHBhcnRzLCAiLCAiKQp9CgpmdW5jIEdldFNlbGVjdEZpZWxkcyhmaWVsZHMgW11UbXBsRmllbGQpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBRdW90ZUlkZW50KGZsLkNvbHVtbk5hbWUpKQoJfQoJcmV0dXJuIHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikKfQoKZnVuYyBHZXRTY2FuRmllbGRzKG0gU3RydWN0VG1wbERhdGEpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJcGFydHMgPSBhcHBlbmQocGFydHMsIGZtdC5TcHJpbnRmKCImJXMuJXMiLCBtLlJlY2VpdmVyLCBmbC5OYW1lKSkKCX0KCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpCn0KCi8vIEdldFVwZGF0ZUZpZWxkcyByZXR1cm5zIHRoZSBmaWVsZHMgYW4gdXBkYXRlIHdyaXRlcyB0aGUgdmFsdWUgb2YsCi8vIGxlYXZpbmcgb3V0IHRoZSBvbmVzIHNldCBieSB0aGUgZGF0YWJhc2Ugb3IgbWFuYWdlZCBieSB0aGUgZ2VuZXJhdGVkIG1ldGhvZHMuCmZ1bmMgR2V0VXBkYXRlRmllbGRzKG0gU3RydWN0VG1wbERhdGEpIFtdVG1wbEZpZWxkIHsKCXZhciBmaWVsZHMgW11UbXBsRmllbGQKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJc3dpdGNoIGZsLk5hbWUgewoJCWNhc2UgIklEIiwgIkNyZWF0ZWRBdCIsICJVcGRhdGVkQXQiOgoJCQljb250aW51ZQoJCX0KCQlpZiBmbC5Db2x1bW5OYW1lID09IG0uU29mdERlbGV0ZSB8fCBmbC5Db2x1bW5OYW1lID09IG0uVmVyc2lvbiB7CgkJCWNvbnRpbnVlCgkJfQoJCWZpZWxkcyA9IGFwcGVuZChmaWVsZHMsIGZsKQoJfQoJcmV0dXJuIGZpZWxkcwp9CgpmdW5jIEdldFVwZGF0ZUFyZ3MobSBTdHJ1Y3RUbXBsRGF0YSkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIEdldFVwZGF0ZUZpZWxkcyhtKSB7CgkJcGFydHMgPSBhcHBlbmQocGFydHMsIGZtdC5TcHJpbnRmKCIlcy4lcyIsIG0uUmVjZWl2ZXIsIGZsLk5hbWUpKQoJfQoJaWYgbGVuKHBhcnRzKSA+IDAgewoJCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpICsgIiwgIgoJfQoJcmV0dXJuICIiCn0KCmZ1bmMgR2V0VXBkYXRlVmFsdWVzKG0gU3RydWN0VG1wbERhdGEpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBtLk1vZGVsLkZpZWxkcyB7CgkJaWYgZmwuQ29sdW1uTmFtZSA9PSBtLlNvZnREZWxldGUgewoJCQljb250aW51ZQoJCX0KCQlpZiBmbC5Db2x1bW5OYW1lID09IG0uVmVyc2lvbiB7CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiJVsxXXM9JVsxXXMrMSIsIFF1b3RlSWRlbnQoZmwuQ29sdW1uTmFtZSkpKQoJCQljb250aW51ZQoJCX0KCQlzd2l0Y2ggZmwuTmFtZSB7CgkJY2FzZSAiSUQiLCAiQ3JlYXRlZEF0IjoKCQkJY29udGludWUKCQljYXNlICJVcGRhdGVkQXQiOgoJCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgZm10LlNwcmludGYoIiVzPVVUQ19USU1FU1RBTVAoKSIsIFF1b3RlSWRlbnQoZmwuQ29sdW1uTmFtZSkpKQoJCWRlZmF1bHQ6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiJXM9PyIsIFF1b3RlSWRlbnQoZmwuQ29sdW1uTmFtZSkpKQoJCX0KCX0KCXJldHVybiBzdHJpbmdzLkpvaW4ocGFydHMsICIsICIpCn0KCmZ1bmMgR2V0VXBzZXJ0RmllbGRzKGZpZWxkcyBbXVRtcGxGaWVsZCkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIGZpZWxkcyB7CgkJcGFydHMgPSBhcHBlbmQocGFydHMsIFF1b3RlSWRlbnQoZmwuQ29sdW1uTmFtZSkpCgl9CglyZXR1cm4gc3RyaW5ncy5Kb2luKHBhcnRzLCAiLCAiKQp9CgpmdW5jIEdldFVwc2VydFZhbHVlcyhmaWVsZHMgW11UbXBsRmllbGQpIHN0cmluZyB7Cgl2YXIgcGFydHMgW11zdHJpbmcKCWZvciBfLCBmbCA6PSByYW5nZSBmaWVsZHMgewoJCXN3aXRjaCBmbC5Db2x1bW5OYW1lIHsKCQljYXNlICJjcmVhdGVkX2F0IjoKCQkJcGFydHMgPSBhcHBlbmQocGFydHMsICJOT1coKSIpCgkJCWNvbnRpbnVlCgkJZGVmYXVsdDoKCQkJcGFydHMgPSBhcHBlbmQocGFydHMsICI/IikKCQl9Cgl9CglyZXR1cm4gc3RyaW5ncy5Kb2luKHBhcnRzLCAiLCAiKQp9CgovLyBHZXRVcHNlcnRPbkR1cGxpY2F0ZSByZXR1cm5zIHRoZSBhc3NpZ25tZW50cyBvZiBhbiBPTiBEVVBMSUNBVEUgS0VZIFVQREFURSBjbGF1c2UuCi8vIFdpdGggYSB2ZXJzaW9uIGNvbHVtbiwgZXZlcnkgYXNzaWdubWVudCBvbmx5IGFwcGxpZXMgd2hlbiB0aGUgdmVyc2lvbiBvZiB0aGUKLy8gZXhpc3Rpbmcgcm93IG1hdGNoZXMgdGhlIGluc2VydGVkIG9uZSwgYW5kIHRoZSB2ZXJzaW9uIGlzIGFzc2lnbmVkIGxhc3Q6Ci8vIE15U1FMIGV2YWx1YXRlcyB0aGUgYXNzaWdubWVudHMgaW4gb3JkZXIsIHNvIHRoZSBlYXJsaWVyIG9uZXMgc3RpbGwgc2VlIHRoZSBvbGQgdmVyc2lvbi4KZnVuYyBHZXRVcHNlcnRPbkR1cGxpY2F0ZShtIFN0cnVjdFRtcGxEYXRhKSBzdHJpbmcgewoJZ3VhcmQgOj0gZnVuYyhjb2wsIGV4cHIgc3RyaW5nKSBzdHJpbmcgewoJCWlmIG0uVmVyc2lvbiA9PSAiIiB7CgkJCXJldHVybiBmbXQuU3ByaW50ZigiJXM9JXMiLCBjb2wsIGV4cHIpCgkJfQoJCXJldHVybiBmbXQuU3ByaW50ZigiJVsxXXM9SUYoJVsyXXM9VkFMVUVTKCVbMl1zKSwgJVszXXMsICVbMV1zKSIsIGNvbCwgUXVvdGVJZGVudChtLlZlcnNpb24pLCBleHByKQoJfQoJdmFyIHBhcnRzIFtdc3RyaW5nCglmb3IgXywgZmwgOj0gcmFuZ2UgbS5Nb2RlbC5GaWVsZHMgewoJCWNvbCA6PSBRdW90ZUlkZW50KGZsLkNvbHVtbk5hbWUpCgkJaWYgZmwuQ29sdW1uTmFtZSA9PSBtLlZlcnNpb24gewoJCQljb250aW51ZQoJCX0KCQlzd2l0Y2ggZmwuTmFtZSB7CgkJY2FzZSAiQ3JlYXRlZEF0IjoKCQkJY29udGludWUKCQljYXNlICJJRCI6CgkJCXBhcnRzID0gYXBwZW5kKHBhcnRzLCBmbXQuU3ByaW50ZigiJVsxXXM9TEFTVF9JTlNFUlRfSUQoJVsxXXMpIiwgY29sKSkKCQljYXNlICJVcGRhdGVkQXQiOgoJCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgZ3VhcmQoY29sLCAiVVRDX1RJTUVTVEFNUCgpIikpCgkJZGVmYXVsdDoKCQkJcGFydHMgPSBhcHBlbmQocGFydHMsIGd1YXJkKGNvbCwgZm10LlNwcmludGYoIlZBTFVFUyglcykiLCBjb2wpKSkKCQl9Cgl9CglpZiBtLlZlcnNpb24gIT0gIiIgewoJCWNvbCA6PSBRdW90ZUlkZW50KG0uVmVyc2lvbikKCQlwYXJ0cyA9IGFwcGVuZChwYXJ0cywgZ3VhcmQoY29sLCBjb2wrIisxIikpCgl9CglyZXR1cm4gc3RyaW5ncy5Kb2luKHBhcnRzLCAiLCAiKQp9CgpmdW5jIEdldFVwc2VydEFyZ3MobSBTdHJ1Y3RUbXBsRGF0YSkgc3RyaW5nIHsKCXZhciBwYXJ0cyBbXXN0cmluZwoJZm9yIF8sIGZsIDo9IHJhbmdlIG0uTW9kZWwuRmllbGRzIHsKCQlzd2l0Y2ggZmwuTmFtZSB7CgkJY2FzZSAiQ3JlYXRlZEF0IjoKCQkJY29udGludWUKCQl9CgkJcGFydHMgPSBhcHBlbmQocGFydHMsIGZtdC5TcHJpbnRmKCIlcy4lcyIsIG0uUmVjZWl2ZXIsIGZsLk5hbWUpKQoJfQoJcmV0dXJuIHN0cmluZ3MuSm9pbihwYXJ0cywgIiwgIikKfQo=\"")
+	packr.PackJSONBytes("./tmpl", "tmpl_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJInJlZmxlY3QiCgkidGVzdGluZyIKKQoKZnVuYyBUZXN0UXVvdGVJZGVudCh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lIHN0cmluZwoJCWluICAgc3RyaW5nCgkJd2FudCBzdHJpbmcKCX17CgkJewoJCQluYW1lOiAicGxhaW4iLAoJCQlpbjogICAidXNlciIsCgkJCXdhbnQ6ICJgdXNlcmAiLAoJCX0sCgkJewoJCQluYW1lOiAicmVzZXJ2ZWQgd29yZCIsCgkJCWluOiAgICJvcmRlciIsCgkJCXdhbnQ6ICJgb3JkZXJgIiwKCQl9LAoJCXsKCQkJbmFtZTogImVtYmVkZGVkIGJhY2t0aWNrIiwKCQkJaW46ICAgIndlYGlyZCIsCgkJCXdhbnQ6ICJgd2VgYGlyZGAiLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWlmIGdvdCA6PSBRdW90ZUlkZW50KHR0LmluKTsgZ290ICE9IHR0LndhbnQgewoJCQkJdC5FcnJvcmYoIlF1b3RlSWRlbnQoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0R2V0RmllbGRDb21tZW50KHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgIHN0cmluZwoJCWZpZWxkIFRtcGxGaWVsZAoJCXdhbnQgIHN0cmluZwoJfXsKCQl7CgkJCW5hbWU6ICAibm8gY29tbWVudCBvciBkZWZhdWx0IiwKCQkJZmllbGQ6IFRtcGxGaWVsZHt9LAoJCQl3YW50OiAgIiIsCgkJfSwKCQl7CgkJCW5hbWU6ICAibXVsdGlsaW5lIGNvbW1lbnQiLAoJCQlmaWVsZDogVG1wbEZpZWxke0NvbW1lbnQ6ICJmaXJzdCA8bGluZT5cbnNlY29uZCAmIFwidGhpcmRcIiJ9LAoJCQl3YW50OiAgYC8vIGZpcnN0IDxsaW5lPiBzZWNvbmQgJiAidGhpcmQiYCwKCQl9LAoJCXsKCQkJbmFtZTogICJkZWZhdWx0IHdpdGggcXVvdGVzIiwKCQkJZmllbGQ6IFRtcGxGaWVsZHtEZWZhdWx0OiBgc2F5ICJoaSJgLCBIYXNEZWZhdWx0OiB0cnVlfSwKCQkJd2FudDogIGAvLyBkZWZhdWx0OiAic2F5IFwiaGlcIiJgLAoJCX0sCgkJewoJCQluYW1lOiAgImVtcHR5IGRlZmF1bHQiLAoJCQlmaWVsZDogVG1wbEZpZWxke0NvbW1lbnQ6ICJmbGFnIiwgSGFzRGVmYXVsdDogdHJ1ZX0sCgkJCXdhbnQ6ICBgLy8gZmxhZyBkZWZhdWx0OiAiImAsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZ290IDo9IEdldEZpZWxkQ29tbWVudCh0dC5maWVsZCk7IGdvdCAhPSB0dC53YW50IHsKCQkJCXQuRXJyb3JmKCJHZXRGaWVsZENvbW1lbnQoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0R2V0VXBzZXJ0T25EdXBsaWNhdGUodCAqdGVzdGluZy5UKSB7CglmaWVsZHMgOj0gW11UbXBsRmllbGR7CgkJe05hbWU6ICJJRCIsIENvbHVtbk5hbWU6ICJpZCJ9LAoJCXtOYW1lOiAiVmVyc2lvbiIsIENvbHVtbk5hbWU6ICJ2ZXJzaW9uIn0sCgkJe05hbWU6ICJOYW1lIiwgQ29sdW1uTmFtZTogIm5hbWUifSwKCQl7TmFtZTogIkNyZWF0ZWRBdCIsIENvbHVtbk5hbWU6ICJjcmVhdGVkX2F0In0sCgkJe05hbWU6ICJVcGRhdGVkQXQiLCBDb2x1bW5OYW1lOiAidXBkYXRlZF9hdCJ9LAoJfQoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJdmVyc2lvbiBzdHJpbmcKCQl3YW50ICAgIHN0cmluZwoJfXsKCQl7CgkJCW5hbWU6ICJ3aXRob3V0IHZlcnNpb24iLAoJCQl3YW50OiAiYGlkYD1MQVNUX0lOU0VSVF9JRChgaWRgKSwgYHZlcnNpb25gPVZBTFVFUyhgdmVyc2lvbmApLCBgbmFtZWA9VkFMVUVTKGBuYW1lYCksIGB1cGRhdGVkX2F0YD1VVENfVElNRVNUQU1QKCkiLAoJCX0sCgkJewoJCQluYW1lOiAgICAid2l0aCB2ZXJzaW9uIiwKCQkJdmVyc2lvbjogInZlcnNpb24iLAoJCQl3YW50OiAiYGlkYD1MQVNUX0lOU0VSVF9JRChgaWRgKSwgIiArCgkJCQkiYG5hbWVgPUlGKGB2ZXJzaW9uYD1WQUxVRVMoYHZlcnNpb25gKSwgVkFMVUVTKGBuYW1lYCksIGBuYW1lYCksICIgKwoJCQkJImB1cGRhdGVkX2F0YD1JRihgdmVyc2lvbmA9VkFMVUVTKGB2ZXJzaW9uYCksIFVUQ19USU1FU1RBTVAoKSwgYHVwZGF0ZWRfYXRgKSwgIiArCgkJCQkiYHZlcnNpb25gPUlGKGB2ZXJzaW9uYD1WQUxVRVMoYHZlcnNpb25gKSwgYHZlcnNpb25gKzEsIGB2ZXJzaW9uYCkiLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCW0gOj0gU3RydWN0VG1wbERhdGF7TW9kZWw6IFRtcGxTdHJ1Y3R7RmllbGRzOiBmaWVsZHN9LCBWZXJzaW9uOiB0dC52ZXJzaW9ufQoJCQlpZiBnb3QgOj0gR2V0VXBzZXJ0T25EdXBsaWNhdGUobSk7IGdvdCAhPSB0dC53YW50IHsKCQkJCXQuRXJyb3JmKCJHZXRVcHNlcnRPbkR1cGxpY2F0ZSgpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3RHZXRVcGRhdGVGaWVsZHModCAqdGVzdGluZy5UKSB7CgltIDo9IFN0cnVjdFRtcGxEYXRhewoJCU1vZGVsOiBUbXBsU3RydWN0e0ZpZWxkczogW11UbXBsRmllbGR7CgkJCXtOYW1lOiAiSUQiLCBDb2x1bW5OYW1lOiAiaWQifSwKCQkJe05hbWU6ICJOYW1lIiwgQ29sdW1uTmFtZTogIm5hbWUifSwKCQkJe05hbWU6ICJDcmVhdGVkQXQiLCBDb2x1bW5OYW1lOiAiY3JlYXRlZF9hdCJ9LAoJCQl7TmFtZTogIlVwZGF0ZWRBdCIsIENvbHVtbk5hbWU6ICJ1cGRhdGVkX2F0In0sCgkJCXtOYW1lOiAiRGVsZXRlZEF0IiwgQ29sdW1uTmFtZTogImRlbGV0ZWRfYXQifSwKCQkJe05hbWU6ICJWZXJzaW9uIiwgQ29sdW1uTmFtZTogInZlcnNpb24ifSwKCQkJe05hbWU6ICJFbWFpbCIsIENvbHVtbk5hbWU6ICJlbWFpbCJ9LAoJCX19LAoJCVNvZnREZWxldGU6ICJkZWxldGVkX2F0IiwKCQlWZXJzaW9uOiAgICAidmVyc2lvbiIsCgl9Cgl2YXIgZ290IFtdc3RyaW5nCglmb3IgXywgZmwgOj0gcmFuZ2UgR2V0VXBkYXRlRmllbGRzKG0pIHsKCQlnb3QgPSBhcHBlbmQoZ290LCBmbC5Db2x1bW5OYW1lKQoJfQoJaWYgd2FudCA6PSBbXXN0cmluZ3sibmFtZSIsICJlbWFpbCJ9OyAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB3YW50KSB7CgkJdC5FcnJvcmYoIkdldFVwZGF0ZUZpZWxkcygpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHdhbnQpCgl9Cn0KCmZ1bmMgVGVzdEdldFVuaXF1ZU1hdGNoKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgc3RyaW5nCgkJa2V5ICBUbXBsS2V5CgkJd2FudCBzdHJpbmcKCX17CgkJewoJCQluYW1lOiAibm9uIG51bGxhYmxlIGNvbHVtbnMiLAoJCQlrZXk6IFRtcGxLZXl7RmllbGRzOiBbXVRtcGxGaWVsZHsKCQkJCXtOYW1lOiAiVGVuYW50SUQiLCBUeXBlOiAiaW50NjQifSwKCQkJCXtOYW1lOiAiSGFzaCIsIFR5cGU6ICJbXWJ5dGUifSwKCQkJfX0sCgkJCXdhbnQ6ICJvdGhlci5UZW5hbnRJRCA9PSB1LlRlbmFudElEICYmIHN0cmluZyhvdGhlci5IYXNoKSA9PSBzdHJpbmcodS5IYXNoKSIsCgkJfSwKCQl7CgkJCW5hbWU6ICJudWxsYWJsZSBjb2x1bW5zIiwKCQkJa2V5OiBUbXBsS2V5e0ZpZWxkczogW11UbXBsRmllbGR7CgkJCQl7TmFtZTogIkVtYWlsIiwgVHlwZTogIk51bGxTdHJpbmciLCBOdWxsYWJsZTogdHJ1ZX0sCgkJCQl7TmFtZTogIlRva2VuIiwgVHlwZTogIltdYnl0ZSIsIE51bGxhYmxlOiB0cnVlfSwKCQkJCXtOYW1lOiAiTWV0YSIsIFR5cGU6ICJSYXdKU09OIiwgTnVsbGFibGU6IHRydWV9LAoJCQl9fSwKCQkJd2FudDogIm90aGVyLkVtYWlsLlZhbGlkICYmIHUuRW1haWwuVmFsaWQgJiYgb3RoZXIuRW1haWwgPT0gdS5FbWFpbCAmJiAiICsKCQkJCSJvdGhlci5Ub2tlbiAhPSBuaWwgJiYgdS5Ub2tlbiAhPSBuaWwgJiYgc3RyaW5nKG90aGVyLlRva2VuKSA9PSBzdHJpbmcodS5Ub2tlbikgJiYgIiArCgkJCQkibGVuKG90aGVyLk1ldGEpICE9IDAgJiYgbGVuKHUuTWV0YSkgIT0gMCAmJiBzdHJpbmcob3RoZXIuTWV0YSkgPT0gc3RyaW5nKHUuTWV0YSkiLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWlmIGdvdCA6PSBHZXRVbmlxdWVNYXRjaCh0dC5rZXksICJvdGhlciIsICJ1Iik7IGdvdCAhPSB0dC53YW50IHsKCQkJCXQuRXJyb3JmKCJHZXRVbmlxdWVNYXRjaCgpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3RHZXRTYW1wbGVWYWx1ZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQl0eXAsIGNvbHVtblR5cGUsIHdhbnQgc3RyaW5nCgl9ewoJCXsiaW50NjQiLCAiaW50KDExKSIsICJzYW1wbGVJbnQoMSwgMjE0NzQ4MzY0NykifSwKCQl7ImludDY0IiwgInRpbnlpbnQoMykgdW5zaWduZWQiLCAic2FtcGxlSW50KDEsIDI1NSkifSwKCQl7Ik51bGxJbnQ2NCIsICJ5ZWFyKDQpIiwgIk51bGxJbnQ2NHtJbnQ2NDogc2FtcGxlSW50KDE5MDEsIDIxNTUpLCBWYWxpZDogdHJ1ZX0ifSwKCQl7ImZsb2F0NjQiLCAiZGVjaW1hbCgxMCwyKSIsICJzYW1wbGVGbG9hdCg2LCAyKSJ9LAoJCXsiYm9vbCIsICJ0aW55aW50KDEpIiwgInNhbXBsZUJvb2woKSJ9LAoJCXsic3RyaW5nIiwgImVudW0oJ2l0JydzJywnYicpIiwgYCJpdCdzImB9LAoJCXsic3RyaW5nIiwgInZhcmNoYXIoOCkiLCAic2FtcGxlU3RyaW5nKDgpIn0sCgkJeyJOdWxsU3RyaW5nIiwgInRleHQiLCAiTnVsbFN0cmluZ3tTdHJpbmc6IHNhbXBsZVN0cmluZygxNiksIFZhbGlkOiB0cnVlfSJ9LAoJCXsiW11ieXRlIiwgImJpbmFyeSgzMikiLCAiW11ieXRlKHNhbXBsZVN0cmluZygzMikpIn0sCgkJeyJSYXdKU09OIiwgImpzb24iLCAic2FtcGxlSlNPTigpIn0sCgkJeyJ0aW1lLlRpbWUiLCAiZGF0ZSIsICJzYW1wbGVEYXRlKCkifSwKCQl7Ik51bGxUaW1lIiwgImRhdGV0aW1lIiwgIk51bGxUaW1le1RpbWU6IHNhbXBsZVRpbWUoKSwgVmFsaWQ6IHRydWV9In0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCWlmIGdvdCA6PSBHZXRTYW1wbGVWYWx1ZShUbXBsU3RydWN0e30sIFRtcGxGaWVsZHtUeXBlOiB0dC50eXAsIENvbHVtblR5cGU6IHR0LmNvbHVtblR5cGV9KTsgZ290ICE9IHR0LndhbnQgewoJCQl0LkVycm9yZigiR2V0U2FtcGxlVmFsdWUoJXMgJXMpID0gJXMsIHdhbnQgJXMiLCB0dC50eXAsIHR0LmNvbHVtblR5cGUsIGdvdCwgdHQud2FudCkKCQl9Cgl9CgoJbSA6PSBUbXBsU3RydWN0e0NoZWNrczogW11UbXBsQ2hlY2t7CgkJe05hbWU6ICJzbWFsbF9tYXgiLCBDbGF1c2U6ICIoYHNtYWxsYCA8IDEwMCkifSwKCQl7TmFtZTogInNtYWxsX21pbiIsIENsYXVzZTogIihgc21hbGxgID49IDEwKSJ9LAoJCXtOYW1lOiAiYWdlX21pbiIsIENsYXVzZTogIihgYWdlYCA+IDE3KSJ9LAoJfX0KCWNoZWNrZWQgOj0gW11zdHJ1Y3QgewoJCWZsICAgVG1wbEZpZWxkCgkJd2FudCBzdHJpbmcKCX17CgkJe1RtcGxGaWVsZHtUeXBlOiAiTnVsbEludDY0IiwgQ29sdW1uTmFtZTogInNtYWxsIiwgQ29sdW1uVHlwZTogInNtYWxsaW50KDYpIHVuc2lnbmVkIn0sICJOdWxsSW50NjR7SW50NjQ6IHNhbXBsZUludCgxMCwgOTkpLCBWYWxpZDogdHJ1ZX0ifSwKCQl7VG1wbEZpZWxke1R5cGU6ICJpbnQ2NCIsIENvbHVtbk5hbWU6ICJhZ2UiLCBDb2x1bW5UeXBlOiAidGlueWludCgzKSB1bnNpZ25lZCJ9LCAic2FtcGxlSW50KDE4LCAyNTUpIn0sCgkJe1RtcGxGaWVsZHtUeXBlOiAiaW50NjQiLCBDb2x1bW5OYW1lOiAiY291bnQiLCBDb2x1bW5UeXBlOiAiaW50KDExKSJ9LCAic2FtcGxlSW50KDEsIDIxNDc0ODM2NDcpIn0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgY2hlY2tlZCB7CgkJaWYgZ290IDo9IEdldFNhbXBsZVZhbHVlKG0sIHR0LmZsKTsgZ290ICE9IHR0LndhbnQgewoJCQl0LkVycm9yZigiR2V0U2FtcGxlVmFsdWUoJXMpIHdpdGggY2hlY2tzID0gJXMsIHdhbnQgJXMiLCB0dC5mbC5Db2x1bW5OYW1lLCBnb3QsIHR0LndhbnQpCgkJfQoJfQp9CgpmdW5jIFRlc3RHZXRWYWxpZGF0aW9uUnVsZXModCAqdGVzdGluZy5UKSB7CgltIDo9IFN0cnVjdFRtcGxEYXRhewoJCU1vZGVsOiBUbXBsU3RydWN0ewoJCQlGaWVsZHM6IFtdVG1wbEZpZWxkewoJCQkJe05hbWU6ICJJRCIsIENvbHVtbk5hbWU6ICJpZCIsIFR5cGU6ICJpbnQ2NCIsIENvbHVtblR5cGU6ICJpbnQoMTApIHVuc2lnbmVkIiwgQXV0b0luYzogdHJ1ZX0sCgkJCQl7TmFtZTogIkVtYWlsIiwgQ29sdW1uTmFtZTogImVtYWlsIiwgVHlwZTogInN0cmluZyIsIENvbHVtblR5cGU6ICJ2YXJjaGFyKDI1NSkifSwKCQkJCXtOYW1lOiAiQWdlIiwgQ29sdW1uTmFtZTogImFnZSIsIFR5cGU6ICJOdWxsSW50NjQiLCBDb2x1bW5UeXBlOiAidGlueWludCgzKSB1bnNpZ25lZCIsIE51bGxhYmxlOiB0cnVlfSwKCQkJCXtOYW1lOiAiU3RhdHVzIiwgQ29sdW1uTmFtZTogInN0YXR1cyIsIFR5cGU6ICJzdHJpbmciLCBDb2x1bW5UeXBlOiAiZW51bSgnb24nLCdvZmYnKSJ9LAoJCQkJe05hbWU6ICJBdmF0YXIiLCBDb2x1bW5OYW1lOiAiYXZhdGFyIiwgVHlwZTogIltdYnl0ZSIsIENvbHVtblR5cGU6ICJibG9iIn0sCgkJCQl7TmFtZTogIlRvdGFsIiwgQ29sdW1uTmFtZTogInRvdGFsIiwgVHlwZTogImZsb2F0NjQiLCBDb2x1bW5UeXBlOiAiZGVjaW1hbCg2LDIpIn0sCgkJCQl7TmFtZTogIkNyZWF0ZWRBdCIsIENvbHVtbk5hbWU6ICJjcmVhdGVkX2F0IiwgVHlwZTogInRpbWUuVGltZSIsIENvbHVtblR5cGU6ICJkYXRldGltZSJ9LAoJCQl9LAoJCQlDaGVja3M6IFtdVG1wbENoZWNrewoJCQkJe05hbWU6ICJ0b3RhbF9wb3NpdGl2ZSIsIENsYXVzZTogIihgdG90YWxgID4gMCkifSwKCQkJCXtOYW1lOiAiZW1haWxfbGVuZ3RoIiwgQ2xhdXNlOiAiKChjaGFyX2xlbmd0aChgZW1haWxgKSA+PSAzKSkifSwKCQkJCXtOYW1lOiAiY29tcGFyZXNfY29sdW1ucyIsIENsYXVzZTogIihgdG90YWxgID4gYGFnZWApIn0sCgkJCQl7TmFtZTogImFnZV9hZHVsdCIsIENsYXVzZTogIihgYWdlYCA+IDEuNSkifSwKCQkJCXtOYW1lOiAiZW1haWxfc2hvcnQiLCBDbGF1c2U6ICIoY2hhcl9sZW5ndGgoYGVtYWlsYCkgPCAxZTMpIn0sCgkJCQl7TmFtZTogIm5vdF9hX251bWJlciIsIENsYXVzZTogIihgdG90YWxgID4gSW5mKSJ9LAoJCQl9LAoJCX0sCgkJUmVjZWl2ZXI6ICJ1IiwKCX0KCXdhbnQgOj0gW11zdHJpbmd7CgkJImNoYXJMZW5ndGgodS5FbWFpbCkgPiAyNTUiLAoJCSJ1LkFnZS5WYWxpZCAmJiAodS5BZ2UuSW50NjQgPCAwIHx8IHUuQWdlLkludDY0ID4gMjU1KSIsCgkJYCFvbmVPZih1LlN0YXR1cywgIm9uIiwgIm9mZiIpYCwKCQkidS5BdmF0YXIgPT0gbmlsIiwKCQkibGVuKHUuQXZhdGFyKSA+IDY1NTM1IiwKCQkiZXhjZWVkc0RpZ2l0cyh1LlRvdGFsLCA0KSIsCgkJIiEodS5Ub3RhbCA+IDApIiwKCQkiIShjaGFyTGVuZ3RoKHUuRW1haWwpID49IDMpIiwKCQkidS5BZ2UuVmFsaWQgJiYgIShmbG9hdDY0KHUuQWdlLkludDY0KSA+IDEuNSkiLAoJCSIhKGZsb2F0NjQoY2hhckxlbmd0aCh1LkVtYWlsKSkgPCAxZTMpIiwKCX0KCXZhciBnb3QgW11zdHJpbmcKCWZvciBfLCBydWxlIDo9IHJhbmdlIEdldFZhbGlkYXRpb25SdWxlcyhtKSB7CgkJZ290ID0gYXBwZW5kKGdvdCwgcnVsZS5JbnZhbGlkKQoJfQoJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgd2FudCkgewoJCXQuRXJyb3JmKCJHZXRWYWxpZGF0aW9uUnVsZXMoKSA9ICVxLCB3YW50ICVxIiwgZ290LCB3YW50KQoJfQoJaWYgY2hlY2tzIDo9IEdldERhdGFiYXNlQ2hlY2tzKG0pOyBsZW4oY2hlY2tzKSAhPSAyIHx8IGNoZWNrc1swXS5OYW1lICE9ICJjb21wYXJlc19jb2x1bW5zIiB8fCBjaGVja3NbMV0uTmFtZSAhPSAibm90X2FfbnVtYmVyIiB7CgkJdC5FcnJvcmYoIkdldERhdGFiYXNlQ2hlY2tzKCkgPSAldiwgd2FudCBjb21wYXJlc19jb2x1bW5zIGFuZCBub3RfYV9udW1iZXIiLCBjaGVja3MpCgl9Cn0K\"")
+	packr.PackJSONBytes("./tmpl", "types.go", "\"cGFja2FnZSB0bXBsCgovLyBUbXBsU3RydWN0cyBpcyBhIGNvbGxlY3Rpb24gb24gVG1wbFN0cnVjdAp0eXBlIFRtcGxTdHJ1Y3RzIFtdVG1wbFN0cnVjdAoKLy8gVG1wbFN0cnVjdCBkZWZpbmVzIHRoZSB0YWJsZSBkYXRhIHRvIHBhc3MgdG8gdGhlIG1vZGVscwp0eXBlIFRtcGxTdHJ1Y3Qgc3RydWN0IHsKCU5hbWUgICAgICAgIHN0cmluZwoJVGFibGVOYW1lICAgc3RyaW5nCglGaWVsZHMgICAgICBbXVRtcGxGaWVsZAoJS2V5cyAgICAgICAgW11UbXBsS2V5CglVbmlxdWVzICAgICBbXVRtcGxLZXkKCUNoZWNrcyAgICAgIFtdVG1wbENoZWNrCglGb3JlaWduS2V5cyBbXVRtcGxGb3JlaWduS2V5CglJbXBvcnRzICAgICBtYXBbc3RyaW5nXXN0cnVjdHt9Cn0KCi8vIFRtcGxGaWVsZCBkZWZpbmVzIGEgdGFibGUgZmllbGQgdGVtcGxhdGUKdHlwZSBUbXBsRmllbGQgc3RydWN0IHsKCU5hbWUgICAgICAgc3RyaW5nCglUeXBlICAgICAgIHN0cmluZwoJQ29sdW1uTmFtZSBzdHJpbmcKCUNvbHVtblR5cGUgc3RyaW5nCglOdWxsYWJsZSAgIGJvb2wKCUNvbW1lbnQgICAgc3RyaW5nCglEZWZhdWx0ICAgIHN0cmluZwoJSGFzRGVmYXVsdCBib29sCglBdXRvSW5jICAgIGJvb2wKfQoKLy8gU3RydWN0VG1wbERhdGEgZGVmaW5lcyB0aGUgdG9wIGxldmVsIHN0cnVjdCBkYXRhIHRvIHBhc3MgdG8gdGhlIG1vZGVscwp0eXBlIFN0cnVjdFRtcGxEYXRhIHN0cnVjdCB7CglNb2RlbCAgICAgICBUbXBsU3RydWN0CglSZWNlaXZlciAgICBzdHJpbmcKCVBhY2thZ2VOYW1lIHN0cmluZwoJQ29udGV4dE9ubHkgYm9vbAoJU29mdERlbGV0ZSAgc3RyaW5nCglWZXJzaW9uICAgICBzdHJpbmcKCVZhbGlkYXRlICAgIGJvb2wKCVByb3RvICAgICAgIFRtcGxQcm90bwoJRmFjdG9yeSAgICAgVG1wbEZhY3RvcnkKCUxvb2t1cCAgICAgIFRtcGxMb29rdXAKfQoKLy8gVG1wbEtleSBkZWZpbmVzIGEgdW5pcXVlIGtleSBvZiBhIHRhYmxlLCBuYW1lZCBhZnRlciBpdHMgZmllbGRzLiBUaGUgS2V5cyBvZiBhIHRhYmxlIGFyZSB1c2FibGUKLy8gZm9yIGtleXNldCBwYWdpbmF0aW9uLCB0aGUgcHJpbWFyeSBrZXkgY29taW5nIGZpcnN0IHdpdGggYW4gZW1wdHkgTmFtZSwgd2hpbGUgaXRzIFVuaXF1ZXMgaG9sZAovLyBldmVyeSB1bmlxdWUgc2Vjb25kYXJ5IGluZGV4LCBudWxsYWJsZSBjb2x1bW5zIGluY2x1ZGVkLCBmb3IgdGhlIGZha2UgcmVwb3NpdG9yaWVzIHRvIGVuZm9yY2UuCnR5cGUgVG1wbEtleSBzdHJ1Y3QgewoJTmFtZSAgIHN0cmluZwoJRmllbGRzIFtdVG1wbEZpZWxkCn0KCi8vIFRtcGxDaGVjayBkZWZpbmVzIGEgQ0hFQ0sgY29uc3RyYWludCBvZiBhIHRhYmxlLCB3aXRoIGl0cyBjbGF1c2UgYXMgdGhlIGRhdGFiYXNlIHJlcG9ydHMgaXQuCnR5cGUgVG1wbENoZWNrIHN0cnVjdCB7CglOYW1lICAgc3RyaW5nCglDbGF1c2Ugc3RyaW5nCn0KCi8vIFRtcGxSdWxlIGRlZmluZXMgYSB2YWxpZGF0aW9uIHJ1bGUgb2YgYSBmaWVsZCBkZXJpdmVkIGZyb20gaXRzIGNvbHVtbjoKLy8gSW52YWxpZCBpcyBhIEdvIGV4cHJlc3Npb24gd2hpY2ggaXMgdHJ1ZSB3aGVuIHRoZSBmaWVsZCBicmVha3MgdGhlIHJ1bGUuCnR5cGUgVG1wbFJ1bGUgc3RydWN0IHsKCUZpZWxkICAgVG1wbEZpZWxkCglJbnZhbGlkIHN0cmluZwoJTWVzc2FnZSBzdHJpbmcKfQoKLy8gVG1wbEZvcmVpZ25LZXkgZGVmaW5lcyBhIHNpbmdsZSBjb2x1bW4gZm9yZWlnbiBrZXkgb2YgYSB0YWJsZS4KdHlwZSBUbXBsRm9yZWlnbktleSBzdHJ1Y3QgewoJTmFtZSAgICAgIHN0cmluZwoJQ29sdW1uICAgIHN0cmluZwoJUmVmVGFibGUgIHN0cmluZwoJUmVmQ29sdW1uIHN0cmluZwp9CgovLyBUbXBsUHJvdG8gZGVmaW5lcyB0aGUgcHJvdG9idWYgbWVzc2FnZSBvZiBhIG1vZGVsLiBJdCBpcyBlbXB0eSB1bmxlc3MKLy8gcHJvdG9idWYgZ2VuZXJhdGlvbiBpcyBlbmFibGVkLCBQYWNrYWdlIGJlaW5nIHRoZSBHbyBpbXBvcnQgcGF0aAovLyBvZiB0aGUgcGFja2FnZSBwcm90b2MgZ2VuZXJhdGVzIGZyb20gdGhlIC5wcm90byBmaWxlcy4KdHlwZSBUbXBsUHJvdG8gc3RydWN0IHsKCVBhY2thZ2UgICAgICAgc3RyaW5nCglGaWVsZHMgICAgICAgIFtdVG1wbFByb3RvRmllbGQKCVJlc2VydmVkICAgICAgW11pbnQKCVJlc2VydmVkTmFtZXMgW11zdHJpbmcKfQoKLy8gVG1wbFByb3RvRmllbGQgZGVmaW5lcyBhIGZpZWxkIG9mIGEgcHJvdG9idWYgbWVzc2FnZTogaXRzIHByb3RvIG5hbWUsCi8vIHRoZSBuYW1lIHByb3RvYyBnaXZlcyBpdCBpbiBHbyBhbmQgaXRzIGZpZWxkIG51bWJlci4KdHlwZSBUbXBsUHJvdG9GaWVsZCBzdHJ1Y3QgewoJRmllbGQgIFRtcGxGaWVsZAoJTmFtZSAgIHN0cmluZwoJR29OYW1lIHN0cmluZwoJTnVtYmVyIGludAp9CgovLyBUbXBsRmFjdG9yeSBkZWZpbmVzIHRoZSBmYWN0b3J5IG9mIGEgbW9kZWwsIGdlbmVyYXRlZCBpbnRvIGEgcGFja2FnZSBvZiBpdHMgb3duCi8vIGltcG9ydGluZyB0aGUgbW9kZWxzIHBhY2thZ2UsIHdob3NlIGltcG9ydCBwYXRoIGlzIEltcG9ydCBhbmQgbmFtZSBQYWNrYWdlLgp0eXBlIFRtcGxGYWN0b3J5IHN0cnVjdCB7CglJbXBvcnQgIHN0cmluZwoJUGFja2FnZSBzdHJpbmcKCUZpZWxkcyAgW11UbXBsRmFjdG9yeUZpZWxkCglQYXJlbnRzIFtdVG1wbEZhY3RvcnlQYXJlbnQKfQoKLy8gVG1wbEZhY3RvcnlGaWVsZCBwYWlycyBhIGZpZWxkIHdpdGggdGhlIGV4cHJlc3Npb24gb2YgdGhlIGZha2UgdmFsdWUgYSBmYWN0b3J5IGZpbGxzIGl0IHdpdGguCnR5cGUgVG1wbEZhY3RvcnlGaWVsZCBzdHJ1Y3QgewoJRmllbGQgVG1wbEZpZWxkCglWYWx1ZSBzdHJpbmcKfQoKLy8gVG1wbEZhY3RvcnlQYXJlbnQgZGVmaW5lcyBhIGZvcmVpZ24ga2V5IGNvbHVtbiB3aG9zZSByZWZlcmVuY2VkIHJvdwovLyBhIGZhY3RvcnkgaW5zZXJ0cyBmaXJzdCwgYW5kIHRoZSBtb2RlbCBvZiB0aGF0IHJvdy4KdHlwZSBUbXBsRmFjdG9yeVBhcmVudCBzdHJ1Y3QgewoJRmllbGQgVG1wbEZpZWxkCglNb2RlbCBUbXBsU3RydWN0Cn0KCi8vIFRtcGxGaXh0dXJlcyBkZWZpbmVzIHRoZSBHbyBmaXh0dXJlcyBvZiB0aGUgcm93cyBvZiBhIHRhYmxlLCBkdW1wZWQgZnJvbSB0aGUgZGF0YWJhc2UuCnR5cGUgVG1wbEZpeHR1cmVzIHN0cnVjdCB7CglQYWNrYWdlTmFtZSBzdHJpbmcKCU1vZGVsICAgICAgIFRtcGxTdHJ1Y3QKCUltcG9ydHMgICAgIFtdc3RyaW5nCglSb3dzICAgICAgICBbXVtdVG1wbEZpeHR1cmVWYWx1ZQp9CgovLyBUbXBsRml4dHVyZVZhbHVlIHBhaXJzIGEgZmllbGQgd2l0aCB0aGUgR28gZXhwcmVzc2lvbiBvZiBpdHMgdmFsdWUgaW4gYSBkdW1wZWQgcm93Lgp0eXBlIFRtcGxGaXh0dXJlVmFsdWUgc3RydWN0IHsKCUZpZWxkIFRtcGxGaWVsZAoJVmFsdWUgc3RyaW5nCn0KCi8vIFRtcGxMb29rdXAgZGVmaW5lcyB0aGUgdHlwZWQgY29uc3RhbnRzIG9mIGEgbG9va3VwIHRhYmxlLCBvbmUgcGVyIHJvdyByZWFkIHdoZW4gZ2VuZXJhdGluZwovLyB0aGUgbW9kZWxzLCBuYW1lZCBhZnRlciB0aGUgY29kZSBvZiB0aGUgcm93IGhlbGQgYnkgQ29sdW1uLiBJdCBpcyBlbXB0eSBmb3Igb3RoZXIgdGFibGVzLgp0eXBlIFRtcGxMb29rdXAgc3RydWN0IHsKCVR5cGUgICBzdHJpbmcKCUNvbHVtbiBzdHJpbmcKCVZhbHVlcyBbXVRtcGxMb29rdXBWYWx1ZQp9CgovLyBUbXBsTG9va3VwVmFsdWUgZGVmaW5lcyB0aGUgY29uc3RhbnQgb2YgYSByb3cgb2YgYSBsb29rdXAgdGFibGUuCnR5cGUgVG1wbExvb2t1cFZhbHVlIHN0cnVjdCB7CglOYW1lIHN0cmluZwoJSUQgICBpbnQ2NAoJQ29kZSBzdHJpbmcKfQo=\"")
+	packr.PackJSONBytes("./tmpl", "typescript.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgKAoJImJ5dGVzIgoJImVuY29kaW5nL2pzb24iCgkic3RyaW5ncyIKKQoKLy8gR2V0VHlwZVNjcmlwdFR5cGUgcmV0dXJucyB0aGUgVHlwZVNjcmlwdCB0eXBlIG9mIHRoZSBKU09OIGVuY29kaW5nIG9mIGEgZmllbGQ6Ci8vIHRpbWVzIGFyZSBJU08gODYwMSBzdHJpbmdzLCBieXRlIHNsaWNlcyBiYXNlNjQgc3RyaW5ncywgYW5kIHRoZSBtZW1iZXJzIG9mCi8vIGVudW0gY29sdW1ucyBzdHJpbmcgbGl0ZXJhbHMuIE51bGxhYmxlIGNvbHVtbnMgYW5kIG5pbCBieXRlIHNsaWNlcyBhZGQgbnVsbC4KZnVuYyBHZXRUeXBlU2NyaXB0VHlwZShmbCBUbXBsRmllbGQpIHN0cmluZyB7CgliYXNlLCBhcmdzLCBfIDo9IHBhcnNlQ29sdW1uVHlwZShmbC5Db2x1bW5UeXBlKQoJdmFyIHR5cCBzdHJpbmcKCXN3aXRjaCBzdHJpbmdzLlRyaW1QcmVmaXgoZmwuVHlwZSwgIk51bGwiKSB7CgljYXNlICJSYXdKU09OIjoKCQkvLyBhbnkgSlNPTiB2YWx1ZSwgaW5jbHVkaW5nIG51bGwKCQlyZXR1cm4gInVua25vd24iCgljYXNlICJpbnQ2NCIsICJJbnQ2NCIsICJmbG9hdDY0IiwgIkZsb2F0NjQiOgoJCXR5cCA9ICJudW1iZXIiCgljYXNlICJib29sIiwgIkJvb2wiOgoJCXR5cCA9ICJib29sZWFuIgoJY2FzZSAic3RyaW5nIiwgIlN0cmluZyI6CgkJdHlwID0gInN0cmluZyIKCQlpZiBtZW1iZXJzIDo9IHF1b3RlZFZhbHVlcyhhcmdzKTsgYmFzZSA9PSAiZW51bSIgJiYgbGVuKG1lbWJlcnMpID4gMCB7CgkJCWxpdGVyYWxzIDo9IG1ha2UoW11zdHJpbmcsIGxlbihtZW1iZXJzKSkKCQkJZm9yIGksIG1lbWJlciA6PSByYW5nZSBtZW1iZXJzIHsKCQkJCWxpdGVyYWxzW2ldID0gdHlwZVNjcmlwdFN0cmluZyhtZW1iZXIpCgkJCX0KCQkJdHlwID0gc3RyaW5ncy5Kb2luKGxpdGVyYWxzLCAiIHwgIikKCQl9CglkZWZhdWx0OgoJCXR5cCA9ICJzdHJpbmciCgl9CglpZiBzdHJpbmdzLkhhc1ByZWZpeChmbC5UeXBlLCAiTnVsbCIpIHx8IGZsLlR5cGUgPT0gIltdYnl0ZSIgewoJCXR5cCArPSAiIHwgbnVsbCIKCX0KCXJldHVybiB0eXAKfQoKLy8gVHlwZVNjcmlwdFByb3BlcnR5IHJldHVybnMgdGhlIG5hbWUgb2YgdGhlIHByb3BlcnR5IGhvbGRpbmcgYSBjb2x1bW4sCi8vIHF1b3RlZCB1bmxlc3MgaXQgaXMgYSB2YWxpZCBpZGVudGlmaWVyLgpmdW5jIFR5cGVTY3JpcHRQcm9wZXJ0eShuYW1lIHN0cmluZykgc3RyaW5nIHsKCWlmIG5hbWUgPT0gIiIgfHwgaXNEaWdpdChuYW1lWzBdKSB7CgkJcmV0dXJuIHR5cGVTY3JpcHRTdHJpbmcobmFtZSkKCX0KCWZvciBpIDo9IDA7IGkgPCBsZW4obmFtZSk7IGkrKyB7CgkJYyA6PSBuYW1lW2ldCgkJaWYgIWlzTG93ZXIoYykgJiYgIWlzRGlnaXQoYykgJiYgKGMgPCAnQScgfHwgYyA+ICdaJykgJiYgYyAhPSAnXycgJiYgYyAhPSAnJCcgewoJCQlyZXR1cm4gdHlwZVNjcmlwdFN0cmluZyhuYW1lKQoJCX0KCX0KCXJldHVybiBuYW1lCn0KCi8vIEdldFR5cGVTY3JpcHRDb21tZW50IHJldHVybnMgYSBKU0RvYyBjb21tZW50IGRvY3VtZW50aW5nIHRoZSBjb2x1bW4KLy8gY29tbWVudCBhbmQgZGVmYXVsdCB2YWx1ZSBvZiBhIGZpZWxkLCBvciBub3RoaW5nIGlmIGl0IGhhcyBuZWl0aGVyLgpmdW5jIEdldFR5cGVTY3JpcHRDb21tZW50KGZsIFRtcGxGaWVsZCkgc3RyaW5nIHsKCXRleHQgOj0gc3RyaW5ncy5UcmltUHJlZml4KEdldEZpZWxkQ29tbWVudChmbCksICIvLyAiKQoJaWYgdGV4dCA9PSAiIiB7CgkJcmV0dXJuICIiCgl9CglyZXR1cm4gIi8qKiAiICsgc3RyaW5ncy5SZXBsYWNlKHRleHQsICIqLyIsIGAqXC9gLCAtMSkgKyAiICovIgp9CgovLyB0eXBlU2NyaXB0U3RyaW5nIHF1b3RlcyBzIGFzIGEgVHlwZVNjcmlwdCBzdHJpbmcgbGl0ZXJhbCwgd2hpY2ggSlNPTiBzdHJpbmdzIGFyZSB2YWxpZCBvbmVzIG9mLgpmdW5jIHR5cGVTY3JpcHRTdHJpbmcocyBzdHJpbmcpIHN0cmluZyB7CglidWYgOj0gbmV3KGJ5dGVzLkJ1ZmZlcikKCWVuYyA6PSBqc29uLk5ld0VuY29kZXIoYnVmKQoJZW5jLlNldEVzY2FwZUhUTUwoZmFsc2UpCgllbmMuRW5jb2RlKHMpCglyZXR1cm4gc3RyaW5ncy5UcmltU3BhY2UoYnVmLlN0cmluZygpKQp9Cg==\"")
+	packr.PackJSONBytes("./tmpl", "typescript.html", "\"e3tkZWZpbmUgInR5cGVzY3JpcHQifX0vLyBDb2RlIGdlbmVyYXRlZCBieSBtb2RlbGdlbi4gRE8gTk9UIEVESVQuCgovKiogQSByb3cgb2YgdGhlIHt7IGdvX2NvbW1lbnQgLk1vZGVsLlRhYmxlTmFtZSB9fSB0YWJsZS4gKi8KZXhwb3J0IGludGVyZmFjZSB7ey5Nb2RlbC5OYW1lfX0gewp7ey0gcmFuZ2UgLk1vZGVsLkZpZWxkcyB9fQp7ey0gd2l0aCB0c19jb21tZW50IC4gfX0KICB7eyAuIH19Cnt7LSBlbmQgfX0KICB7eyB0c19wcm9wZXJ0eSAuQ29sdW1uTmFtZSB9fToge3sgdHNfdHlwZSAuIH19Owp7ey0gZW5kIH19Cn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "typescript_test.go", "\"cGFja2FnZSB0bXBsCgppbXBvcnQgInRlc3RpbmciCgpmdW5jIFRlc3RHZXRUeXBlU2NyaXB0VHlwZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQl0eXAsIGNvbHVtblR5cGUsIHdhbnQgc3RyaW5nCgl9ewoJCXsiaW50NjQiLCAiYmlnaW50KDIwKSB1bnNpZ25lZCIsICJudW1iZXIifSwKCQl7Ik51bGxGbG9hdDY0IiwgImRlY2ltYWwoMTAsMikiLCAibnVtYmVyIHwgbnVsbCJ9LAoJCXsiYm9vbCIsICJ0aW55aW50KDEpIiwgImJvb2xlYW4ifSwKCQl7Ik51bGxCb29sIiwgInRpbnlpbnQoMSkiLCAiYm9vbGVhbiB8IG51bGwifSwKCQl7InN0cmluZyIsICJ2YXJjaGFyKDI1NSkiLCAic3RyaW5nIn0sCgkJeyJOdWxsU3RyaW5nIiwgInRleHQiLCAic3RyaW5nIHwgbnVsbCJ9LAoJCXsidGltZS5UaW1lIiwgImRhdGV0aW1lIiwgInN0cmluZyJ9LAoJCXsiTnVsbFRpbWUiLCAidGltZXN0YW1wIiwgInN0cmluZyB8IG51bGwifSwKCQl7IltdYnl0ZSIsICJibG9iIiwgInN0cmluZyB8IG51bGwifSwKCQl7IlJhd0pTT04iLCAianNvbiIsICJ1bmtub3duIn0sCgkJeyJzdHJpbmciLCAiZW51bSgnYScsJ2InJ2MnKSIsIGAiYSIgfCAiYidjImB9LAoJCXsiTnVsbFN0cmluZyIsICJlbnVtKCdvbicpIiwgYCJvbiIgfCBudWxsYH0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCWZsIDo9IFRtcGxGaWVsZHtUeXBlOiB0dC50eXAsIENvbHVtblR5cGU6IHR0LmNvbHVtblR5cGV9CgkJaWYgZ290IDo9IEdldFR5cGVTY3JpcHRUeXBlKGZsKTsgZ290ICE9IHR0LndhbnQgewoJCQl0LkVycm9yZigiR2V0VHlwZVNjcmlwdFR5cGUoJXMgJXMpID0gJXMsIHdhbnQgJXMiLCB0dC50eXAsIHR0LmNvbHVtblR5cGUsIGdvdCwgdHQud2FudCkKCQl9Cgl9Cn0KCmZ1bmMgVGVzdFR5cGVTY3JpcHRQcm9wZXJ0eSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lLCB3YW50IHN0cmluZwoJfXsKCQl7ImNyZWF0ZWRfYXQiLCAiY3JlYXRlZF9hdCJ9LAoJCXsiJHJlZiIsICIkcmVmIn0sCgkJeyIyZmEiLCBgIjJmYSJgfSwKCQl7Im9yZGVyLXRvdGFsIiwgYCJvcmRlci10b3RhbCJgfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJaWYgZ290IDo9IFR5cGVTY3JpcHRQcm9wZXJ0eSh0dC5uYW1lKTsgZ290ICE9IHR0LndhbnQgewoJCQl0LkVycm9yZigiVHlwZVNjcmlwdFByb3BlcnR5KCVxKSA9ICVzLCB3YW50ICVzIiwgdHQubmFtZSwgZ290LCB0dC53YW50KQoJCX0KCX0KfQo=\"")
+	packr.PackJSONBytes("./tmpl", "x_factories.html", "\"e3tkZWZpbmUgImZhY3RvcmllcyJ9fQoKcGFja2FnZSBmYWN0b3JpZXMKCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuIHwKfCAgICAgICAgRE8gTk9UIEVESVQuICAgICAgICB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKaW1wb3J0ICgKCSJmbXQiCgkibWF0aCIKCSJzdHJjb252IgoJInN5bmMvYXRvbWljIgoJInRpbWUiCgoJe3sgLlBhY2thZ2UgfX0gInt7IC5JbXBvcnQgfX0iCikKCi8vIHNlcXVlbmNlIG51bWJlcnMgdGhlIHJvd3MgYnVpbHQgYnkgZXZlcnkgZmFjdG9yeSwgZnJvbSB3aGljaCB0aGVpciB2YWx1ZXMgYXJlIGRlcml2ZWQuCnZhciBzZXF1ZW5jZSBpbnQ2NAoKLy8gbmV4dCByZXR1cm5zIHRoZSBuZXh0IG51bWJlciBvZiB0aGUgc2VxdWVuY2UuCmZ1bmMgbmV4dCgpIGludDY0IHsKCXJldHVybiBhdG9taWMuQWRkSW50NjQoJnNlcXVlbmNlLCAxKQp9CgovLyBmaXQga2VlcHMgdGhlIGxhc3QgbWF4IGJ5dGVzIG9mIHMsIHdoZXJlIHRoZSBzZXF1ZW5jZSBudW1iZXIgaXMsIHdoZW4gcyBpcyBsb25nZXIuCi8vIEEgbWF4IG9mIHplcm8gbGVhdmVzIHMgYXMgaXMuCmZ1bmMgZml0KHMgc3RyaW5nLCBtYXggaW50KSBzdHJpbmcgewoJaWYgbWF4ID4gMCAmJiBsZW4ocykgPiBtYXggewoJCXJldHVybiBzW2xlbihzKS1tYXg6XQoJfQoJcmV0dXJuIHMKfQoKLy8gZmFrZUludCByZXR1cm5zIGFuIGludGVnZXIgYmV0d2VlbiBsbyBhbmQgaGksIGluY3JlYXNpbmcgd2l0aCBuIHVudGlsIGl0IHdyYXBzIGFyb3VuZC4KZnVuYyBmYWtlSW50KG4sIGxvLCBoaSBpbnQ2NCkgaW50NjQgewoJcmV0dXJuIGxvICsgKG4tMSklKGhpLWxvKzEpCn0KCi8vIGZha2VGbG9hdCByZXR1cm5zIGEgbnVtYmVyIG9mIHVwIHRvIGRpZ2l0cyBpbnRlZ2VyIGRpZ2l0cyBhbmQgc2NhbGUgZGVjaW1hbHMuCmZ1bmMgZmFrZUZsb2F0KG4gaW50NjQsIGRpZ2l0cywgc2NhbGUgaW50KSBmbG9hdDY0IHsKCXdob2xlIDo9IGZsb2F0NjQobiAlIGludDY0KG1hdGguUG93MTAoZGlnaXRzKSkpCglmcmFjdGlvbiA6PSBmbG9hdDY0KG4laW50NjQobWF0aC5Qb3cxMChzY2FsZSkpKSAvIG1hdGguUG93MTAoc2NhbGUpCglyZXR1cm4gd2hvbGUgKyBmcmFjdGlvbgp9CgpmdW5jIGZha2VCb29sKG4gaW50NjQpIGJvb2wgewoJcmV0dXJuIG4lMiA9PSAxCn0KCi8vIGZha2VQaWNrIHJldHVybnMgb25lIG9mIHRoZSBtZW1iZXJzIG9mIGFuIGVudW0gb3Igc2V0IGNvbHVtbiwgaW4gdHVybi4KZnVuYyBmYWtlUGljayhuIGludDY0LCBtZW1iZXJzIC4uLnN0cmluZykgc3RyaW5nIHsKCXJldHVybiBtZW1iZXJzWyhuLTEpJWludDY0KGxlbihtZW1iZXJzKSldCn0KCmZ1bmMgZmFrZVRleHQoY29sdW1uIHN0cmluZywgbiBpbnQ2NCwgbWF4IGludCkgc3RyaW5nIHsKCXJldHVybiBmaXQoY29sdW1uKyIgIitzdHJjb252LkZvcm1hdEludChuLCAxMCksIG1heCkKfQoKZnVuYyBmYWtlRW1haWwodGFibGUgc3RyaW5nLCBuIGludDY0LCBtYXggaW50KSBzdHJpbmcgewoJcmV0dXJuIGZpdChmbXQuU3ByaW50ZigiJXMlZEBleGFtcGxlLmNvbSIsIHRhYmxlLCBuKSwgbWF4KQp9CgpmdW5jIGZha2VVUkwodGFibGUgc3RyaW5nLCBuIGludDY0LCBtYXggaW50KSBzdHJpbmcgewoJcmV0dXJuIGZpdChmbXQuU3ByaW50ZigiaHR0cHM6Ly9leGFtcGxlLmNvbS8lcy8lZCIsIHRhYmxlLCBuKSwgbWF4KQp9CgovLyBmYWtlQml0cyByZXR1cm5zIGEgc2luZ2xlIGJpdCwgd2hpY2ggZml0cyBhbnkgYml0IGNvbHVtbi4KZnVuYyBmYWtlQml0cyhuIGludDY0KSBbXWJ5dGUgewoJcmV0dXJuIFtdYnl0ZXtieXRlKG4gJSAyKX0KfQoKZnVuYyBmYWtlUGhvbmUobiBpbnQ2NCwgbWF4IGludCkgc3RyaW5nIHsKCXJldHVybiBmaXQoZm10LlNwcmludGYoIis0NCAyMCA3OTQ2ICUwNGQiLCBuJTEwMDAwKSwgbWF4KQp9CgpmdW5jIGZha2VVVUlEKG4gaW50NjQpIHN0cmluZyB7CglyZXR1cm4gZm10LlNwcmludGYoIjAwMDAwMDAwLTAwMDAtNDAwMC04MDAwLSUwMTJ4IiwgbikKfQoKLy8gZmFrZVRpbWUgcmV0dXJucyBhIHRpbWUgbiBtaW51dGVzIGludG8gMjAyMCwgd2hpY2ggZml0cyBUSU1FU1RBTVAgY29sdW1ucyBhcyB3ZWxsLgpmdW5jIGZha2VUaW1lKG4gaW50NjQpIHRpbWUuVGltZSB7CglyZXR1cm4gdGltZS5EYXRlKDIwMjAsIDEsIDEsIDAsIDAsIDAsIDAsIHRpbWUuVVRDKS5BZGQodGltZS5EdXJhdGlvbihuKSAqIHRpbWUuTWludXRlKQp9CgpmdW5jIGZha2VEYXRlKG4gaW50NjQpIHRpbWUuVGltZSB7CglyZXR1cm4gZmFrZVRpbWUobiAqIDI0ICogNjApCn0KCmZ1bmMgZmFrZUNsb2NrKG4gaW50NjQpIHN0cmluZyB7CglyZXR1cm4gZmFrZVRpbWUobikuRm9ybWF0KHt7IC5QYWNrYWdlIH19LlN0ZFRpbWUpCn0KCmZ1bmMgZmFrZUpTT04obiBpbnQ2NCkge3sgLlBhY2thZ2UgfX0uUmF3SlNPTiB7CglyZXR1cm4ge3sgLlBhY2thZ2UgfX0uUmF3SlNPTihmbXQuU3ByaW50ZihgeyJmYWtlIjogJWR9YCwgbikpCn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "x_graphql.html", "\"e3tkZWZpbmUgImdyYXBocWwifX0KcGFja2FnZSB7eyAuUGFja2FnZU5hbWUgfX0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuIHwKfCAgICAgICAgRE8gTk9UIEVESVQuICAgICAgICB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKaW1wb3J0ICgKCSJlbmNvZGluZy9iYXNlNjQiCgkiZW5jb2RpbmcvanNvbiIKCSJlcnJvcnMiCgkiZm10IgoJInN0cmNvbnYiCgkic3RyaW5ncyIKCSJ0aW1lIgoKCWdyYXBocWwgImdpdGh1Yi5jb20vZ3JhcGgtZ29waGVycy9ncmFwaHFsLWdvIgopCgovLyBHcmFwaFFMU2NoZW1hIGlzIHRoZSBzY2hlbWEgb2Ygc2NoZW1hLmdyYXBocWwsIHJlc29sdmVkIGJ5IFJlc29sdmVyIHdpdGggZ2l0aHViLmNvbS9ncmFwaC1nb3BoZXJzL2dyYXBocWwtZ28sIGV4OgovLyAgc2NoZW1hIDo9IGdyYXBocWwuTXVzdFBhcnNlU2NoZW1hKG1vZGVscy5HcmFwaFFMU2NoZW1hLCAmbW9kZWxzLlJlc29sdmVye0RCOiBkYn0pCmNvbnN0IEdyYXBoUUxTY2hlbWEgPSBge3sgLlNjaGVtYSB9fWAKCi8vIFJlc29sdmVyIGlzIHRoZSByb290IHJlc29sdmVyIG9mIEdyYXBoUUxTY2hlbWEsIGxvYWRpbmcgcm93cyB3aXRoIERCLgp0eXBlIFJlc29sdmVyIHN0cnVjdCB7CglEQiBRdWVyeWVyQ29udGV4dAp9CgovLyBEZWZhdWx0UGFnZVNpemUgaXMgdGhlIG51bWJlciBvZiByb3dzIGEgY29ubmVjdGlvbiBsb2FkcyB3aGVuIG5vIGZpcnN0IGFyZ3VtZW50IGlzIGdpdmVuLAovLyBhbmQgTWF4UGFnZVNpemUgdGhlIG1vc3QgaXQgbG9hZHMgd2hhdGV2ZXIgdGhlIGZpcnN0IGFyZ3VtZW50Lgp2YXIgKAoJRGVmYXVsdFBhZ2VTaXplID0gMjAKCU1heFBhZ2VTaXplICAgICA9IDEwMAopCgovLyBDb25uZWN0aW9uQXJncyBhcmUgdGhlIHBhZ2luYXRpb24gYXJndW1lbnRzIG9mIGNvbm5lY3Rpb24gZmllbGRzLgp0eXBlIENvbm5lY3Rpb25BcmdzIHN0cnVjdCB7CglGaXJzdCAqaW50MzIKCUFmdGVyICpzdHJpbmcKfQoKLy8gcGFnZSByZXR1cm5zIHRoZSBudW1iZXIgb2Ygcm93cyB0byBsb2FkIGFuZCB0aGUgY3Vyc29yIHRvIGxvYWQgdGhlbSBhZnRlci4KZnVuYyAoYXJncyBDb25uZWN0aW9uQXJncykgcGFnZSgpIChuIGludCwgYWZ0ZXIgc3RyaW5nLCBlcnIgZXJyb3IpIHsKCW4gPSBEZWZhdWx0UGFnZVNpemUKCWlmIGFyZ3MuRmlyc3QgIT0gbmlsIHsKCQlpZiAqYXJncy5GaXJzdCA8PSAwIHsKCQkJcmV0dXJuIDAsICIiLCBlcnJvcnMuTmV3KCJmaXJzdCBtdXN0IGJlIHBvc2l0aXZlIikKCQl9CgkJbiA9IGludCgqYXJncy5GaXJzdCkKCX0KCWlmIG4gPiBNYXhQYWdlU2l6ZSB7CgkJbiA9IE1heFBhZ2VTaXplCgl9CglpZiBhcmdzLkFmdGVyICE9IG5pbCB7CgkJYWZ0ZXIgPSAqYXJncy5BZnRlcgoJfQoJcmV0dXJuIG4sIGFmdGVyLCBuaWwKfQoKLy8gUGFnZUluZm9SZXNvbHZlciByZXNvbHZlcyB0aGUgUGFnZUluZm8gdHlwZS4KdHlwZSBQYWdlSW5mb1Jlc29sdmVyIHN0cnVjdCB7CgllbmQgIHN0cmluZwoJbW9yZSBib29sCn0KCi8vIEVuZEN1cnNvciByZXNvbHZlcyB0aGUgZW5kQ3Vyc29yIGZpZWxkLgpmdW5jIChyICpQYWdlSW5mb1Jlc29sdmVyKSBFbmRDdXJzb3IoKSAqc3RyaW5nIHsKCWlmIHIuZW5kID09ICIiIHsKCQlyZXR1cm4gbmlsCgl9CglyZXR1cm4gJnIuZW5kCn0KCi8vIEhhc05leHRQYWdlIHJlc29sdmVzIHRoZSBoYXNOZXh0UGFnZSBmaWVsZC4KZnVuYyAociAqUGFnZUluZm9SZXNvbHZlcikgSGFzTmV4dFBhZ2UoKSBib29sIHsKCXJldHVybiByLm1vcmUKfQoKLy8gaWRDdXJzb3IgcmV0dXJucyB0aGUgY3Vyc29yIExvYWRBZnRlciBsb2FkcyB0aGUgcm93cyBmb2xsb3dpbmcgdGhlIHJvdyB3aXRoIHRoZSBnaXZlbiBpZCB3aXRoLgpmdW5jIGlkQ3Vyc29yKGlkIGludDY0KSAoc3RyaW5nLCBlcnJvcikgewoJcmV0dXJuIGVuY29kZUN1cnNvcihzdHJ1Y3QgewoJCUlEIGludDY0IGBqc29uOiJpZCJgCgl9e2lkfSkKfQoKZnVuYyBwYXJzZUlEKGlkIGdyYXBocWwuSUQpIChpbnQ2NCwgZXJyb3IpIHsKCW4sIGVyciA6PSBzdHJjb252LlBhcnNlSW50KHN0cmluZyhpZCksIDEwLCA2NCkKCWlmIGVyciAhPSBuaWwgewoJCXJldHVybiAwLCBmbXQuRXJyb3JmKCJpbnZhbGlkIGlkICVxIiwgaWQpCgl9CglyZXR1cm4gbiwgbmlsCn0KCi8qLS0tLS0tLS0rCnwgU2NhbGFycyB8CistLS0tLS0tLSovCgovLyBJbnQ2NCBpcyB0aGUgSW50NjQgc2NhbGFyLCBmb3IgaW50ZWdlciBjb2x1bW5zIHRvbyBsYXJnZSBmb3IgSW50LgovLyBJdCBpcyBlbmNvZGVkIGFzIGEgc3RyaW5nLCBhcyBKU09OIG51bWJlcnMgY2Fubm90IGhvbGQgZXZlcnkgNjQgYml0cyBpbnRlZ2VyLgp0eXBlIEludDY0IGludDY0CgovLyBJbXBsZW1lbnRzR3JhcGhRTFR5cGUgbWFwcyBJbnQ2NCB0byB0aGUgSW50NjQgc2NhbGFyLgpmdW5jIChJbnQ2NCkgSW1wbGVtZW50c0dyYXBoUUxUeXBlKG5hbWUgc3RyaW5nKSBib29sIHsKCXJldHVybiBuYW1lID09ICJJbnQ2NCIKfQoKLy8gVW5tYXJzaGFsR3JhcGhRTCByZWFkcyBhbiBJbnQ2NCBmcm9tIGEgc3RyaW5nIG9yIGEgbnVtYmVyLgpmdW5jIChpICpJbnQ2NCkgVW5tYXJzaGFsR3JhcGhRTChpbnB1dCBpbnRlcmZhY2V7fSkgZXJyb3IgewoJc3dpdGNoIHYgOj0gaW5wdXQuKHR5cGUpIHsKCWNhc2Ugc3RyaW5nOgoJCW4sIGVyciA6PSBzdHJjb252LlBhcnNlSW50KHYsIDEwLCA2NCkKCQlpZiBlcnIgIT0gbmlsIHsKCQkJcmV0dXJuIGVycgoJCX0KCQkqaSA9IEludDY0KG4pCgljYXNlIGludDMyOgoJCSppID0gSW50NjQodikKCWNhc2UgZmxvYXQ2NDoKCQkqaSA9IEludDY0KHYpCglkZWZhdWx0OgoJCXJldHVybiBmbXQuRXJyb3JmKCJ3cm9uZyB0eXBlIGZvciBJbnQ2NDogJVQiLCBpbnB1dCkKCX0KCXJldHVybiBuaWwKfQoKLy8gTWFyc2hhbEpTT04gZW5jb2RlcyB0aGUgSW50NjQgYXMgYSBzdHJpbmcuCmZ1bmMgKGkgSW50NjQpIE1hcnNoYWxKU09OKCkgKFtdYnl0ZSwgZXJyb3IpIHsKCXJldHVybiBqc29uLk1hcnNoYWwoc3RyY29udi5Gb3JtYXRJbnQoaW50NjQoaSksIDEwKSkKfQoKLy8gSW1wbGVtZW50c0dyYXBoUUxUeXBlIG1hcHMgUmF3SlNPTiB0byB0aGUgSlNPTiBzY2FsYXIuCmZ1bmMgKFJhd0pTT04pIEltcGxlbWVudHNHcmFwaFFMVHlwZShuYW1lIHN0cmluZykgYm9vbCB7CglyZXR1cm4gbmFtZSA9PSAiSlNPTiIKfQoKLy8gVW5tYXJzaGFsR3JhcGhRTCByZWFkcyBhIEpTT04gdmFsdWUuCmZ1bmMgKG4gKlJhd0pTT04pIFVubWFyc2hhbEdyYXBoUUwoaW5wdXQgaW50ZXJmYWNle30pIGVycm9yIHsKCWIsIGVyciA6PSBqc29uLk1hcnNoYWwoaW5wdXQpCglpZiBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgkqbiA9IFJhd0pTT04oYikKCXJldHVybiBuaWwKfQoKLyotLS0tLS0tLS0tLSsKfCBDb252ZXJ0ZXJzIHwKKy0tLS0tLS0tLS0tKi8KCmZ1bmMgZ3FsSUQoaWQgaW50NjQpIGdyYXBocWwuSUQgewoJcmV0dXJuIGdyYXBocWwuSUQoc3RyY29udi5Gb3JtYXRJbnQoaWQsIDEwKSkKfQoKZnVuYyBncWxOdWxsSUQoaWQgTnVsbEludDY0KSAqZ3JhcGhxbC5JRCB7CglpZiAhaWQuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXYgOj0gZ3FsSUQoaWQuSW50NjQpCglyZXR1cm4gJnYKfQoKZnVuYyBncWxOdWxsSW50MzIoaSBOdWxsSW50NjQpICppbnQzMiB7CglpZiAhaS5WYWxpZCB7CgkJcmV0dXJuIG5pbAoJfQoJdiA6PSBpbnQzMihpLkludDY0KQoJcmV0dXJuICZ2Cn0KCmZ1bmMgZ3FsTnVsbEludDY0KGkgTnVsbEludDY0KSAqSW50NjQgewoJaWYgIWkuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXYgOj0gSW50NjQoaS5JbnQ2NCkKCXJldHVybiAmdgp9CgpmdW5jIGdxbE51bGxGbG9hdDY0KGYgTnVsbEZsb2F0NjQpICpmbG9hdDY0IHsKCWlmICFmLlZhbGlkIHsKCQlyZXR1cm4gbmlsCgl9CglyZXR1cm4gJmYuRmxvYXQ2NAp9CgpmdW5jIGdxbE51bGxCb29sKGIgTnVsbEJvb2wpICpib29sIHsKCWlmICFiLlZhbGlkIHsKCQlyZXR1cm4gbmlsCgl9CglyZXR1cm4gJmIuQm9vbAp9CgpmdW5jIGdxbE51bGxTdHJpbmcocyBOdWxsU3RyaW5nKSAqc3RyaW5nIHsKCWlmICFzLlZhbGlkIHsKCQlyZXR1cm4gbmlsCgl9CglyZXR1cm4gJnMuU3RyaW5nCn0KCi8vIGdxbEVudW0gcmV0dXJucyB0aGUgbmFtZSBvZiB0aGUgZW51bSB2YWx1ZSBzdGFuZGluZyBmb3IgdGhlIHZhbHVlIG9mIGFuIGVudW0gY29sdW1uLgpmdW5jIGdxbEVudW0ocyBzdHJpbmcpIHN0cmluZyB7CglyZXR1cm4gc3RyaW5ncy5Ub1VwcGVyKHMpCn0KCmZ1bmMgZ3FsTnVsbEVudW0ocyBOdWxsU3RyaW5nKSAqc3RyaW5nIHsKCWlmICFzLlZhbGlkIHsKCQlyZXR1cm4gbmlsCgl9Cgl2IDo9IGdxbEVudW0ocy5TdHJpbmcpCglyZXR1cm4gJnYKfQoKZnVuYyBncWxUaW1lKHQgdGltZS5UaW1lKSBncmFwaHFsLlRpbWUgewoJcmV0dXJuIGdyYXBocWwuVGltZXtUaW1lOiB0fQp9CgpmdW5jIGdxbE51bGxUaW1lKHQgTnVsbFRpbWUpICpncmFwaHFsLlRpbWUgewoJaWYgIXQuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXYgOj0gZ3FsVGltZSh0LlRpbWUpCglyZXR1cm4gJnYKfQoKZnVuYyBncWxCeXRlcyhiIFtdYnl0ZSkgKnN0cmluZyB7CglpZiBiID09IG5pbCB7CgkJcmV0dXJuIG5pbAoJfQoJdiA6PSBiYXNlNjQuU3RkRW5jb2RpbmcuRW5jb2RlVG9TdHJpbmcoYikKCXJldHVybiAmdgp9CgpmdW5jIGdxbEpTT04oaiBSYXdKU09OKSAqUmF3SlNPTiB7CglpZiBsZW4oaikgPT0gMCB7CgkJcmV0dXJuIG5pbAoJfQoJcmV0dXJuICZqCn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "x_helpers.html", "\"e3tkZWZpbmUgImhlbHBlcnMifX0KCnBhY2thZ2Uge3sgLlBhY2thZ2VOYW1lIH19CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBDb2RlIGdlbmVyYXRlZCBieSBtb2RlbGdlbiB8CnwgICAgICAgIERPIE5PVCBFRElULiAgICAgICAgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCmltcG9ydCAoCgkiYnl0ZXMiCgkiY29udGV4dCIKCSJkYXRhYmFzZS9zcWwiCgkiZGF0YWJhc2Uvc3FsL2RyaXZlciIKCSJlbmNvZGluZy9qc29uIgoJImVycm9ycyIKCSJmbXQiCgkibG9nIgoJIm1hdGgiCgkicmVmbGVjdCIKCSJzdHJpbmdzIgoJInRpbWUiCgkidW5pY29kZS91dGY4IgoKCSJnaXRodWIuY29tL2dvLXNxbC1kcml2ZXIvbXlzcWwiCikKCi8vIFN0ZFRpbWUgcHJvdmlkZXMgZGVmYXVsdCBTUUwgVElNRSBmb3JtYXQKY29uc3QgU3RkVGltZSA9ICIxNTowNDowNSIKCi8vIGVtcHR5VGltZSBhbGxvd3MgZGVmYXVsdCB0aW1lcyB0byBiZSBjb25zaWRlcmVkCi8vIG51bGwgZm9yIGluc2VydGlvbiBpbnRvIHRoZSBkYXRhYmFzZS4KdmFyIGVtcHR5VGltZSA9IHRpbWUuVGltZXt9CgovLyBudWxsTGl0ZXJhbCBpcyBoZWxwZnVsIGZvciBjaGVja2luZwovLyBmb3IgbnVsbHMsIGFzIHRoZXkgd29uJ3QgY2F1c2UgZXJyb3JzLAovLyB5ZXQgd2UgbmVlZCB0aGUgY29udGVudCBvZiB0aGUgZmlsZSB0byBjaGFuZ2UgYW55d2F5CnZhciBudWxsTGl0ZXJhbCA9IFtdYnl0ZSgibnVsbCIpCgovKioqKioqKioKKiBUeXBlcyAqCioqKioqKioqLwoKLy8gUXVlcnllciBhbGxvd3Mgc3FsLkRCIGFuZCBzcWwuVHggdG8gYmUgdXNlZCBpbnRlcmNoYW5nZWFibHksIGFsbG93aW5nIHlvdQovLyB0byB1c2UgYW55IG9mIHRoZSBtb2RlbCBtZXRob2RzIGluc2lkZSB0cmFuc2FjdGlvbnMgb3Igc3RhbmRhbG9uZSBjYWxscy4KdHlwZSBRdWVyeWVyIGludGVyZmFjZSB7CglRdWVyeShxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pICgqc3FsLlJvd3MsIGVycm9yKQoJUXVlcnlSb3cocXVlcnkgc3RyaW5nLCBhcmdzIC4uLmludGVyZmFjZXt9KSAqc3FsLlJvdwoJRXhlYyhxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChzcWwuUmVzdWx0LCBlcnJvcikKfQoKLy8gUXVlcnllckNvbnRleHQgYWxsb3dzIHNxbC5EQiwgc3FsLlR4IGFuZCBzcWwuQ29ubiB0byBiZSB1c2VkIGludGVyY2hhbmdlYWJseQovLyB3aXRoIHRoZSBjb250ZXh0IGF3YXJlIG1vZGVsIG1ldGhvZHMsIHNvIHF1ZXJpZXMgaG9ub3VyIGNhbmNlbGxhdGlvbiBhbmQgZGVhZGxpbmVzLgp0eXBlIFF1ZXJ5ZXJDb250ZXh0IGludGVyZmFjZSB7CglRdWVyeUNvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXVlcnkgc3RyaW5nLCBhcmdzIC4uLmludGVyZmFjZXt9KSAoKnNxbC5Sb3dzLCBlcnJvcikKCVF1ZXJ5Um93Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pICpzcWwuUm93CglFeGVjQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChzcWwuUmVzdWx0LCBlcnJvcikKfQoKLy8gVHhRdWVyeWVyIGlzIGEgUXVlcnllckNvbnRleHQgcnVubmluZyB3aXRoaW4gYSB0cmFuc2FjdGlvbiwgbGlrZSBzcWwuVHguCi8vIFJvdyBsb2NrcyBhcmUgcmVsZWFzZWQgYXMgc29vbiBhcyB0aGVpciB0cmFuc2FjdGlvbiBlbmRzLAovLyBzbyB0aGUgbG9ja2luZyByZWFkcywgc3VjaCBhcyBGaW5kRm9yVXBkYXRlLCBvbmx5IGFjY2VwdCBhIFR4UXVlcnllci4KdHlwZSBUeFF1ZXJ5ZXIgaW50ZXJmYWNlIHsKCVF1ZXJ5ZXJDb250ZXh0CglDb21taXQoKSBlcnJvcgoJUm9sbGJhY2soKSBlcnJvcgp9CgovLyBhc1F1ZXJ5ZXJDb250ZXh0IGFkYXB0cyBhIFF1ZXJ5ZXIgZm9yIHVzZSB3aXRoIHRoZSBjb250ZXh0IGF3YXJlIG1vZGVsIG1ldGhvZHMuCi8vIFF1ZXJ5ZXJzIGFscmVhZHkgaW1wbGVtZW50aW5nIFF1ZXJ5ZXJDb250ZXh0LCBsaWtlIHNxbC5EQiBhbmQgc3FsLlR4LCBhcmUgcmV0dXJuZWQgYXMgaXMuCmZ1bmMgYXNRdWVyeWVyQ29udGV4dChxdSBRdWVyeWVyKSBRdWVyeWVyQ29udGV4dCB7CglpZiBxdWMsIG9rIDo9IHF1LihRdWVyeWVyQ29udGV4dCk7IG9rIHsKCQlyZXR1cm4gcXVjCgl9CglyZXR1cm4gcXVlcnllckNvbnRleHR7cXV9Cn0KCi8vIHF1ZXJ5ZXJDb250ZXh0IHdyYXBzIGEgUXVlcnllciwgaWdub3JpbmcgdGhlIGNvbnRleHQgaXQgaXMgZ2l2ZW4uCnR5cGUgcXVlcnllckNvbnRleHQgc3RydWN0IHsKCVF1ZXJ5ZXIKfQoKLy8gUXVlcnlDb250ZXh0IGZvciBxdWVyeWVyQ29udGV4dApmdW5jIChxIHF1ZXJ5ZXJDb250ZXh0KSBRdWVyeUNvbnRleHQoXyBjb250ZXh0LkNvbnRleHQsIHF1ZXJ5IHN0cmluZywgYXJncyAuLi5pbnRlcmZhY2V7fSkgKCpzcWwuUm93cywgZXJyb3IpIHsKCXJldHVybiBxLlF1ZXJ5KHF1ZXJ5LCBhcmdzLi4uKQp9CgovLyBRdWVyeVJvd0NvbnRleHQgZm9yIHF1ZXJ5ZXJDb250ZXh0CmZ1bmMgKHEgcXVlcnllckNvbnRleHQpIFF1ZXJ5Um93Q29udGV4dChfIGNvbnRleHQuQ29udGV4dCwgcXVlcnkgc3RyaW5nLCBhcmdzIC4uLmludGVyZmFjZXt9KSAqc3FsLlJvdyB7CglyZXR1cm4gcS5RdWVyeVJvdyhxdWVyeSwgYXJncy4uLikKfQoKLy8gRXhlY0NvbnRleHQgZm9yIHF1ZXJ5ZXJDb250ZXh0CmZ1bmMgKHEgcXVlcnllckNvbnRleHQpIEV4ZWNDb250ZXh0KF8gY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChzcWwuUmVzdWx0LCBlcnJvcikgewoJcmV0dXJuIHEuRXhlYyhxdWVyeSwgYXJncy4uLikKfQoKLyotLS0tLS0tLS0tLS0tKwp8IFR5cGUgYWxpYXNlcyB8CistLS0tLS0tLS0tLS0tKi8KCi8vIE51bGxGbG9hdDY0IGFsaWFzZXMgc3FsLk51bGxGbG9hdDY0CnR5cGUgTnVsbEZsb2F0NjQgc3FsLk51bGxGbG9hdDY0CgovLyBOdWxsU3RyaW5nIGFsaWFzZXMgc3FsLk51bGxTdHJpbmcKdHlwZSBOdWxsU3RyaW5nIHNxbC5OdWxsU3RyaW5nCgovLyBOdWxsQm9vbCBhbGlhc2VzIHNxbC5OdWxsQm9vbAp0eXBlIE51bGxCb29sIHNxbC5OdWxsQm9vbAoKLy8gTnVsbEludDY0IGFsaWFzZXMgc3FsLk51bGxJbnQ2NAp0eXBlIE51bGxJbnQ2NCBzcWwuTnVsbEludDY0CgovLyBOdWxsVGltZSBhbGlhc2VzIHNxbC5OdWxsVGltZQp0eXBlIE51bGxUaW1lIG15c3FsLk51bGxUaW1lCgovLyBSYXdKU09OIGFsaWFzZXMganNvbi5SYXdNZXNzYWdlCnR5cGUgUmF3SlNPTiBqc29uLlJhd01lc3NhZ2UKCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IE51bGxTdHJpbmcgaW1wbGVtZW50YXRpb25zIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgovLyBNYXJzaGFsSlNPTiBmb3IgTnVsbFN0cmluZwpmdW5jIChuIE51bGxTdHJpbmcpIE1hcnNoYWxKU09OKCkgKFtdYnl0ZSwgZXJyb3IpIHsKCXZhciBhICpzdHJpbmcKCWlmIG4uVmFsaWQgewoJCWEgPSAmbi5TdHJpbmcKCX0KCXJldHVybiBqc29uLk1hcnNoYWwoYSkKfQoKLy8gVW5tYXJzaGFsSlNPTiBmb3IgTnVsbFN0cmluZwpmdW5jIChuICpOdWxsU3RyaW5nKSBVbm1hcnNoYWxKU09OKGIgW11ieXRlKSBlcnJvciB7CglpZiBieXRlcy5FcXVhbEZvbGQoYiwgbnVsbExpdGVyYWwpIHsKCQluLlZhbGlkID0gZmFsc2UKCQlyZXR1cm4gbmlsCgl9CgllcnIgOj0ganNvbi5Vbm1hcnNoYWwoYiwgJm4uU3RyaW5nKQoJbi5WYWxpZCA9IGVyciA9PSBuaWwKCXJldHVybiBlcnIKfQoKLy8gVmFsdWUgZm9yIE51bGxTdHJpbmcKZnVuYyAobiBOdWxsU3RyaW5nKSBWYWx1ZSgpIChkcml2ZXIuVmFsdWUsIGVycm9yKSB7CglpZiAhbi5WYWxpZCB7CgkJcmV0dXJuIG5pbCwgbmlsCgl9CglyZXR1cm4gbi5TdHJpbmcsIG5pbAp9CgovLyBTY2FuIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gKk51bGxTdHJpbmcpIFNjYW4oc3JjIGludGVyZmFjZXt9KSBlcnJvciB7Cgl2YXIgYSBzcWwuTnVsbFN0cmluZwoJaWYgZXJyIDo9IGEuU2NhbihzcmMpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgluLlN0cmluZyA9IGEuU3RyaW5nCglpZiByZWZsZWN0LlR5cGVPZihzcmMpICE9IG5pbCB7CgkJbi5WYWxpZCA9IHRydWUKCX0KCXJldHVybiBuaWwKfQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IE51bGxGbG9hdDY0IGltcGxlbWVudGF0aW9ucyB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIE1hcnNoYWxKU09OIGZvciBOdWxsRmxvYXQ2NApmdW5jIChuIE51bGxGbG9hdDY0KSBNYXJzaGFsSlNPTigpIChbXWJ5dGUsIGVycm9yKSB7Cgl2YXIgYSAqZmxvYXQ2NAoJaWYgbi5WYWxpZCB7CgkJYSA9ICZuLkZsb2F0NjQKCX0KCXJldHVybiBqc29uLk1hcnNoYWwoYSkKfQoKLy8gVmFsdWUgZm9yIE51bGxGbG9hdDY0CmZ1bmMgKG4gTnVsbEZsb2F0NjQpIFZhbHVlKCkgKGRyaXZlci5WYWx1ZSwgZXJyb3IpIHsKCWlmICFuLlZhbGlkIHsKCQlyZXR1cm4gbmlsLCBuaWwKCX0KCXJldHVybiBuLkZsb2F0NjQsIG5pbAp9CgovLyBVbm1hcnNoYWxKU09OIGZvciBOdWxsRmxvYXQ2NApmdW5jIChuICpOdWxsRmxvYXQ2NCkgVW5tYXJzaGFsSlNPTihiIFtdYnl0ZSkgZXJyb3IgewoJaWYgYnl0ZXMuRXF1YWxGb2xkKGIsIG51bGxMaXRlcmFsKSB7CgkJbi5WYWxpZCA9IGZhbHNlCgkJcmV0dXJuIG5pbAoJfQoJZXJyIDo9IGpzb24uVW5tYXJzaGFsKGIsICZuLkZsb2F0NjQpCgluLlZhbGlkID0gZXJyID09IG5pbAoJcmV0dXJuIGVycgp9CgovLyBTY2FuIGZvciBOdWxsRmxvYXQ2NApmdW5jIChuICpOdWxsRmxvYXQ2NCkgU2NhbihzcmMgaW50ZXJmYWNle30pIGVycm9yIHsKCXZhciBhIHNxbC5OdWxsRmxvYXQ2NAoJaWYgZXJyIDo9IGEuU2NhbihzcmMpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgluLkZsb2F0NjQgPSBhLkZsb2F0NjQKCWlmIHJlZmxlY3QuVHlwZU9mKHNyYykgIT0gbmlsIHsKCQluLlZhbGlkID0gdHJ1ZQoJfQoJcmV0dXJuIG5pbAp9CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IE51bGxJbnQ2NCBpbXBsZW1lbnRhdGlvbnMgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKLy8gTWFyc2hhbEpTT04gZm9yIE51bGxJbnQ2NApmdW5jIChuIE51bGxJbnQ2NCkgTWFyc2hhbEpTT04oKSAoW11ieXRlLCBlcnJvcikgewoJdmFyIGEgKmludDY0CglpZiBuLlZhbGlkIHsKCQlhID0gJm4uSW50NjQKCX0KCXJldHVybiBqc29uLk1hcnNoYWwoYSkKfQoKLy8gVmFsdWUgZm9yIE51bGxJbnQ2NApmdW5jIChuIE51bGxJbnQ2NCkgVmFsdWUoKSAoZHJpdmVyLlZhbHVlLCBlcnJvcikgewoJaWYgIW4uVmFsaWQgewoJCXJldHVybiBuaWwsIG5pbAoJfQoJcmV0dXJuIG4uSW50NjQsIG5pbAp9CgovLyBVbm1hcnNoYWxKU09OIGZvciBOdWxsSW50NjQKZnVuYyAobiAqTnVsbEludDY0KSBVbm1hcnNoYWxKU09OKGIgW11ieXRlKSBlcnJvciB7CglpZiBieXRlcy5FcXVhbEZvbGQoYiwgbnVsbExpdGVyYWwpIHsKCQluLlZhbGlkID0gZmFsc2UKCQlyZXR1cm4gbmlsCgl9CgllcnIgOj0ganNvbi5Vbm1hcnNoYWwoYiwgJm4uSW50NjQpCgluLlZhbGlkID0gZXJyID09IG5pbAoJcmV0dXJuIGVycgp9CgovLyBTY2FuIGZvciBOdWxsSW50NjQKZnVuYyAobiAqTnVsbEludDY0KSBTY2FuKHNyYyBpbnRlcmZhY2V7fSkgZXJyb3IgewoJLy8gU2V0IGluaXRpYWwgc3RhdGUgZm9yIHN1YnNlcXVlbnQgc2NhbnMuCgluLlZhbGlkID0gZmFsc2UKCgl2YXIgYSBzcWwuTnVsbEludDY0CglpZiBlcnIgOj0gYS5TY2FuKHNyYyk7IGVyciAhPSBuaWwgewoJCXJldHVybiBlcnIKCX0KCW4uSW50NjQgPSBhLkludDY0CglpZiByZWZsZWN0LlR5cGVPZihzcmMpICE9IG5pbCB7CgkJbi5WYWxpZCA9IHRydWUKCX0KCXJldHVybiBuaWwKfQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IE51bGxCb29sIGltcGxlbWVudGF0aW9ucyB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIE1hcnNoYWxKU09OIGZvciBOdWxsQm9vbApmdW5jIChuIE51bGxCb29sKSBNYXJzaGFsSlNPTigpIChbXWJ5dGUsIGVycm9yKSB7Cgl2YXIgYSAqYm9vbAoJaWYgbi5WYWxpZCB7CgkJYSA9ICZuLkJvb2wKCX0KCXJldHVybiBqc29uLk1hcnNoYWwoYSkKfQoKLy8gVmFsdWUgZm9yIE51bGxCb29sCmZ1bmMgKG4gTnVsbEJvb2wpIFZhbHVlKCkgKGRyaXZlci5WYWx1ZSwgZXJyb3IpIHsKCWlmICFuLlZhbGlkIHsKCQlyZXR1cm4gbmlsLCBuaWwKCX0KCXJldHVybiBuLkJvb2wsIG5pbAp9CgovLyBVbm1hcnNoYWxKU09OIGZvciBOdWxsQm9vbApmdW5jIChuICpOdWxsQm9vbCkgVW5tYXJzaGFsSlNPTihiIFtdYnl0ZSkgZXJyb3IgewoJdmFyIGZpZWxkICpib29sCgllcnIgOj0ganNvbi5Vbm1hcnNoYWwoYiwgJmZpZWxkKQoJaWYgZmllbGQgIT0gbmlsIHsKCQluLlZhbGlkID0gdHJ1ZQoJCW4uQm9vbCA9ICpmaWVsZAoJfQoJcmV0dXJuIGVycgp9CgovLyBTY2FuIGZvciBOdWxsQm9vbApmdW5jIChuICpOdWxsQm9vbCkgU2NhbihzcmMgaW50ZXJmYWNle30pIGVycm9yIHsKCXZhciBhIHNxbC5OdWxsQm9vbAoJaWYgZXJyIDo9IGEuU2NhbihzcmMpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgluLkJvb2wgPSBhLkJvb2wKCWlmIHJlZmxlY3QuVHlwZU9mKHNyYykgIT0gbmlsIHsKCQluLlZhbGlkID0gdHJ1ZQoJfQoJcmV0dXJuIG5pbAp9CgovKi0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgTnVsbFRpbWUgaW1wbGVtZW50YXRpb25zIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKLy8gTWFyc2hhbEpTT04gZm9yIE51bGxUaW1lCmZ1bmMgKG4gTnVsbFRpbWUpIE1hcnNoYWxKU09OKCkgKFtdYnl0ZSwgZXJyb3IpIHsKCXZhciBhICp0aW1lLlRpbWUKCWlmIG4uVmFsaWQgewoJCWEgPSAmbi5UaW1lCgl9CglyZXR1cm4ganNvbi5NYXJzaGFsKGEpCn0KCi8vIFZhbHVlIGZvciBOdWxsVGltZQpmdW5jIChuIE51bGxUaW1lKSBWYWx1ZSgpIChkcml2ZXIuVmFsdWUsIGVycm9yKSB7CglpZiAhbi5WYWxpZCB7CgkJcmV0dXJuIG5pbCwgbmlsCgl9CglyZXR1cm4gbi5UaW1lLCBuaWwKfQoKLy8gVW5tYXJzaGFsSlNPTiBmb3IgTnVsbFRpbWUKZnVuYyAobiAqTnVsbFRpbWUpIFVubWFyc2hhbEpTT04oYiBbXWJ5dGUpIGVycm9yIHsKICAgIHMgOj0gc3RyaW5nKGIpCiAgICBzID0gc3RyaW5ncy5UcmltKHMsIGAiYCkKCiAgICB2YXIgKAogICAgICAgIHplcm9UaW1lIHRpbWUuVGltZQogICAgICAgIHRpbSAgICAgIHRpbWUuVGltZQogICAgICAgIGVyciAgICAgIGVycm9yCiAgICApCgogICAgaWYgc3RyaW5ncy5FcXVhbEZvbGQocywgIm51bGwiKSB7CiAgICAgICAgcmV0dXJuIG5pbAogICAgfQoKICAgIGlmIHRpbSwgZXJyID0gdGltZS5QYXJzZSh0aW1lLlJGQzMzMzksIHMpOyBlcnIgIT0gbmlsIHsKICAgICAgICBuLlZhbGlkID0gZmFsc2UKICAgICAgICByZXR1cm4gZXJyCiAgICB9CgogICAgaWYgdGltID09IHplcm9UaW1lIHsKICAgICAgICByZXR1cm4gbmlsCiAgICB9CgogICAgbi5UaW1lID0gdGltCiAgICBuLlZhbGlkID0gdHJ1ZQogICAgcmV0dXJuIG5pbAp9CgovLyBTY2FuIGZvciBOdWxsVGltZQpmdW5jIChuICpOdWxsVGltZSkgU2NhbihzcmMgaW50ZXJmYWNle30pIGVycm9yIHsKCS8vIFNldCBpbml0aWFsIHN0YXRlIGZvciBzdWJzZXF1ZW50IHNjYW5zLgoJbi5WYWxpZCA9IGZhbHNlCgoJdmFyIGEgbXlzcWwuTnVsbFRpbWUKCWlmIGVyciA6PSBhLlNjYW4oc3JjKTsgZXJyICE9IG5pbCB7CgkJcmV0dXJuIGVycgoJfQoJbi5UaW1lID0gYS5UaW1lCglpZiByZWZsZWN0LlR5cGVPZihzcmMpICE9IG5pbCB7CgkJbi5WYWxpZCA9IHRydWUKCX0KCXJldHVybiBuaWwKfQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgUmF3SlNPTiBpbXBsZW1lbnRhdGlvbnMgfAorLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIE1hcnNoYWxKU09OIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gUmF3SlNPTikgTWFyc2hhbEpTT04oKSAoW11ieXRlLCBlcnJvcikgewoJaWYgbGVuKG4pID09IDAgewoJCXJldHVybiBbXWJ5dGUoIm51bGwiKSwgbmlsCgl9CglhIDo9IGpzb24uUmF3TWVzc2FnZShuKQoJcmV0dXJuIGEuTWFyc2hhbEpTT04oKQp9CgovLyBWYWx1ZSBmb3IgTnVsbFN0cmluZwpmdW5jIChuIFJhd0pTT04pIFZhbHVlKCkgKGRyaXZlci5WYWx1ZSwgZXJyb3IpIHsKCS8vIE15U1FMIHJlamVjdHMgYW4gZW1wdHkgc3RyaW5nIGFzIEpTT04gdGV4dAoJaWYgbGVuKG4pID09IDAgewoJCXJldHVybiBuaWwsIG5pbAoJfQoJcmV0dXJuIHN0cmluZyhuKSwgbmlsCn0KCi8vIFVubWFyc2hhbEpTT04gZm9yIE51bGxTdHJpbmcKZnVuYyAobiAqUmF3SlNPTikgVW5tYXJzaGFsSlNPTihiIFtdYnl0ZSkgZXJyb3IgewoJdmFyIGEganNvbi5SYXdNZXNzYWdlCglpZiBlcnIgOj0ganNvbi5Vbm1hcnNoYWwoYiwgJmEpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9CgljIDo9IFJhd0pTT04oYSkKCSpuID0gYwoJcmV0dXJuIG5pbAp9CgovLyBTY2FuIGZvciBOdWxsU3RyaW5nCmZ1bmMgKG4gKlJhd0pTT04pIFNjYW4oc3JjIGludGVyZmFjZXt9KSBlcnJvciB7Cgl2YXIgYSBzcWwuTnVsbFN0cmluZwoJaWYgZXJyIDo9IGEuU2NhbihzcmMpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gZXJyCgl9Cglqc24gOj0gUmF3SlNPTihbXWJ5dGUoYS5TdHJpbmcpKQoJKm4gPSBqc24KCXJldHVybiBuaWwKfQoKLyotLS0tLS0tLS0tLS0tLS0tLSsKfCBIZWxwZXIgZnVuY3Rpb25zIHwKKy0tLS0tLS0tLS0tLS0tLS0tKi8KCi8vIEVyckR1cGxpY2F0ZUtleSBpcyByZXR1cm5lZCBieSByZXBvc2l0b3JpZXMgd2hlbiBhIHJvdyB3b3VsZCBkdXBsaWNhdGUgYSB1bmlxdWUga2V5IG9mIGFub3RoZXIgcm93Lgp2YXIgRXJyRHVwbGljYXRlS2V5ID0gZXJyb3JzLk5ldygiZHVwbGljYXRlIGtleTogYW5vdGhlciByb3cgaGFzIHRoZSBzYW1lIHVuaXF1ZSBrZXkiKQoKLy8gZHVwbGljYXRlS2V5IHJlcG9ydHMgTXlTUUwgZHVwbGljYXRlIGVudHJ5IGVycm9ycyBhcyBFcnJEdXBsaWNhdGVLZXkuCmZ1bmMgZHVwbGljYXRlS2V5KGVyciBlcnJvcikgZXJyb3IgewoJaWYgZSwgb2sgOj0gZXJyLigqbXlzcWwuTXlTUUxFcnJvcik7IG9rICYmIGUuTnVtYmVyID09IDEwNjIgewoJCXJldHVybiBFcnJEdXBsaWNhdGVLZXkKCX0KCXJldHVybiBlcnIKfQoKLy8gbm93IHJldHVybnMgdGhlIGN1cnJlbnQgdGltZSBpbiBVVEMsIGFzIFVUQ19USU1FU1RBTVAoKSBkb2VzLgpmdW5jIG5vdygpIHRpbWUuVGltZSB7CglyZXR1cm4gdGltZS5Ob3coKS5VVEMoKQp9CgovLyBFcnJTdGFsZU9iamVjdCBpcyByZXR1cm5lZCB3aGVuIHVwZGF0aW5nIGEgcm93IHdoaWNoIHdhcyBjaGFuZ2VkIHNpbmNlIGl0IHdhcyByZWFkLAovLyBhcyBpdHMgdmVyc2lvbiBjb2x1bW4gbm8gbG9uZ2VyIG1hdGNoZXMgdGhlIHZlcnNpb24gb2YgdGhlIG1vZGVsLgp2YXIgRXJyU3RhbGVPYmplY3QgPSBlcnJvcnMuTmV3KCJzdGFsZSBvYmplY3Q6IHRoZSByb3cgd2FzIGNoYW5nZWQgb3IgZGVsZXRlZCBzaW5jZSBpdCB3YXMgcmVhZCIpCgovLyBUb051bGxTdHJpbmcgcmV0dXJucyBhIG5ldyBOdWxsU3RyaW5nCmZ1bmMgVG9OdWxsU3RyaW5nKHMgKnN0cmluZykgTnVsbFN0cmluZyB7CglpZiBzID09IG5pbCB7CgkJcmV0dXJuIE51bGxTdHJpbmcoc3FsLk51bGxTdHJpbmd7VmFsaWQ6IGZhbHNlfSkKCX0KCXJldHVybiBOdWxsU3RyaW5nKHNxbC5OdWxsU3RyaW5ne1N0cmluZzogKnMsIFZhbGlkOiB0cnVlfSkKfQoKLy8gVG9OdWxsSW50NjQgcmV0dXJucyBhIG5ldyBOdWxsSW50NjQKZnVuYyBUb051bGxJbnQ2NChpICppbnQ2NCkgTnVsbEludDY0IHsKCWlmIGkgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbEludDY0KHNxbC5OdWxsSW50NjR7VmFsaWQ6IGZhbHNlfSkKCX0KCXJldHVybiBOdWxsSW50NjQoc3FsLk51bGxJbnQ2NHtJbnQ2NDogKmksIFZhbGlkOiB0cnVlfSkKfQoKLy8gVG9OdWxsRmxvYXQ2NCByZXR1cm5zIGEgbmV3IE51bGxGbG9hdDY0CmZ1bmMgVG9OdWxsRmxvYXQ2NChpICpmbG9hdDY0KSBOdWxsRmxvYXQ2NCB7CglpZiBpID09IG5pbCB7CgkJcmV0dXJuIE51bGxGbG9hdDY0KHNxbC5OdWxsRmxvYXQ2NHtWYWxpZDogZmFsc2V9KQoJfQoJcmV0dXJuIE51bGxGbG9hdDY0KHNxbC5OdWxsRmxvYXQ2NHtGbG9hdDY0OiAqaSwgVmFsaWQ6IHRydWV9KQp9CgovLyBUb051bGxCb29sIGNyZWF0ZXMgYSBuZXcgTnVsbEJvb2wKZnVuYyBUb051bGxCb29sKGIgKmJvb2wpIE51bGxCb29sIHsKCWlmIGIgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbEJvb2woc3FsLk51bGxCb29se1ZhbGlkOiBmYWxzZX0pCgl9CglyZXR1cm4gTnVsbEJvb2woc3FsLk51bGxCb29se0Jvb2w6ICpiLCBWYWxpZDogdHJ1ZX0pCn0KCi8vIFRvTnVsbFRpbWUgY3JlYXRlcyBhIG5ldyBOdWxsVGltZQpmdW5jIFRvTnVsbFRpbWUodCB0aW1lLlRpbWUpIE51bGxUaW1lIHsKCWlmIHQgPT0gZW1wdHlUaW1lIHsKCQlyZXR1cm4gTnVsbFRpbWUobXlzcWwuTnVsbFRpbWV7VmFsaWQ6IGZhbHNlfSkKCX0KCXJldHVybiBOdWxsVGltZShteXNxbC5OdWxsVGltZXtUaW1lOiB0LCBWYWxpZDogdHJ1ZX0pCn0KCi8qLS0tLS0tLS0tLS0tLS0tLSsKfCBCYXRjaCBleGVjdXRpb24gfAorLS0tLS0tLS0tLS0tLS0tLSovCgovLyBNYXhQbGFjZWhvbGRlcnMgaXMgdGhlIG1heGltdW0gbnVtYmVyIG9mIHBsYWNlaG9sZGVycyBNeVNRTAovLyBhY2NlcHRzIGluIGEgc2luZ2xlIHByZXBhcmVkIHN0YXRlbWVudC4KY29uc3QgTWF4UGxhY2Vob2xkZXJzID0gNjU1MzUKCi8vIE1heFBhY2tldFNpemUgaXMgdGhlIGVzdGltYXRlZCBzdGF0ZW1lbnQgc2l6ZSB0aGUgYmF0Y2ggbWV0aG9kcywgc3VjaCBhcyBJbnNlcnRNYW55LAovLyBrZWVwIGVhY2ggY2h1bmsgdW5kZXIuIEl0IGRlZmF1bHRzIHRvIHRoZSBNeVNRTCBkZWZhdWx0IG1heF9hbGxvd2VkX3BhY2tldCBvZiA0TUIsCi8vIHNldCBpdCB0byBtYXRjaCB5b3VyIHNlcnZlciBjb25maWd1cmF0aW9uLgp2YXIgTWF4UGFja2V0U2l6ZSA9IDQgPDwgMjAKCi8vIGV4ZWNCYXRjaCBleGVjdXRlcyBwcmVmaXggZm9sbG93ZWQgYnkgb25lIHJvdyBwZXIgYXJndW1lbnQgc2V0IGFuZCBzdWZmaXgsCi8vIHNwbGl0dGluZyB0aGUgc2V0cyBpbnRvIGFzIGZldyBzdGF0ZW1lbnRzIGFzIE1heFBsYWNlaG9sZGVycyBhbmQgTWF4UGFja2V0U2l6ZSBhbGxvdy4KLy8gVGhlIHJvd3MgYWZmZWN0ZWQgYnkgZXZlcnkgZXhlY3V0ZWQgc3RhdGVtZW50IGFyZSBzdW1tZWQgdXAuCmZ1bmMgZXhlY0JhdGNoKGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBwcmVmaXgsIHJvdywgc3VmZml4IHN0cmluZywgc2V0cyBbXVtdaW50ZXJmYWNle30pIChyb3dzQWZmZWN0ZWQgaW50NjQsIGVyciBlcnJvcikgewoJZm9yIGxlbihzZXRzKSA+IDAgewoJCXZhciAoCgkJCW4gICAgaW50CgkJCWFyZ3MgW11pbnRlcmZhY2V7fQoJCQlzaXplID0gbGVuKHByZWZpeCkgKyBsZW4oc3VmZml4KQoJCSkKCQlmb3IgOyBuIDwgbGVuKHNldHMpOyBuKysgewoJCQlyb3dTaXplIDo9IGxlbihyb3cpICsgbGVuKCIsICIpCgkJCWZvciBfLCBhcmcgOj0gcmFuZ2Ugc2V0c1tuXSB7CgkJCQlyb3dTaXplICs9IGFyZ1NpemUoYXJnKQoJCQl9CgkJCWlmIG4gPiAwICYmIChsZW4oYXJncykrbGVuKHNldHNbbl0pID4gTWF4UGxhY2Vob2xkZXJzIHx8IHNpemUrcm93U2l6ZSA+IE1heFBhY2tldFNpemUpIHsKCQkJCWJyZWFrCgkJCX0KCQkJc2l6ZSArPSByb3dTaXplCgkJCWFyZ3MgPSBhcHBlbmQoYXJncywgc2V0c1tuXS4uLikKCQl9CgoJCXN0bXQgOj0gcHJlZml4ICsgc3RyaW5ncy5SZXBlYXQocm93KyIsICIsIG4tMSkgKyByb3cgKyBzdWZmaXgKCQlyZXN1bHQsIGVyciA6PSBxdS5FeGVjQ29udGV4dChjdHgsIHN0bXQsIGFyZ3MuLi4pCgkJaWYgZXJyICE9IG5pbCB7CgkJCXJldHVybiByb3dzQWZmZWN0ZWQsIGVycgoJCX0KCQlhZmZlY3RlZCwgZXJyIDo9IHJlc3VsdC5Sb3dzQWZmZWN0ZWQoKQoJCWlmIGVyciAhPSBuaWwgewoJCQlyZXR1cm4gcm93c0FmZmVjdGVkLCBlcnIKCQl9CgkJcm93c0FmZmVjdGVkICs9IGFmZmVjdGVkCgkJc2V0cyA9IHNldHNbbjpdCgl9CglyZXR1cm4gcm93c0FmZmVjdGVkLCBuaWwKfQoKLy8gYXJnU2l6ZSBlc3RpbWF0ZXMgdGhlIG51bWJlciBvZiBieXRlcyBhbiBhcmd1bWVudCB0YWtlcyB1cCBpbiBhIHN0YXRlbWVudC4KZnVuYyBhcmdTaXplKGFyZyBpbnRlcmZhY2V7fSkgaW50IHsKCXN3aXRjaCB2IDo9IGFyZy4odHlwZSkgewoJY2FzZSBzdHJpbmc6CgkJcmV0dXJuIGxlbih2KQoJY2FzZSBbXWJ5dGU6CgkJcmV0dXJuIGxlbih2KQoJY2FzZSBSYXdKU09OOgoJCXJldHVybiBsZW4odikKCWNhc2UgTnVsbFN0cmluZzoKCQlyZXR1cm4gbGVuKHYuU3RyaW5nKQoJZGVmYXVsdDoKCQlyZXR1cm4gMTYKCX0KfQoKLyotLS0tLS0rCnwgSG9va3MgfAorLS0tLS0tKi8KCi8vIE1vZGVscyBpbXBsZW1lbnQgdGhlIGhvb2sgaW50ZXJmYWNlcyBpbiBhIGZpbGUgb2YgdGhlaXIgb3duIG5leHQgdG8gdGhlIGdlbmVyYXRlZCBvbmUsIGV4OgovLyAgZnVuYyAodSAqVXNlcikgQmVmb3JlSW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSBlcnJvciB7Ci8vICAJaWYgdS5FbWFpbCA9PSAiIiB7Ci8vICAJCXJldHVybiBlcnJvcnMuTmV3KCJ1c2VyIGVtYWlsIGlzIHJlcXVpcmVkIikKLy8gIAl9Ci8vICAJcmV0dXJuIG5pbAovLyAgfQovLyBBbiBlcnJvciByZXR1cm5lZCBieSBhIEJlZm9yZSBob29rIGFib3J0cyB0aGUgc3RhdGVtZW50LAovLyB3aGlsZSBvbmUgcmV0dXJuZWQgYnkgYW4gQWZ0ZXIgaG9vayBpcyByZXR1cm5lZCBvbmNlIHRoZSBzdGF0ZW1lbnQgd2FzIGV4ZWN1dGVkLgovLyBIb29rcyBnZXQgcGFzc2VkIHRoZSBzYW1lIHF1ZXJ5ZXIgYXMgdGhlIHN0YXRlbWVudCwgdG8gcnVuIHRoZWlyIG93biB3aXRoaW4gdGhlIHNhbWUgdHJhbnNhY3Rpb24uCgovLyBCZWZvcmVJbnNlcnRlciBpcyBpbXBsZW1lbnRlZCBieSBtb2RlbHMgcnVubmluZyBjb2RlIGJlZm9yZSBiZWluZyBpbnNlcnRlZC4KdHlwZSBCZWZvcmVJbnNlcnRlciBpbnRlcmZhY2UgewoJQmVmb3JlSW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSBlcnJvcgp9CgovLyBBZnRlckluc2VydGVyIGlzIGltcGxlbWVudGVkIGJ5IG1vZGVscyBydW5uaW5nIGNvZGUgYWZ0ZXIgYmVpbmcgaW5zZXJ0ZWQuCnR5cGUgQWZ0ZXJJbnNlcnRlciBpbnRlcmZhY2UgewoJQWZ0ZXJJbnNlcnQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQpIGVycm9yCn0KCi8vIEJlZm9yZVVwZGF0ZXIgaXMgaW1wbGVtZW50ZWQgYnkgbW9kZWxzIHJ1bm5pbmcgY29kZSBiZWZvcmUgYmVpbmcgdXBkYXRlZC4KdHlwZSBCZWZvcmVVcGRhdGVyIGludGVyZmFjZSB7CglCZWZvcmVVcGRhdGUoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQpIGVycm9yCn0KCi8vIEFmdGVyVXBkYXRlciBpcyBpbXBsZW1lbnRlZCBieSBtb2RlbHMgcnVubmluZyBjb2RlIGFmdGVyIGJlaW5nIHVwZGF0ZWQuCnR5cGUgQWZ0ZXJVcGRhdGVyIGludGVyZmFjZSB7CglBZnRlclVwZGF0ZShjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgZXJyb3IKfQoKLy8gQmVmb3JlVXBzZXJ0ZXIgaXMgaW1wbGVtZW50ZWQgYnkgbW9kZWxzIHJ1bm5pbmcgY29kZSBiZWZvcmUgYmVpbmcgdXBzZXJ0ZWQuCnR5cGUgQmVmb3JlVXBzZXJ0ZXIgaW50ZXJmYWNlIHsKCUJlZm9yZVVwc2VydChjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCkgZXJyb3IKfQoKLy8gQWZ0ZXJVcHNlcnRlciBpcyBpbXBsZW1lbnRlZCBieSBtb2RlbHMgcnVubmluZyBjb2RlIGFmdGVyIGJlaW5nIHVwc2VydGVkLgp0eXBlIEFmdGVyVXBzZXJ0ZXIgaW50ZXJmYWNlIHsKCUFmdGVyVXBzZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSBlcnJvcgp9CgovLyBCZWZvcmVEZWxldGVyIGlzIGltcGxlbWVudGVkIGJ5IG1vZGVscyBydW5uaW5nIGNvZGUgYmVmb3JlIGJlaW5nIGRlbGV0ZWQuCnR5cGUgQmVmb3JlRGVsZXRlciBpbnRlcmZhY2UgewoJQmVmb3JlRGVsZXRlKGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSBlcnJvcgp9CgovLyBBZnRlckRlbGV0ZXIgaXMgaW1wbGVtZW50ZWQgYnkgbW9kZWxzIHJ1bm5pbmcgY29kZSBhZnRlciBiZWluZyBkZWxldGVkLgp0eXBlIEFmdGVyRGVsZXRlciBpbnRlcmZhY2UgewoJQWZ0ZXJEZWxldGUoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQpIGVycm9yCn0KCmZ1bmMgYmVmb3JlSW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBtb2RlbCBpbnRlcmZhY2V7fSkgZXJyb3IgewoJaWYgaCwgb2sgOj0gbW9kZWwuKEJlZm9yZUluc2VydGVyKTsgb2sgewoJCXJldHVybiBoLkJlZm9yZUluc2VydChjdHgsIHF1KQoJfQoJcmV0dXJuIG5pbAp9CgpmdW5jIGFmdGVySW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBtb2RlbCBpbnRlcmZhY2V7fSkgZXJyb3IgewoJaWYgaCwgb2sgOj0gbW9kZWwuKEFmdGVySW5zZXJ0ZXIpOyBvayB7CgkJcmV0dXJuIGguQWZ0ZXJJbnNlcnQoY3R4LCBxdSkKCX0KCXJldHVybiBuaWwKfQoKZnVuYyBiZWZvcmVVcGRhdGUoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQsIG1vZGVsIGludGVyZmFjZXt9KSBlcnJvciB7CglpZiBoLCBvayA6PSBtb2RlbC4oQmVmb3JlVXBkYXRlcik7IG9rIHsKCQlyZXR1cm4gaC5CZWZvcmVVcGRhdGUoY3R4LCBxdSkKCX0KCXJldHVybiBuaWwKfQoKZnVuYyBhZnRlclVwZGF0ZShjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgbW9kZWwgaW50ZXJmYWNle30pIGVycm9yIHsKCWlmIGgsIG9rIDo9IG1vZGVsLihBZnRlclVwZGF0ZXIpOyBvayB7CgkJcmV0dXJuIGguQWZ0ZXJVcGRhdGUoY3R4LCBxdSkKCX0KCXJldHVybiBuaWwKfQoKZnVuYyBiZWZvcmVVcHNlcnQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQsIG1vZGVsIGludGVyZmFjZXt9KSBlcnJvciB7CglpZiBoLCBvayA6PSBtb2RlbC4oQmVmb3JlVXBzZXJ0ZXIpOyBvayB7CgkJcmV0dXJuIGguQmVmb3JlVXBzZXJ0KGN0eCwgcXUpCgl9CglyZXR1cm4gbmlsCn0KCmZ1bmMgYWZ0ZXJVcHNlcnQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXUgUXVlcnllckNvbnRleHQsIG1vZGVsIGludGVyZmFjZXt9KSBlcnJvciB7CglpZiBoLCBvayA6PSBtb2RlbC4oQWZ0ZXJVcHNlcnRlcik7IG9rIHsKCQlyZXR1cm4gaC5BZnRlclVwc2VydChjdHgsIHF1KQoJfQoJcmV0dXJuIG5pbAp9CgpmdW5jIGJlZm9yZURlbGV0ZShjdHggY29udGV4dC5Db250ZXh0LCBxdSBRdWVyeWVyQ29udGV4dCwgbW9kZWwgaW50ZXJmYWNle30pIGVycm9yIHsKCWlmIGgsIG9rIDo9IG1vZGVsLihCZWZvcmVEZWxldGVyKTsgb2sgewoJCXJldHVybiBoLkJlZm9yZURlbGV0ZShjdHgsIHF1KQoJfQoJcmV0dXJuIG5pbAp9CgpmdW5jIGFmdGVyRGVsZXRlKGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0LCBtb2RlbCBpbnRlcmZhY2V7fSkgZXJyb3IgewoJaWYgaCwgb2sgOj0gbW9kZWwuKEFmdGVyRGVsZXRlcik7IG9rIHsKCQlyZXR1cm4gaC5BZnRlckRlbGV0ZShjdHgsIHF1KQoJfQoJcmV0dXJuIG5pbAp9CgovKi0tLS0tLS0tLS0tKwp8IFZhbGlkYXRpb24gfAorLS0tLS0tLS0tLS0qLwoKLy8gRmllbGRFcnJvciBkZXNjcmliZXMgYSBmaWVsZCB3aG9zZSB2YWx1ZSBkb2VzIG5vdCBmaXQgaXRzIGNvbHVtbi4KdHlwZSBGaWVsZEVycm9yIHN0cnVjdCB7CglGaWVsZCAgIHN0cmluZwoJQ29sdW1uICBzdHJpbmcKCU1lc3NhZ2Ugc3RyaW5nCn0KCmZ1bmMgKGUgRmllbGRFcnJvcikgRXJyb3IoKSBzdHJpbmcgewoJcmV0dXJuIGUuQ29sdW1uICsgIiAiICsgZS5NZXNzYWdlCn0KCi8vIFZhbGlkYXRpb25FcnJvcnMgaXMgcmV0dXJuZWQgYnkgVmFsaWRhdGUsIGxpc3RpbmcgZXZlcnkgZmllbGQgd2hvc2UgdmFsdWUgZG9lcyBub3QgZml0IGl0cyBjb2x1bW4uCnR5cGUgVmFsaWRhdGlvbkVycm9ycyBbXUZpZWxkRXJyb3IKCmZ1bmMgKGVycnMgVmFsaWRhdGlvbkVycm9ycykgRXJyb3IoKSBzdHJpbmcgewoJbXNncyA6PSBtYWtlKFtdc3RyaW5nLCBsZW4oZXJycykpCglmb3IgaSwgZSA6PSByYW5nZSBlcnJzIHsKCQltc2dzW2ldID0gZS5FcnJvcigpCgl9CglyZXR1cm4gImludmFsaWQgdmFsdWVzOiAiICsgc3RyaW5ncy5Kb2luKG1zZ3MsICIsICIpCn0KCi8vIGNoYXJMZW5ndGggY291bnRzIGNoYXJhY3RlcnMgdGhlIHdheSBDSEFSX0xFTkdUSCgpIGRvZXMgZm9yIHV0ZjggY29sdW1ucy4KZnVuYyBjaGFyTGVuZ3RoKHMgc3RyaW5nKSBpbnQgewoJcmV0dXJuIHV0ZjguUnVuZUNvdW50SW5TdHJpbmcocykKfQoKLy8gb25lT2YgcmVwb3J0cyB3aGV0aGVyIHZhbHVlIGlzIG9uZSBvZiB0aGUgbWVtYmVycyBvZiBhbiBlbnVtIGNvbHVtbiwKLy8gd2hpY2ggY29tcGFyZSBjYXNlIGluc2Vuc2l0aXZlbHkgbGlrZSB0aGUgY29sdW1ucyBvZiBtb3N0IGNvbGxhdGlvbnMuCmZ1bmMgb25lT2YodmFsdWUgc3RyaW5nLCBtZW1iZXJzIC4uLnN0cmluZykgYm9vbCB7Cglmb3IgXywgbSA6PSByYW5nZSBtZW1iZXJzIHsKCQlpZiBzdHJpbmdzLkVxdWFsRm9sZCh2YWx1ZSwgbSkgewoJCQlyZXR1cm4gdHJ1ZQoJCX0KCX0KCXJldHVybiBmYWxzZQp9CgovLyBzZXRPZiByZXBvcnRzIHdoZXRoZXIgdmFsdWUgaXMgYSBjb21tYSBzZXBhcmF0ZWQgbGlzdCBvZiB0aGUgbWVtYmVycyBvZiBhIHNldCBjb2x1bW4uCmZ1bmMgc2V0T2YodmFsdWUgc3RyaW5nLCBtZW1iZXJzIC4uLnN0cmluZykgYm9vbCB7CglpZiB2YWx1ZSA9PSAiIiB7CgkJcmV0dXJuIHRydWUKCX0KCWZvciBfLCB2IDo9IHJhbmdlIHN0cmluZ3MuU3BsaXQodmFsdWUsICIsIikgewoJCWlmICFvbmVPZih2LCBtZW1iZXJzLi4uKSB7CgkJCXJldHVybiBmYWxzZQoJCX0KCX0KCXJldHVybiB0cnVlCn0KCi8vIGV4Y2VlZHNEaWdpdHMgcmVwb3J0cyB3aGV0aGVyIGYgaGFzIG1vcmUgdGhhbiB0aGUgZ2l2ZW4gbnVtYmVyIG9mIGRpZ2l0cyBiZWZvcmUgdGhlIGRlY2ltYWwgcG9pbnQuCmZ1bmMgZXhjZWVkc0RpZ2l0cyhmIGZsb2F0NjQsIGRpZ2l0cyBpbnQpIGJvb2wgewoJcmV0dXJuIG1hdGguQWJzKGYpID49IG1hdGguUG93MTAoZGlnaXRzKQp9CgovLyBUeE9wdGlvbnMgZGVmaW5lcyBhbiBvcHRpb24gdHlwZSBmb3IgY29uZmlndXJpbmcKLy8gdHJhbnNhdGlvbnMuIFRoaXMgbWF5IG9ubHkgYmUgdXNlZCB3aXRoIHRoZSBFeGVjdXRlVHJhbnNhY3Rpb24gd3JhcHBlci4KdHlwZSBUeE9wdGlvbnMgc3RydWN0IHsKCVRpbWVvdXQgICB0aW1lLkR1cmF0aW9uCglJc29sYXRpb24gc3FsLklzb2xhdGlvbkxldmVsCglSZWFkT25seSAgYm9vbAp9CgovLyBFeGVjdXRlVHJhbnNhY3Rpb24gY2xvc2VzIG92ZXIgYSB0cmFuc2FjdGlvbiBhbmQgYXV0b21hdGljYWxseSBjb21taXRzCi8vIG9yIHJvbGxiYWNrcyBkZXBlbmRpbmcgb24gd2hldGhlciBlcnJvcnMgd2VyZSBlbmNvdW50ZXJlZC4KLy8gSW4gdGhlIGNhc2Ugd2hlcmUgbmlsIGlzIHBhc3NlZCBmb3Igb3B0ICgqVHhPcHRpb24pLCB0aGUgZm9sbG93aW5nIGRlZmF1bHRzIGFyZSB1c2VkOgovLyAgJlR4T3B0aW9uc3sKLy8gIAlUaW1lb3V0OiAgIDUgKiB0aW1lLlNlY29uZCwKLy8gIAlJc29sYXRpb246IHNxbC5MZXZlbFNlcmlhbGl6YWJsZSwKLy8gIAlSZWFkT25seTogIGZhbHNlLAovLyAgfQpmdW5jIEV4ZWN1dGVUcmFuc2FjdGlvbihkYiAqc3FsLkRCLCBvcHQgKlR4T3B0aW9ucywgYWN0aW9ucyBmdW5jKCpzcWwuVHgpIGVycm9yKSAoZXJyIGVycm9yKSB7CgkvLyBQcm92aWRlIHNhZmUgZGVmYXVsdHMgaW4gY2FzZSBub25lIHdlcmUgZ2l2ZW4uCglpZiBvcHQgPT0gbmlsIHsKCQlvcHQgPSAmVHhPcHRpb25zewoJCQlUaW1lb3V0OiAgIDUgKiB0aW1lLlNlY29uZCwKCQkJSXNvbGF0aW9uOiBzcWwuTGV2ZWxTZXJpYWxpemFibGUsCgkJCVJlYWRPbmx5OiAgZmFsc2UsCgkJfQoJfQoKCS8vIEJ1aWxkIHRoZSBjb250ZXh0IHdpdGggdGhlIHByb3ZpZGVkIHRpbWVvdXQuCgkvLyBUaGlzIHdpbGwgYmUgdXNlZCB0byBkZWZpbmUgdGhlIHRvdGFsIHRpbWUgdGhlIHRyYW5zYWN0aW9uIG1heSB0YWtlLAoJLy8gcGFzdCB0aGlzIHRpbWUsIGl0IHdpbGwgYmUgY2FuY2VsbGVkLCByb2xsYmFjaywgdGhlbiB0aHJvdyBhbiBlcnJvci4KCWN0eCwgY2FuY2VsIDo9IGNvbnRleHQuV2l0aFRpbWVvdXQoY29udGV4dC5CYWNrZ3JvdW5kKCksIG9wdC5UaW1lb3V0KQoJZGVmZXIgY2FuY2VsKCkKCgl2YXIgdHggKnNxbC5UeAoJaWYgdHgsIGVyciA9IGRiLkJlZ2luVHgoY3R4LCAmc3FsLlR4T3B0aW9uc3sKCQlJc29sYXRpb246IG9wdC5Jc29sYXRpb24sCgkJUmVhZE9ubHk6ICBvcHQuUmVhZE9ubHksCgl9KTsgZXJyICE9IG5pbCB7CgkJcmV0dXJuIGVycgoJfQoKCWRlZmVyIGZ1bmMoKSB7CgkJaWYgciA6PSByZWNvdmVyKCk7IHIgIT0gbmlsIHsKCQkJLy8gT25seSBuZWVkIHRvIGxvZyBoZXJlIGJlY2F1c2UgcGFuaWMgd29uJ3QgcmVwb3J0IHdoZXRoZXIKCQkJLy8gdGhlIHJvbGxiYWNrIHdhcyBzdWNjZXNzZnVsIG9yIG5vdC4KCQkJaWYgdHhlcnIgOj0gdHguUm9sbGJhY2soKTsgdHhlcnIgIT0gbmlsIHsKCQkJCWxvZy5QcmludGxuKCJkYiByb2xsYmFjayBlcnJvcjoiLCB0eGVycikKCQkJfQoKCQkJbG9nLlByaW50Zigicm9sbGVkIGJhY2sgdHJhbnNhY3Rpb24iKQoJCQlwYW5pYyhyKQoJCX0gZWxzZSBpZiBlcnIgIT0gbmlsIHsKCQkJLy8gSWYgd2UgcnVuIGludG8gaXNzdWVzIHJvbGxpbmcgYmFjaywga2VlcCB0cmFjayBvZiB0aGUgZXJyb3IgdGhhdAoJCQkvLyBjYXVzZWQgdGhlIGlzc3VlIGFuZCBwcm92aWRlIHNvbWUgY29udGV4dCBvbiB0aGUgcm9sbGJhY2sgZmFpbHVyZS4KCQkJaWYgcmVyciA6PSB0eC5Sb2xsYmFjaygpOyByZXJyICE9IG5pbCB7CgkJCQllcnIgPSBmbXQuRXJyb3JmKCJkYiBlcnJvcjogJXYgcm9sbGJhY2sgZXJyb3I6ICV2IiwgZXJyLCByZXJyKQoJCQl9CgkJfSBlbHNlIHsKCQkJaWYgY2VyciA6PSB0eC5Db21taXQoKTsgY2VyciAhPSBuaWwgewoJCQkJZXJyID0gZm10LkVycm9yZigiY29tbWl0IGVycm9yOiAldiIsIGNlcnIpCgkJCX0KCQl9Cgl9KCkKCgllcnIgPSBhY3Rpb25zKHR4KQoJcmV0dXJuIGVycgp9Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "x_helpers_test.html", "\"e3tkZWZpbmUgImhlbHBlcnN0ZXN0In19Ci8vK2J1aWxkICFoZWxwZXJzCgpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImNvbnRleHQiCgkiZGF0YWJhc2Uvc3FsIgoJImRhdGFiYXNlL3NxbC9kcml2ZXIiCgkiZW5jb2RpbmcvanNvbiIKCSJyZWZsZWN0IgoJInN0cmluZ3MiCgkidGVzdGluZyIKCSJ0aW1lIgoKCSJnaXRodWIuY29tL2dvLXNxbC1kcml2ZXIvbXlzcWwiCikKCmZ1bmMgVGVzdFN0cnVjdEVtYmVkZGluZyh0ICp0ZXN0aW5nLlQpIHsKCXRpbSA6PSB0aW1lLkRhdGUoMjAxNywgMSwgMSwgMCwgMCwgMCwgMCwgdGltZS5VVEMpCglleHBlY3RlZCA6PSBbXWJ5dGUoYHsiYSI6MTIzLCJiIjp0cnVlLCJjIjoxMjMuMTIzLCJkIjoic3RyaW5nIiwiZSI6IjIwMTctMDEtMDFUMDA6MDA6MDBaIiwiZiI6WzEsMiwzXX1gKQoJdHlwZSBlbWJlZCBzdHJ1Y3QgewoJCUEgTnVsbEludDY0ICAgYGpzb246ImEsb21pdGVtcHR5ImAKCQlCIE51bGxCb29sICAgIGBqc29uOiJiLG9taXRlbXB0eSJgCgkJQyBOdWxsRmxvYXQ2NCBganNvbjoiYyxvbWl0ZW1wdHkiYAoJCUQgTnVsbFN0cmluZyAgYGpzb246ImQsb21pdGVtcHR5ImAKCQlFIE51bGxUaW1lICAgIGBqc29uOiJlLG9taXRlbXB0eSJgCgkJRiBSYXdKU09OICAgICBganNvbjoiZixvbWl0ZW1wdHkiYAoJfQoJZW0gOj0gZW1iZWR7CgkJQTogTnVsbEludDY0e1ZhbGlkOiB0cnVlLCBJbnQ2NDogMTIzfSwKCQlCOiBOdWxsQm9vbHtWYWxpZDogdHJ1ZSwgQm9vbDogdHJ1ZX0sCgkJQzogTnVsbEZsb2F0NjR7VmFsaWQ6IHRydWUsIEZsb2F0NjQ6IDEyMy4xMjN9LAoJCUQ6IE51bGxTdHJpbmd7VmFsaWQ6IHRydWUsIFN0cmluZzogInN0cmluZyJ9LAoJCUU6IE51bGxUaW1le1ZhbGlkOiB0cnVlLCBUaW1lOiB0aW19LAoJCUY6IFJhd0pTT04oYFsxLDIsM11gKSwKCX0KCWIsIGVyciA6PSBqc29uLk1hcnNoYWwoZW0pCglpZiBlcnIgIT0gbmlsIHsKCQl0LkZhdGFsKGVycikKCX0KCWlmICFyZWZsZWN0LkRlZXBFcXVhbChleHBlY3RlZCwgYikgewoJCXQuRmF0YWwoIm5vdCB0aGUgc2FtZSBKU09OISIpCgl9CglpZiAhKHN0cmluZyhiKSA9PSBzdHJpbmcoZXhwZWN0ZWQpKSB7CgkJdC5GYXRhbCgibm90IHRoZSBzYW1lISIpCgl9CgoJdmFyIGVtMiBlbWJlZAoJaWYgZXJyIDo9IGpzb24uVW5tYXJzaGFsKGV4cGVjdGVkLCAmZW0yKTsgZXJyICE9IG5pbCB7CgkJdC5GYXRhbChlcnIpCgl9CglpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZW0yLCBlbSkgewoJCXQuRmF0YWwoIm5vdCBjb3JyZWN0IikKCX0KfQoKZnVuYyBUZXN0TnVsbFN0cmluZ19Vbm1hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBOdWxsU3RyaW5nCgkJc291cmNlICBbXWJ5dGUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAgICAiZXhwbGljaXQgbnVsbCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgInN0cmluZyBudWxsIiwKCQkJc291cmNlOiAgW11ieXRlKGAibnVsbCJgKSwKCQkJd2FudEVycjogZmFsc2UsIC8vIHRoaXMgb25lIFNIT1VMRCBiZSB2YWxpZAoJCX0sCgkJewoJCQluYW1lOiAgICAidmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYCJoZWxsbyJgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJpbnZhbGlkIiwKCQkJc291cmNlOiAgW11ieXRlKGB7ImtleSI6InZhbHVlIn1gKSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImVtcHR5IiwKCQkJc291cmNlOiAgW11ieXRle30sCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uVW5tYXJzaGFsSlNPTih0dC5zb3VyY2UpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFN0cmluZy5Vbm1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxTdHJpbmdfVmFsdWUodCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgIE51bGxTdHJpbmcKCQl3YW50ICAgIGRyaXZlci5WYWx1ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46IE51bGxTdHJpbmd7CgkJCQlWYWxpZDogIHRydWUsCgkJCQlTdHJpbmc6ICJoZWxsbyIsCgkJCX0sCgkJCXdhbnQ6ICAgIGRyaXZlci5WYWx1ZSgiaGVsbG8iKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogTnVsbFN0cmluZ3sKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJd2FudDogICAgbmlsLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLlZhbHVlKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxTdHJpbmcuVmFsdWUoKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQkJcmV0dXJuCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgdHQud2FudCkgewoJCQkJdC5FcnJvcmYoIk51bGxTdHJpbmcuVmFsdWUoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbFN0cmluZ19TY2FuKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICAqTnVsbFN0cmluZwoJCXdhbnRFcnIgYm9vbAoJCXNyYyAgICAgaW50ZXJmYWNle30KCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbFN0cmluZ3sKCQkJCVN0cmluZzogImhlbGxvIiwKCQkJCVZhbGlkOiAgdHJ1ZSwKCQkJfSwKCQkJc3JjOiAgICAgIiIsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAibmlsIHZhbHVlIiwKCQkJbjogJk51bGxTdHJpbmd7CgkJCQlTdHJpbmc6ICJoZWxsbyIsCgkJCQlWYWxpZDogIGZhbHNlLAoJCQl9LAoJCQlzcmM6ICAgICBuaWwsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWlmIGVyciA6PSB0dC5uLlNjYW4odHQuc3JjKTsgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxTdHJpbmcuU2NhbigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQkJaWYgIXR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IG5pbCB7CgkJCQl0LkVycm9yZigic2hvdWxkIHJldHVybiBudWxsIikKCQkJfQoJCQlpZiB0dC5uLlZhbGlkICYmIHR0LnNyYyAhPSB0dC5uLlN0cmluZyB7CgkJCQl0LkVycm9yZigiaW52YWxpZCB2YWx1ZSIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsU3RyaW5nX01hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICAqTnVsbFN0cmluZwoJCXdhbnQgICAgW11ieXRlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxTdHJpbmd7CgkJCQlTdHJpbmc6ICJoZWxsbyIsCgkJCQlWYWxpZDogIHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgImhlbGxvImApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogInZhbGlkIG51bGwiLAoJCQluOiAmTnVsbFN0cmluZ3sKCQkJCVN0cmluZzogIiIsCgkJCQlWYWxpZDogIGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogJk51bGxTdHJpbmd7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGAiImApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLk1hcnNoYWxKU09OKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxTdHJpbmcuTWFyc2hhbEpTT04oKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQkJcmV0dXJuCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgdHQud2FudCkgewoJCQkJdC5FcnJvcmYoIk51bGxTdHJpbmcuTWFyc2hhbEpTT04oKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbEJvb2xfVW5tYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgICAgICAgc3RyaW5nCgkJbiAgICAgICAgICAgIE51bGxCb29sCgkJc291cmNlICAgICAgIFtdYnl0ZQoJCXdhbnRFcnIgICAgICBib29sCgkJd2FudFZhbGlkaXR5IGJvb2wKCX17CgkJewoJCQluYW1lOiAgICAgICAgICJleHBsaWNpdCBudWxsIiwKCQkJc291cmNlOiAgICAgICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogICAgICBmYWxzZSwKCQkJd2FudFZhbGlkaXR5OiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgICAgICAidmFsaWQiLAoJCQlzb3VyY2U6ICAgICAgIFtdYnl0ZShgZmFsc2VgKSwKCQkJd2FudEVycjogICAgICBmYWxzZSwKCQkJd2FudFZhbGlkaXR5OiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAgICAgICJpbnZhbGlkIiwKCQkJc291cmNlOiAgICAgICBbXWJ5dGUoYHsia2V5IjoidmFsdWUifWApLAoJCQl3YW50RXJyOiAgICAgIHRydWUsCgkJCXdhbnRWYWxpZGl0eTogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICAgICAgImVtcHR5IiwKCQkJc291cmNlOiAgICAgICBbXWJ5dGV7fSwKCQkJd2FudEVycjogICAgICB0cnVlLAoJCQl3YW50VmFsaWRpdHk6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAgICAgICAgICJleHBsaWNpdCBudWxsIiwKCQkJc291cmNlOiAgICAgICBbXWJ5dGUoIm51bGwiKSwKCQkJd2FudEVycjogICAgICBmYWxzZSwKCQkJd2FudFZhbGlkaXR5OiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5Vbm1hcnNoYWxKU09OKHR0LnNvdXJjZSk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyICYmIHR0Lm4uVmFsaWQgPT0gdHQud2FudFZhbGlkaXR5IHsKCQkJCXQuRXJyb3JmKCJOdWxsQm9vbC5Vbm1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxCb29sX1ZhbHVlKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBOdWxsQm9vbAoJCXdhbnQgICAgZHJpdmVyLlZhbHVlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogTnVsbEJvb2x7CgkJCQlWYWxpZDogdHJ1ZSwKCQkJCUJvb2w6ICB0cnVlLAoJCQl9LAoJCQl3YW50OiAgICBkcml2ZXIuVmFsdWUodHJ1ZSksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAiaW52YWxpZCIsCgkJCW46IE51bGxCb29sewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBuaWwsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uVmFsdWUoKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEJvb2wuVmFsdWUoKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQkJcmV0dXJuCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgdHQud2FudCkgewoJCQkJdC5FcnJvcmYoIk51bGxCb29sLlZhbHVlKCkgPSAldiwgd2FudCAldiIsIGdvdCwgdHQud2FudCkKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxCb29sX1NjYW4odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsQm9vbAoJCXdhbnRFcnIgYm9vbAoJCXNyYyAgICAgaW50ZXJmYWNle30KCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbEJvb2x7CgkJCQlCb29sOiAgdHJ1ZSwKCQkJCVZhbGlkOiB0cnVlLAoJCQl9LAoJCQlzcmM6ICAgICB0cnVlLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogIm5pbCB2YWx1ZSIsCgkJCW46ICZOdWxsQm9vbHsKCQkJCUJvb2w6ICB0cnVlLAoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQlzcmM6ICAgICBmYWxzZSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uU2Nhbih0dC5zcmMpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEJvb2wuU2NhbigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQkJaWYgIXR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IG5pbCB7CgkJCQl0LkVycm9yZigic2hvdWxkIHJldHVybiBudWxsIikKCQkJfQoJCQlpZiB0dC5uLlZhbGlkICYmIHR0LnNyYyAhPSB0dC5uLkJvb2wgewoJCQkJdC5FcnJvcmYoImludmFsaWQgdmFsdWUiKQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbEJvb2xfTWFyc2hhbEpTT04odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsQm9vbAoJCXdhbnQgICAgW11ieXRlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxCb29sewoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgZmFsc2VgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJ2YWxpZCBudWxsIiwKCQkJbjogJk51bGxCb29sewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogJk51bGxCb29sewoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgZmFsc2VgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJZ290LCBlcnIgOj0gdHQubi5NYXJzaGFsSlNPTigpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsQm9vbC5NYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbEJvb2wuTWFyc2hhbEpTT04oKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbFRpbWVfVW5tYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbFRpbWUKCQlzb3VyY2UgIFtdYnl0ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICAgICJ2YWxpZCIsCgkJCXNvdXJjZTogIFtdYnl0ZShgIjIwMTctMTEtMjRUMDA6MDA6MDBaImApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImludmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYHsia2V5IjoidmFsdWUifWApLAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiZW1wdHkiLAoJCQlzb3VyY2U6ICBbXWJ5dGV7fSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImV4cGxpY2l0IG51bGwiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uVW5tYXJzaGFsSlNPTih0dC5zb3VyY2UpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEJvb2wuVW5tYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsVGltZV9WYWx1ZSh0ICp0ZXN0aW5nLlQpIHsKCXRpbSA6PSB0aW1lLk5vdygpCgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgIE51bGxUaW1lCgkJd2FudCAgICBkcml2ZXIuVmFsdWUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiBOdWxsVGltZXsKCQkJCVZhbGlkOiB0cnVlLAoJCQkJVGltZTogIHRpbSwKCQkJfSwKCQkJd2FudDogICAgZHJpdmVyLlZhbHVlKHRpbSksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAiaW52YWxpZCIsCgkJCW46IE51bGxUaW1lewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBuaWwsCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgl9Cglmb3IgXywgdHQgOj0gcmFuZ2UgdGVzdHMgewoJCXQuUnVuKHR0Lm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCWdvdCwgZXJyIDo9IHR0Lm4uVmFsdWUoKQoJCQlpZiAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFRpbWUuVmFsdWUoKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQkJcmV0dXJuCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgdHQud2FudCkgewoJCQkJdC5FcnJvcmYoIk51bGxUaW1lLlZhbHVlKCkgPSAldiwgd2FudCAldiIsIGdvdCwgdHQud2FudCkKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxUaW1lX1NjYW4odCAqdGVzdGluZy5UKSB7Cgl0aW0gOj0gdGltZS5Ob3coKQoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICAqTnVsbFRpbWUKCQl3YW50RXJyIGJvb2wKCQlzcmMgICAgIGludGVyZmFjZXt9Cgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxUaW1lewoJCQkJVGltZTogIHRpbSwKCQkJCVZhbGlkOiB0cnVlLAoJCQl9LAoJCQlzcmM6ICAgICB0aW0sCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAibmlsIHZhbHVlIiwKCQkJbjogJk51bGxUaW1lewoJCQkJVGltZTogIHRpbSwKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJc3JjOiAgICAgdGltZS5Ob3coKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uU2Nhbih0dC5zcmMpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbFRpbWUuU2NhbigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQkJaWYgIXR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IG5pbCB7CgkJCQl0LkVycm9yZigic2hvdWxkIHJldHVybiBudWxsIikKCQkJfQoJCQlpZiB0dC5uLlZhbGlkICYmIHR0LnNyYyAhPSB0dC5uLlRpbWUgewoJCQkJdC5FcnJvcmYoImludmFsaWQgdmFsdWUiKQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbFRpbWVfTWFyc2hhbEpTT04odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsVGltZQoJCXdhbnQgICAgW11ieXRlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogJk51bGxUaW1lewoJCQkJVGltZTogIHRpbWUuRGF0ZSgyMDE3LCAxMSwgMjQsIDAsIDAsIDAsIDAsIHRpbWUuVVRDKSwKCQkJCVZhbGlkOiB0cnVlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYCIyMDE3LTExLTI0VDAwOjAwOjAwWiJgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJ2YWxpZCBudWxsIiwKCQkJbjogJk51bGxUaW1lewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYG51bGxgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogJk51bGxUaW1lewoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgIjAwMDEtMDEtMDFUMDA6MDA6MDBaImApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLk1hcnNoYWxKU09OKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxUaW1lLk1hcnNoYWxKU09OKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJCXJldHVybgoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChnb3QsIHR0LndhbnQpIHsKCQkJCXQuRXJyb3JmKCJOdWxsVGltZS5NYXJzaGFsSlNPTigpID0gJXYsIHdhbnQgJXYiLCBnb3QsIHR0LndhbnQpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsSW50NjRfVW5tYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbEludDY0CgkJc291cmNlICBbXWJ5dGUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAgICAidmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYDEyM2ApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImludmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYHsia2V5IjoidmFsdWUifWApLAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAiZW1wdHkiLAoJCQlzb3VyY2U6ICBbXWJ5dGV7fSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5Vbm1hcnNoYWxKU09OKHR0LnNvdXJjZSk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsSW50NjQuVW5tYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsSW50NjRfVmFsdWUodCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgIE51bGxJbnQ2NAoJCXdhbnQgICAgZHJpdmVyLlZhbHVlCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogInZhbGlkIiwKCQkJbjogTnVsbEludDY0ewoJCQkJVmFsaWQ6IHRydWUsCgkJCQlJbnQ2NDogMTIzLAoJCQl9LAoJCQl3YW50OiAgICBkcml2ZXIuVmFsdWUoaW50NjQoMTIzKSksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAiaW52YWxpZCIsCgkJCW46IE51bGxJbnQ2NHsKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJd2FudDogICAgbmlsLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLlZhbHVlKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxJbnQ2NC5WYWx1ZSgpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbEludDY0LlZhbHVlKCkgPSAldiwgd2FudCAldiIsIGdvdCwgdHQud2FudCkKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdE51bGxJbnQ2NF9TY2FuKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICAqTnVsbEludDY0CgkJd2FudEVyciBib29sCgkJc3JjICAgICBpbnRlcmZhY2V7fQoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46ICZOdWxsSW50NjR7CgkJCQlJbnQ2NDogMTIzLAoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXNyYzogICAgIGludDY0KDEyMyksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAibmlsIHZhbHVlIiwKCQkJbjogJk51bGxJbnQ2NHsKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJc3JjOiAgICAgaW50NjQoMTIzKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uU2Nhbih0dC5zcmMpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEludDY0LlNjYW4oKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQl9CgkJCWlmICF0dC5uLlZhbGlkICYmIHR0LnNyYyAhPSBuaWwgewoJCQkJdC5FcnJvcmYoInNob3VsZCByZXR1cm4gbnVsbCIpCgkJCX0KCQkJaWYgdHQubi5WYWxpZCAmJiB0dC5zcmMgIT0gdHQubi5JbnQ2NCB7CgkJCQl0LkVycm9yZigiaW52YWxpZCB2YWx1ZSIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsSW50NjRfTWFyc2hhbEpTT04odCAqdGVzdGluZy5UKSB7Cgl0ZXN0cyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQluICAgICAgICpOdWxsSW50NjQKCQl3YW50ICAgIFtdYnl0ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46ICZOdWxsSW50NjR7CgkJCQlJbnQ2NDogMTIzLAoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgMTIzYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAidmFsaWQgbnVsbCIsCgkJCW46ICZOdWxsSW50NjR7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgbnVsbGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJCXsKCQkJbmFtZTogImludmFsaWQiLAoJCQluOiAmTnVsbEludDY0ewoJCQkJVmFsaWQ6IHRydWUsCgkJCX0sCgkJCXdhbnQ6ICAgIFtdYnl0ZShgMGApLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLk1hcnNoYWxKU09OKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIk51bGxJbnQ2NC5NYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbEludDY0Lk1hcnNoYWxKU09OKCkgPSAldiwgd2FudCAldiIsIGdvdCwgdHQud2FudCkKCQkJfQoJCX0pCgl9Cn0KZnVuYyBUZXN0TnVsbEZsb2F0NjRfVW5tYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbEZsb2F0NjQKCQlzb3VyY2UgIFtdYnl0ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICAgICJleHBsaWNpdCBudWxsIiwKCQkJc291cmNlOiAgW11ieXRlKGBudWxsYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAgICAidmFsaWQiLAoJCQlzb3VyY2U6ICBbXWJ5dGUoYDEyMy4xMjNgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJpbnZhbGlkIiwKCQkJc291cmNlOiAgW11ieXRlKGB7ImtleSI6InZhbHVlIn1gKSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImVtcHR5IiwKCQkJc291cmNlOiAgW11ieXRle30sCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJaWYgZXJyIDo9IHR0Lm4uVW5tYXJzaGFsSlNPTih0dC5zb3VyY2UpOyAoZXJyICE9IG5pbCkgIT0gdHQud2FudEVyciB7CgkJCQl0LkVycm9yZigiTnVsbEZsb2F0NjQuVW5tYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsRmxvYXQ2NF9WYWx1ZSh0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgTnVsbEZsb2F0NjQKCQl3YW50ICAgIGRyaXZlci5WYWx1ZQoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46IE51bGxGbG9hdDY0ewoJCQkJVmFsaWQ6ICAgdHJ1ZSwKCQkJCUZsb2F0NjQ6IDEyMy4xMjMsCgkJCX0sCgkJCXdhbnQ6ICAgIGRyaXZlci5WYWx1ZShmbG9hdDY0KDEyMy4xMjMpKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICJpbnZhbGlkIiwKCQkJbjogTnVsbEZsb2F0NjR7CgkJCQlWYWxpZDogZmFsc2UsCgkJCX0sCgkJCXdhbnQ6ICAgIG5pbCwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJZ290LCBlcnIgOj0gdHQubi5WYWx1ZSgpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsRmxvYXQ2NC5WYWx1ZSgpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbEZsb2F0NjQuVmFsdWUoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0TnVsbEZsb2F0NjRfU2Nhbih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgKk51bGxGbG9hdDY0CgkJd2FudEVyciBib29sCgkJc3JjICAgICBpbnRlcmZhY2V7fQoJfXsKCQl7CgkJCW5hbWU6ICJ2YWxpZCIsCgkJCW46ICZOdWxsRmxvYXQ2NHsKCQkJCUZsb2F0NjQ6IDEyMy4xMjMsCgkJCQlWYWxpZDogICB0cnVlLAoJCQl9LAoJCQlzcmM6ICAgICBmbG9hdDY0KDEyMyksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAibmlsIHZhbHVlIiwKCQkJbjogJk51bGxGbG9hdDY0ewoJCQkJVmFsaWQ6IGZhbHNlLAoJCQl9LAoJCQlzcmM6ICAgICBmbG9hdDY0KDEyMy4xMjMpLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlpZiBlcnIgOj0gdHQubi5TY2FuKHR0LnNyYyk7IChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsRmxvYXQ2NC5TY2FuKCkgZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgdHQud2FudEVycikKCQkJfQoJCQlpZiAhdHQubi5WYWxpZCAmJiB0dC5zcmMgIT0gbmlsIHsKCQkJCXQuRXJyb3JmKCJzaG91bGQgcmV0dXJuIG51bGwiKQoJCQl9CgkJCWlmIHR0Lm4uVmFsaWQgJiYgdHQuc3JjICE9IHR0Lm4uRmxvYXQ2NCB7CgkJCQl0LkVycm9yZigiaW52YWxpZCB2YWx1ZSIpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3ROdWxsRmxvYXQ2NF9NYXJzaGFsSlNPTih0ICp0ZXN0aW5nLlQpIHsKCXRlc3RzIDo9IFtdc3RydWN0IHsKCQluYW1lICAgIHN0cmluZwoJCW4gICAgICAgKk51bGxGbG9hdDY0CgkJd2FudCAgICBbXWJ5dGUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAidmFsaWQiLAoJCQluOiAmTnVsbEZsb2F0NjR7CgkJCQlGbG9hdDY0OiAxMjMuMTIzLAoJCQkJVmFsaWQ6ICAgdHJ1ZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGAxMjMuMTIzYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAidmFsaWQgbnVsbCIsCgkJCW46ICZOdWxsRmxvYXQ2NHsKCQkJCVZhbGlkOiBmYWxzZSwKCQkJfSwKCQkJd2FudDogICAgW11ieXRlKGBudWxsYCksCgkJCXdhbnRFcnI6IGZhbHNlLAoJCX0sCgkJewoJCQluYW1lOiAiaW52YWxpZCIsCgkJCW46ICZOdWxsRmxvYXQ2NHsKCQkJCVZhbGlkOiB0cnVlLAoJCQl9LAoJCQl3YW50OiAgICBbXWJ5dGUoYDBgKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCX0KCWZvciBfLCB0dCA6PSByYW5nZSB0ZXN0cyB7CgkJdC5SdW4odHQubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJZ290LCBlcnIgOj0gdHQubi5NYXJzaGFsSlNPTigpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSB0dC53YW50RXJyIHsKCQkJCXQuRXJyb3JmKCJOdWxsRmxvYXQ2NC5NYXJzaGFsSlNPTigpIGVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIHR0LndhbnRFcnIpCgkJCQlyZXR1cm4KCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoZ290LCB0dC53YW50KSB7CgkJCQl0LkVycm9yZigiTnVsbEZsb2F0NjQuTWFyc2hhbEpTT04oKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0VG9OdWxsQm9vbCh0ICp0ZXN0aW5nLlQpIHsKCWIgOj0gdHJ1ZQoJYmIgOj0gVG9OdWxsQm9vbCgmYikKCWlmICFiYi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIHZhbGlkLCBnb3QgJXYiLCBiYi5WYWxpZCkKCX0KCWlmICFiYi5Cb29sIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgdHJ1ZSwgZ290ICV2IiwgYmIuQm9vbCkKCX0KCgl2YXIgYjIgKmJvb2wKCWJiMiA6PSBUb051bGxCb29sKGIyKQoJaWYgYmIyLlZhbGlkIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgbm90IHZhbGlkLCBnb3QgJXYiLCBiYjIuVmFsaWQpCgl9CglpZiBiYjIuQm9vbCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIGZhbHNlLCBnb3QgJXYiLCBiYjIuQm9vbCkKCX0KfQpmdW5jIFRlc3RUb051bGxJbnQ2NCh0ICp0ZXN0aW5nLlQpIHsKCWIgOj0gaW50NjQoMTIzKQoJYmIgOj0gVG9OdWxsSW50NjQoJmIpCglpZiAhYmIuVmFsaWQgewoJCXQuRXJyb3JmKCJleHBlY3RlZCB2YWxpZCwgZ290ICV2IiwgYmIuVmFsaWQpCgl9CglpZiBiYi5JbnQ2NCAhPSAxMjMgewoJCXQuRXJyb3JmKCJleHBlY3RlZCAxMjMsIGdvdCAldiIsIGJiLkludDY0KQoJfQoKCXZhciBiMiAqaW50NjQKCWJiMiA6PSBUb051bGxJbnQ2NChiMikKCWlmIGJiMi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIG5vdCB2YWxpZCwgZ290ICV2IiwgYmIyLlZhbGlkKQoJfQoJaWYgYmIyLkludDY0ICE9IDAgewoJCXQuRXJyb3JmKCJleHBlY3RlZCAwLCBnb3QgJXYiLCBiYjIuSW50NjQpCgl9Cn0KCmZ1bmMgVGVzdFRvTnVsbEZsb2F0NjQodCAqdGVzdGluZy5UKSB7CgliIDo9IGZsb2F0NjQoMTIzLjEyMykKCWJiIDo9IFRvTnVsbEZsb2F0NjQoJmIpCglpZiAhYmIuVmFsaWQgewoJCXQuRXJyb3JmKCJleHBlY3RlZCB2YWxpZCwgZ290ICV2IiwgYmIuVmFsaWQpCgl9CglpZiBiYi5GbG9hdDY0ICE9IDEyMy4xMjMgewoJCXQuRXJyb3JmKCJleHBlY3RlZCAxMjMuMTIzLCBnb3QgJXYiLCBiYi5GbG9hdDY0KQoJfQoKCXZhciBiMiAqZmxvYXQ2NAoJYmIyIDo9IFRvTnVsbEZsb2F0NjQoYjIpCglpZiBiYjIuVmFsaWQgewoJCXQuRXJyb3JmKCJleHBlY3RlZCBub3QgdmFsaWQsIGdvdCAldiIsIGJiMi5WYWxpZCkKCX0KCWlmIGJiMi5GbG9hdDY0ICE9IDAgewoJCXQuRXJyb3JmKCJleHBlY3RlZCAwLCBnb3QgJXYiLCBiYjIuRmxvYXQ2NCkKCX0KfQpmdW5jIFRlc3RUb051bGxTdHJpbmcodCAqdGVzdGluZy5UKSB7CgliIDo9ICJxd2UiCgliYiA6PSBUb051bGxTdHJpbmcoJmIpCglpZiAhYmIuVmFsaWQgewoJCXQuRXJyb3JmKCJleHBlY3RlZCB2YWxpZCwgZ290ICV2IiwgYmIuVmFsaWQpCgl9CglpZiBiYi5TdHJpbmcgIT0gInF3ZSIgewoJCXQuRXJyb3JmKCJleHBlY3RlZCBxd2UsIGdvdCAldiIsIGJiLlN0cmluZykKCX0KCgl2YXIgYjIgKnN0cmluZwoJYmIyIDo9IFRvTnVsbFN0cmluZyhiMikKCWlmIGJiMi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIG5vdCB2YWxpZCwgZ290ICV2IiwgYmIyLlZhbGlkKQoJfQoJaWYgYmIyLlN0cmluZyAhPSAiIiB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIDxlbXB0eSBzdHJpbmc+LCBnb3QgJXYiLCBiYjIuU3RyaW5nKQoJfQp9CmZ1bmMgVGVzdFRvTnVsbFRpbWUodCAqdGVzdGluZy5UKSB7Cgl0aW0gOj0gdGltZS5Ob3coKQoJYmIgOj0gVG9OdWxsVGltZSh0aW0pCglpZiAhYmIuVmFsaWQgewoJCXQuRXJyb3JmKCJleHBlY3RlZCB2YWxpZCwgZ290ICV2IiwgYmIuVmFsaWQpCgl9CglpZiBiYi5UaW1lICE9IHRpbSB7CgkJdC5FcnJvcmYoImV4cGVjdGVkICV2LCBnb3QgJXYiLCB0aW0sIGJiLlRpbWUpCgl9CgoJdGltID0gdGltZS5UaW1le30KCWJiID0gVG9OdWxsVGltZSh0aW0pCglpZiBiYi5WYWxpZCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIGludmFsaWQsIGdvdCAldiIsIGJiLlZhbGlkKQoJfQoJaWYgYmIuVGltZSAhPSB0aW0gewoJCXQuRXJyb3JmKCJleHBlY3RlZCAldiwgZ290ICV2IiwgdGltLCBiYi5UaW1lKQoJfQp9CgpmdW5jIFRlc3RSYXdKU09OX01hcnNoYWxKU09OKHQgKnRlc3RpbmcuVCkgewoJY2FzZXMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgc3RyaW5nCgkJZGF0YSBbXWJ5dGUKCQlleHAgIHN0cmluZwoJfXsKCQl7CgkJCW5hbWU6ICJlbXB0eSBkYXRhIiwKCQkJZGF0YTogW11ieXRle30sCgkJCWV4cDogICJudWxsIiwKCQl9LAoJfQoKCWZvciBfLCBjIDo9IHJhbmdlIGNhc2VzIHsKCQl0LlJ1bihjLm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCXJqIDo9IFJhd0pTT04oYy5kYXRhKQoJCQliLCBlcnIgOj0gcmouTWFyc2hhbEpTT04oKQoJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCXQuRmF0YWxmKCJ1bmV4cGVjdGVkIGVycm9yOiAldiIsIGVycikKCQkJfQoJCQlpZiBzdHJpbmcoYikgIT0gYy5leHAgewoJCQkJdC5GYXRhbGYoIlxuZXhwOiAlcVxuZ290OiAlcSIsIGMuZXhwLCBzdHJpbmcoYikpCgkJCX0KCQl9KQoJfQp9CgpmdW5jIFRlc3RSYXdKU09OX1ZhbHVlKHQgKnRlc3RpbmcuVCkgewoJdGVzdHMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJbiAgICAgICBSYXdKU09OCgkJd2FudCAgICBkcml2ZXIuVmFsdWUKCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAgICAib2JqZWN0IiwKCQkJbjogICAgICAgUmF3SlNPTihgeyJhIjoxfWApLAoJCQl3YW50OiAgICBkcml2ZXIuVmFsdWUoYHsiYSI6MX1gKSwKCQkJd2FudEVycjogZmFsc2UsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJlbXB0eSIsCgkJCW46ICAgICAgIFJhd0pTT057fSwKCQkJd2FudDogICAgbmlsLAoJCQl3YW50RXJyOiBmYWxzZSwKCQl9LAoJfQoJZm9yIF8sIHR0IDo9IHJhbmdlIHRlc3RzIHsKCQl0LlJ1bih0dC5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlnb3QsIGVyciA6PSB0dC5uLlZhbHVlKCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IHR0LndhbnRFcnIgewoJCQkJdC5FcnJvcmYoIlJhd0pTT04uVmFsdWUoKSBlcnJvciA9ICV2LCB3YW50RXJyICV2IiwgZXJyLCB0dC53YW50RXJyKQoJCQkJcmV0dXJuCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKGdvdCwgdHQud2FudCkgewoJCQkJdC5FcnJvcmYoIlJhd0pTT04uVmFsdWUoKSA9ICV2LCB3YW50ICV2IiwgZ290LCB0dC53YW50KQoJCQl9CgkJfSkKCX0KfQoKLy8gcmVjb3JkaW5nUXVlcnllciByZWNvcmRzIHRoZSBzdGF0ZW1lbnRzIGV4ZWN1dGVkIGFnYWluc3QgaXQsCi8vIHJlcG9ydGluZyBvbmUgYWZmZWN0ZWQgcm93IHBlciBwbGFjZWhvbGRlciBzZXQuCnR5cGUgcmVjb3JkaW5nUXVlcnllciBzdHJ1Y3QgewoJc3RtdHMgW11zdHJpbmcKCWFyZ3MgIFtdW11pbnRlcmZhY2V7fQp9CgpmdW5jIChxICpyZWNvcmRpbmdRdWVyeWVyKSBRdWVyeUNvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXVlcnkgc3RyaW5nLCBhcmdzIC4uLmludGVyZmFjZXt9KSAoKnNxbC5Sb3dzLCBlcnJvcikgewoJcGFuaWMoIm5vdCBpbXBsZW1lbnRlZCIpCn0KCmZ1bmMgKHEgKnJlY29yZGluZ1F1ZXJ5ZXIpIFF1ZXJ5Um93Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pICpzcWwuUm93IHsKCXBhbmljKCJub3QgaW1wbGVtZW50ZWQiKQp9CgpmdW5jIChxICpyZWNvcmRpbmdRdWVyeWVyKSBFeGVjQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChzcWwuUmVzdWx0LCBlcnJvcikgewoJcS5zdG10cyA9IGFwcGVuZChxLnN0bXRzLCBxdWVyeSkKCXEuYXJncyA9IGFwcGVuZChxLmFyZ3MsIGFyZ3MpCglyZXR1cm4gZHJpdmVyLlJvd3NBZmZlY3RlZChzdHJpbmdzLkNvdW50KHF1ZXJ5LCAiKD8iKSksIG5pbAp9CgpmdW5jIFRlc3RFeGVjQmF0Y2godCAqdGVzdGluZy5UKSB7CglkZWZlciBmdW5jKHNpemUgaW50KSB7IE1heFBhY2tldFNpemUgPSBzaXplIH0oTWF4UGFja2V0U2l6ZSkKCgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICAgICBzdHJpbmcKCQlwYWNrZXRTaXplIGludAoJCXNldHMgICAgICAgW11bXWludGVyZmFjZXt9CgkJZXhwU3RtdHMgICBbXXN0cmluZwoJfXsKCQl7CgkJCW5hbWU6ICAgICAgICJzaW5nbGUgc3RhdGVtZW50IiwKCQkJcGFja2V0U2l6ZTogNCA8PCAyMCwKCQkJc2V0czogICAgICAgW11bXWludGVyZmFjZXt9eyB7ImEiLCAxfSwgeyJiIiwgMn0sIHsiYyIsIDN9IH0sCgkJCWV4cFN0bXRzOiBbXXN0cmluZ3sKCQkJCSJJTlNFUlQgSU5UTyB0IChhLCBiKSBWQUxVRVMgKD8sID8pLCAoPywgPyksICg/LCA/KSIsCgkJCX0sCgkJfSwKCQl7CgkJCW5hbWU6ICAgICAgICJjaHVua2VkIGJ5IHBhY2tldCBzaXplIiwKCQkJcGFja2V0U2l6ZTogMTAwLAoJCQlzZXRzOiAgICAgICBbXVtdaW50ZXJmYWNle317IHtzdHJpbmdzLlJlcGVhdCgiYSIsIDIwKSwgMX0sIHtzdHJpbmdzLlJlcGVhdCgiYiIsIDIwKSwgMn0sIHsiYyIsIDN9IH0sCgkJCWV4cFN0bXRzOiBbXXN0cmluZ3sKCQkJCSJJTlNFUlQgSU5UTyB0IChhLCBiKSBWQUxVRVMgKD8sID8pIiwKCQkJCSJJTlNFUlQgSU5UTyB0IChhLCBiKSBWQUxVRVMgKD8sID8pLCAoPywgPykiLAoJCQl9LAoJCX0sCgkJewoJCQluYW1lOiAgICAgICAib3ZlcnNpemVkIHJvdyIsCgkJCXBhY2tldFNpemU6IDEwLAoJCQlzZXRzOiAgICAgICBbXVtdaW50ZXJmYWNle317IHsiYSIsIDF9LCB7ImIiLCAyfSB9LAoJCQlleHBTdG10czogW11zdHJpbmd7CgkJCQkiSU5TRVJUIElOVE8gdCAoYSwgYikgVkFMVUVTICg/LCA/KSIsCgkJCQkiSU5TRVJUIElOVE8gdCAoYSwgYikgVkFMVUVTICg/LCA/KSIsCgkJCX0sCgkJfSwKCQl7CgkJCW5hbWU6ICAgICAgICJjaHVua2VkIGJ5IHBsYWNlaG9sZGVycyIsCgkJCXBhY2tldFNpemU6IDEgPDwgMzAsCgkJCXNldHM6ICAgICAgIG1ha2UoW11bXWludGVyZmFjZXt9LCBNYXhQbGFjZWhvbGRlcnMvMisxKSwKCQkJZXhwU3RtdHM6IFtdc3RyaW5newoJCQkJIklOU0VSVCBJTlRPIHQgKGEsIGIpIFZBTFVFUyAiICsgc3RyaW5ncy5SZXBlYXQoIig/LCA/KSwgIiwgTWF4UGxhY2Vob2xkZXJzLzItMSkgKyAiKD8sID8pIiwKCQkJCSJJTlNFUlQgSU5UTyB0IChhLCBiKSBWQUxVRVMgKD8sID8pIiwKCQkJfSwKCQl9LAoJfQoKCWZvciBfLCBjIDo9IHJhbmdlIGNhc2VzIHsKCQl0LlJ1bihjLm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCU1heFBhY2tldFNpemUgPSBjLnBhY2tldFNpemUKCQkJZm9yIGkgOj0gcmFuZ2UgYy5zZXRzIHsKCQkJCWlmIGMuc2V0c1tpXSA9PSBuaWwgewoJCQkJCWMuc2V0c1tpXSA9IFtdaW50ZXJmYWNle317IngiLCBpfQoJCQkJfQoJCQl9CgoJCQlxdSA6PSAmcmVjb3JkaW5nUXVlcnllcnt9CgkJCWFmZmVjdGVkLCBlcnIgOj0gZXhlY0JhdGNoKGNvbnRleHQuQmFja2dyb3VuZCgpLCBxdSwgIklOU0VSVCBJTlRPIHQgKGEsIGIpIFZBTFVFUyAiLCAiKD8sID8pIiwgIiIsIGMuc2V0cykKCQkJaWYgZXJyICE9IG5pbCB7CgkJCQl0LkZhdGFsZigidW5leHBlY3RlZCBlcnJvcjogJXYiLCBlcnIpCgkJCX0KCQkJaWYgYWZmZWN0ZWQgIT0gaW50NjQobGVuKGMuc2V0cykpIHsKCQkJCXQuRXJyb3JmKCJleHBlY3RlZCAlZCByb3dzIGFmZmVjdGVkLCBnb3QgJWQiLCBsZW4oYy5zZXRzKSwgYWZmZWN0ZWQpCgkJCX0KCQkJaWYgIXJlZmxlY3QuRGVlcEVxdWFsKHF1LnN0bXRzLCBjLmV4cFN0bXRzKSB7CgkJCQl0LkZhdGFsZigiXG5leHA6ICVxXG5nb3Q6ICVxIiwgYy5leHBTdG10cywgcXUuc3RtdHMpCgkJCX0KCQkJdmFyIGFyZ3MgaW50CgkJCWZvciBfLCBhIDo9IHJhbmdlIHF1LmFyZ3MgewoJCQkJYXJncyArPSBsZW4oYSkKCQkJfQoJCQlpZiBhcmdzICE9IDIqbGVuKGMuc2V0cykgewoJCQkJdC5FcnJvcmYoImV4cGVjdGVkICVkIGFyZ3MsIGdvdCAlZCIsIDIqbGVuKGMuc2V0cyksIGFyZ3MpCgkJCX0KCQl9KQoJfQp9Cgp0eXBlIGhvb2tlZCBzdHJ1Y3QgewoJY2FsbHMgW11zdHJpbmcKCWVyciAgIGVycm9yCn0KCmZ1bmMgKGggKmhvb2tlZCkgQmVmb3JlSW5zZXJ0KGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSBlcnJvciB7CgloLmNhbGxzID0gYXBwZW5kKGguY2FsbHMsICJiZWZvcmUgaW5zZXJ0IikKCXJldHVybiBoLmVycgp9CgpmdW5jIChoICpob29rZWQpIEFmdGVyRGVsZXRlKGN0eCBjb250ZXh0LkNvbnRleHQsIHF1IFF1ZXJ5ZXJDb250ZXh0KSBlcnJvciB7CgloLmNhbGxzID0gYXBwZW5kKGguY2FsbHMsICJhZnRlciBkZWxldGUiKQoJcmV0dXJuIGguZXJyCn0KCmZ1bmMgVGVzdEhvb2tzKHQgKnRlc3RpbmcuVCkgewoJY3R4LCBxdSA6PSBjb250ZXh0LkJhY2tncm91bmQoKSwgJnJlY29yZGluZ1F1ZXJ5ZXJ7fQoJaCA6PSAmaG9va2Vke30KCXJ1biA6PSBbXWZ1bmMoY29udGV4dC5Db250ZXh0LCBRdWVyeWVyQ29udGV4dCwgaW50ZXJmYWNle30pIGVycm9yewoJCWJlZm9yZUluc2VydCwgYWZ0ZXJJbnNlcnQsIGJlZm9yZVVwZGF0ZSwgYWZ0ZXJVcGRhdGUsCgkJYmVmb3JlVXBzZXJ0LCBhZnRlclVwc2VydCwgYmVmb3JlRGVsZXRlLCBhZnRlckRlbGV0ZSwKCX0KCWZvciBfLCBob29rIDo9IHJhbmdlIHJ1biB7CgkJaWYgZXJyIDo9IGhvb2soY3R4LCBxdSwgaCk7IGVyciAhPSBuaWwgewoJCQl0LkZhdGFsZigidW5leHBlY3RlZCBlcnJvcjogJXYiLCBlcnIpCgkJfQoJfQoJaWYgZXhwIDo9IFtdc3RyaW5neyJiZWZvcmUgaW5zZXJ0IiwgImFmdGVyIGRlbGV0ZSJ9OyAhcmVmbGVjdC5EZWVwRXF1YWwoaC5jYWxscywgZXhwKSB7CgkJdC5FcnJvcmYoIlxuZXhwOiAldlxuZ290OiAldiIsIGV4cCwgaC5jYWxscykKCX0KCgloLmVyciA9IHNxbC5FcnJOb1Jvd3MKCWlmIGVyciA6PSBiZWZvcmVJbnNlcnQoY3R4LCBxdSwgaCk7IGVyciAhPSBzcWwuRXJyTm9Sb3dzIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgdGhlIGhvb2sgZXJyb3IsIGdvdCAldiIsIGVycikKCX0KCWlmIGVyciA6PSBiZWZvcmVJbnNlcnQoY3R4LCBxdSwgc3RydWN0e317fSk7IGVyciAhPSBuaWwgewoJCXQuRXJyb3JmKCJleHBlY3RlZCBubyBlcnJvciB3aXRob3V0IGhvb2tzLCBnb3QgJXYiLCBlcnIpCgl9Cn0KCmZ1bmMgVGVzdER1cGxpY2F0ZUtleSh0ICp0ZXN0aW5nLlQpIHsKCWlmIGVyciA6PSBkdXBsaWNhdGVLZXkoJm15c3FsLk15U1FMRXJyb3J7TnVtYmVyOiAxMDYyLCBNZXNzYWdlOiAiRHVwbGljYXRlIGVudHJ5In0pOyBlcnIgIT0gRXJyRHVwbGljYXRlS2V5IHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgRXJyRHVwbGljYXRlS2V5LCBnb3QgJXYiLCBlcnIpCgl9CglvdGhlciA6PSAmbXlzcWwuTXlTUUxFcnJvcntOdW1iZXI6IDExNDYsIE1lc3NhZ2U6ICJUYWJsZSBkb2Vzbid0IGV4aXN0In0KCWlmIGVyciA6PSBkdXBsaWNhdGVLZXkob3RoZXIpOyBlcnIgIT0gb3RoZXIgewoJCXQuRXJyb3JmKCJleHBlY3RlZCB0aGUgb3JpZ2luYWwgZXJyb3IsIGdvdCAldiIsIGVycikKCX0KCWlmIGVyciA6PSBkdXBsaWNhdGVLZXkobmlsKTsgZXJyICE9IG5pbCB7CgkJdC5FcnJvcmYoImV4cGVjdGVkIG5vIGVycm9yLCBnb3QgJXYiLCBlcnIpCgl9Cn0KCmZ1bmMgVGVzdFNldE9mKHQgKnRlc3RpbmcuVCkgewoJY2FzZXMgOj0gW11zdHJ1Y3QgewoJCXZhbHVlIHN0cmluZwoJCWV4cCAgIGJvb2wKCX17CgkJeyIiLCB0cnVlfSwKCQl7ImEiLCB0cnVlfSwKCQl7ImEsQiIsIHRydWV9LAoJCXsiYSxjIiwgZmFsc2V9LAoJCXsiYSwiLCBmYWxzZX0sCgl9Cglmb3IgXywgYyA6PSByYW5nZSBjYXNlcyB7CgkJaWYgZ290IDo9IHNldE9mKGMudmFsdWUsICJhIiwgImIiKTsgZ290ICE9IGMuZXhwIHsKCQkJdC5FcnJvcmYoInNldE9mKCVxKTogZXhwZWN0ZWQgJXYsIGdvdCAldiIsIGMudmFsdWUsIGMuZXhwLCBnb3QpCgkJfQoJfQp9CgpmdW5jIFRlc3RWYWxpZGF0aW9uRXJyb3JzKHQgKnRlc3RpbmcuVCkgewoJZXJycyA6PSBWYWxpZGF0aW9uRXJyb3JzewoJCXtGaWVsZDogIkVtYWlsIiwgQ29sdW1uOiAiZW1haWwiLCBNZXNzYWdlOiAibXVzdCBiZSBhdCBtb3N0IDI1NSBjaGFyYWN0ZXJzIn0sCgkJe0ZpZWxkOiAiQWdlIiwgQ29sdW1uOiAiYWdlIiwgTWVzc2FnZTogIm11c3QgYmUgYmV0d2VlbiAwIGFuZCAyNTUifSwKCX0KCWV4cCA6PSAiaW52YWxpZCB2YWx1ZXM6IGVtYWlsIG11c3QgYmUgYXQgbW9zdCAyNTUgY2hhcmFjdGVycywgYWdlIG11c3QgYmUgYmV0d2VlbiAwIGFuZCAyNTUiCglpZiBnb3QgOj0gZXJycy5FcnJvcigpOyBnb3QgIT0gZXhwIHsKCQl0LkVycm9yZigiZXhwZWN0ZWQgJXEsIGdvdCAlcSIsIGV4cCwgZ290KQoJfQp9Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "x_http.html", "\"e3tkZWZpbmUgImh0dHBoZWxwZXJzIn19CgpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImRhdGFiYXNlL3NxbCIKCSJlbmNvZGluZy9qc29uIgoJImxvZyIKCSJuZXQvaHR0cCIKCSJzdHJjb252IgoJInN0cmluZ3MiCikKCi8qLS0tLS0tLS0tLS0tLS0rCnwgSFRUUCBoYW5kbGVycyB8CistLS0tLS0tLS0tLS0tLSovCgpjb25zdCAoCgkvLyBEZWZhdWx0SFRUUFBhZ2VTaXplIGlzIHRoZSBudW1iZXIgb2Ygcm93cyBsaXN0ZWQgd2hlbiB0aGUgbGltaXQgcGFyYW1ldGVyIGlzIG1pc3NpbmcuCglEZWZhdWx0SFRUUFBhZ2VTaXplID0gMjAKCS8vIE1heEhUVFBQYWdlU2l6ZSBpcyB0aGUgbGFyZ2VzdCBudW1iZXIgb2Ygcm93cyBsaXN0ZWQgYXQgb25jZS4KCU1heEhUVFBQYWdlU2l6ZSA9IDEwMAoJLy8gbWF4Qm9keVNpemUgY2FwcyB0aGUgc2l6ZSBvZiB0aGUgcmVxdWVzdCBib2RpZXMgZGVjb2RlZCBieSB0aGUgaGFuZGxlcnMuCgltYXhCb2R5U2l6ZSA9IDEgPDwgMjAKKQoKLy8gaHR0cEVycm9yIGlzIGFuIGVycm9yIHJlc3BvbmRlZCB3aXRoIGFzIGlzLCB3aXRoIGl0cyBzdGF0dXMgY29kZS4KdHlwZSBodHRwRXJyb3Igc3RydWN0IHsKCXN0YXR1cyBpbnQKCW1zZyAgICBzdHJpbmcKfQoKZnVuYyAoZSBodHRwRXJyb3IpIEVycm9yKCkgc3RyaW5nIHsKCXJldHVybiBlLm1zZwp9CgovLyBodHRwUGFnZSBpcyB0aGUgcmVzcG9uc2Ugb2YgbGlzdGluZyByb3dzLgp0eXBlIGh0dHBQYWdlIHN0cnVjdCB7CglSb3dzICAgIGludGVyZmFjZXt9IGBqc29uOiJyb3dzImAKCU5leHQgICAgc3RyaW5nICAgICAgYGpzb246Im5leHQiYAoJSGFzTW9yZSBib29sICAgICAgICBganNvbjoiaGFzX21vcmUiYAp9CgovLyBodHRwRmllbGRFcnJvciBpcyB0aGUgcmVzcG9uc2UgZGVzY3JpYmluZyBhIGZpZWxkIGZhaWxpbmcgdmFsaWRhdGlvbi4KdHlwZSBodHRwRmllbGRFcnJvciBzdHJ1Y3QgewoJRmllbGQgICBzdHJpbmcgYGpzb246ImZpZWxkImAKCU1lc3NhZ2Ugc3RyaW5nIGBqc29uOiJtZXNzYWdlImAKfQoKLy8gaHR0cEVycm9yQm9keSBpcyB0aGUgcmVzcG9uc2Ugb2YgYSBmYWlsZWQgcmVxdWVzdC4KdHlwZSBodHRwRXJyb3JCb2R5IHN0cnVjdCB7CglFcnJvciAgc3RyaW5nICAgICAgICAgICBganNvbjoiZXJyb3IiYAoJRmllbGRzIFtdaHR0cEZpZWxkRXJyb3IgYGpzb246ImZpZWxkcyxvbWl0ZW1wdHkiYAp9CgovLyByb3V0ZUlEIHNwbGl0cyB0aGUgcGF0aCBvZiBhIHJlcXVlc3QgdW5kZXIgcHJlZml4IGludG8gYSByb3V0ZSB0byB0aGUgcm93cywKLy8gcHJlZml4IGl0c2VsZiwgb3IgdG8gdGhlIHJvdyB3aXRoIHRoZSBpZCBmb2xsb3dpbmcgaXQuIEl0IHJlcG9ydHMgZmFsc2Ugd2hlbgovLyB0aGUgcGF0aCBtYXRjaGVzIG5laXRoZXIuCmZ1bmMgcm91dGVJRChwcmVmaXgsIHBhdGggc3RyaW5nKSAoaWQgaW50NjQsIGl0ZW0gYm9vbCwgb2sgYm9vbCkgewoJaWYgIXN0cmluZ3MuSGFzUHJlZml4KHBhdGgsIHByZWZpeCkgewoJCXJldHVybiAwLCBmYWxzZSwgZmFsc2UKCX0KCXJlc3QgOj0gc3RyaW5ncy5UcmltKHBhdGhbbGVuKHByZWZpeCk6XSwgIi8iKQoJaWYgcmVzdCA9PSAiIiB7CgkJcmV0dXJuIDAsIGZhbHNlLCBwYXRoID09IHByZWZpeCB8fCBwYXRoID09IHByZWZpeCsiLyIKCX0KCWlmIHBhdGhbbGVuKHByZWZpeCldICE9ICcvJyB8fCBzdHJpbmdzLkNvbnRhaW5zKHJlc3QsICIvIikgewoJCXJldHVybiAwLCBmYWxzZSwgZmFsc2UKCX0KCWlkLCBlcnIgOj0gc3RyY29udi5QYXJzZUludChyZXN0LCAxMCwgNjQpCglpZiBlcnIgIT0gbmlsIHx8IGlkIDw9IDAgewoJCXJldHVybiAwLCBmYWxzZSwgZmFsc2UKCX0KCXJldHVybiBpZCwgdHJ1ZSwgdHJ1ZQp9CgovLyBwYWdlQXJncyByZWFkcyB0aGUgY3Vyc29yIGFuZCBsaW1pdCBxdWVyeSBwYXJhbWV0ZXJzIG9mIGEgcmVxdWVzdCBsaXN0aW5nIHJvd3MuCmZ1bmMgcGFnZUFyZ3MociAqaHR0cC5SZXF1ZXN0KSAoY3Vyc29yIHN0cmluZywgbiBpbnQsIGVyciBlcnJvcikgewoJcSA6PSByLlVSTC5RdWVyeSgpCgluID0gRGVmYXVsdEhUVFBQYWdlU2l6ZQoJaWYgbGltaXQgOj0gcS5HZXQoImxpbWl0Iik7IGxpbWl0ICE9ICIiIHsKCQluLCBlcnIgPSBzdHJjb252LkF0b2kobGltaXQpCgkJaWYgZXJyICE9IG5pbCB8fCBuIDwgMSB7CgkJCXJldHVybiAiIiwgMCwgaHR0cEVycm9ye2h0dHAuU3RhdHVzQmFkUmVxdWVzdCwgImxpbWl0IG11c3QgYmUgYSBwb3NpdGl2ZSBpbnRlZ2VyIn0KCQl9CgkJaWYgbiA+IE1heEhUVFBQYWdlU2l6ZSB7CgkJCW4gPSBNYXhIVFRQUGFnZVNpemUKCQl9Cgl9CglyZXR1cm4gcS5HZXQoImN1cnNvciIpLCBuLCBuaWwKfQoKLy8gZGVjb2RlSlNPTiBkZWNvZGVzIHRoZSBKU09OIGJvZHkgb2YgYSByZXF1ZXN0IGludG8gdi4KZnVuYyBkZWNvZGVKU09OKHcgaHR0cC5SZXNwb25zZVdyaXRlciwgciAqaHR0cC5SZXF1ZXN0LCB2IGludGVyZmFjZXt9KSBlcnJvciB7CglpZiBlcnIgOj0ganNvbi5OZXdEZWNvZGVyKGh0dHAuTWF4Qnl0ZXNSZWFkZXIodywgci5Cb2R5LCBtYXhCb2R5U2l6ZSkpLkRlY29kZSh2KTsgZXJyICE9IG5pbCB7CgkJcmV0dXJuIGh0dHBFcnJvcntodHRwLlN0YXR1c0JhZFJlcXVlc3QsICJpbnZhbGlkIEpTT04gYm9keTogIiArIGVyci5FcnJvcigpfQoJfQoJcmV0dXJuIG5pbAp9CgovLyB3cml0ZUpTT04gcmVzcG9uZHMgd2l0aCB0aGUgSlNPTiBlbmNvZGluZyBvZiB2LgpmdW5jIHdyaXRlSlNPTih3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIHN0YXR1cyBpbnQsIHYgaW50ZXJmYWNle30pIHsKCXcuSGVhZGVyKCkuU2V0KCJDb250ZW50LVR5cGUiLCAiYXBwbGljYXRpb24vanNvbjsgY2hhcnNldD11dGYtOCIpCgl3LldyaXRlSGVhZGVyKHN0YXR1cykKCWpzb24uTmV3RW5jb2Rlcih3KS5FbmNvZGUodikKfQoKLy8gbWV0aG9kTm90QWxsb3dlZCByZXNwb25kcyB0byBhIHJlcXVlc3Qgd2hvc2UgbWV0aG9kIHRoZSByb3V0ZSBkb2VzIG5vdCBoYW5kbGUuCmZ1bmMgbWV0aG9kTm90QWxsb3dlZCh3IGh0dHAuUmVzcG9uc2VXcml0ZXIsIGFsbG93IHN0cmluZykgewoJdy5IZWFkZXIoKS5TZXQoIkFsbG93IiwgYWxsb3cpCgl3cml0ZUpTT04odywgaHR0cC5TdGF0dXNNZXRob2ROb3RBbGxvd2VkLCBodHRwRXJyb3JCb2R5e0Vycm9yOiAibWV0aG9kIG5vdCBhbGxvd2VkIn0pCn0KCi8vIHdyaXRlRXJyb3IgcmVzcG9uZHMgd2l0aCB0aGUgc3RhdHVzIGFuIGVycm9yIG1hcHMgdG8uIE1pc3Npbmcgcm93cyBhcmUgbm90IGZvdW5kLAovLyBzdGFsZSBvYmplY3RzIGFuZCBkdXBsaWNhdGUga2V5cyBjb25mbGljdHMsIGFuZCB2YWxpZGF0aW9uIGVycm9ycyB1bnByb2Nlc3NhYmxlLgovLyBBbnkgb3RoZXIgZXJyb3IgaXMgbG9nZ2VkIGFuZCBoaWRkZW4gYmVoaW5kIGFuIGludGVybmFsIHNlcnZlciBlcnJvci4KZnVuYyB3cml0ZUVycm9yKHcgaHR0cC5SZXNwb25zZVdyaXRlciwgciAqaHR0cC5SZXF1ZXN0LCBlcnIgZXJyb3IpIHsKCWVyciA9IGR1cGxpY2F0ZUtleShlcnIpCglzd2l0Y2ggZSA6PSBlcnIuKHR5cGUpIHsKCWNhc2UgaHR0cEVycm9yOgoJCXdyaXRlSlNPTih3LCBlLnN0YXR1cywgaHR0cEVycm9yQm9keXtFcnJvcjogZS5tc2d9KQoJCXJldHVybgoJY2FzZSBWYWxpZGF0aW9uRXJyb3JzOgoJCWJvZHkgOj0gaHR0cEVycm9yQm9keXtFcnJvcjogImludmFsaWQgdmFsdWVzIn0KCQlmb3IgXywgZmUgOj0gcmFuZ2UgZSB7CgkJCWJvZHkuRmllbGRzID0gYXBwZW5kKGJvZHkuRmllbGRzLCBodHRwRmllbGRFcnJvcntGaWVsZDogZmUuQ29sdW1uLCBNZXNzYWdlOiBmZS5NZXNzYWdlfSkKCQl9CgkJd3JpdGVKU09OKHcsIGh0dHAuU3RhdHVzVW5wcm9jZXNzYWJsZUVudGl0eSwgYm9keSkKCQlyZXR1cm4KCX0KCXN3aXRjaCBlcnIgewoJY2FzZSBzcWwuRXJyTm9Sb3dzOgoJCXdyaXRlSlNPTih3LCBodHRwLlN0YXR1c05vdEZvdW5kLCBodHRwRXJyb3JCb2R5e0Vycm9yOiAibm90IGZvdW5kIn0pCgljYXNlIEVyclN0YWxlT2JqZWN0LCBFcnJEdXBsaWNhdGVLZXk6CgkJd3JpdGVKU09OKHcsIGh0dHAuU3RhdHVzQ29uZmxpY3QsIGh0dHBFcnJvckJvZHl7RXJyb3I6IGVyci5FcnJvcigpfSkKCWNhc2UgRXJySW52YWxpZEN1cnNvcjoKCQl3cml0ZUpTT04odywgaHR0cC5TdGF0dXNCYWRSZXF1ZXN0LCBodHRwRXJyb3JCb2R5e0Vycm9yOiBlcnIuRXJyb3IoKX0pCglkZWZhdWx0OgoJCWxvZy5QcmludGYoIiVzICVzOiAldiIsIHIuTWV0aG9kLCByLlVSTC5QYXRoLCBlcnIpCgkJd3JpdGVKU09OKHcsIGh0dHAuU3RhdHVzSW50ZXJuYWxTZXJ2ZXJFcnJvciwgaHR0cEVycm9yQm9keXtFcnJvcjogImludGVybmFsIHNlcnZlciBlcnJvciJ9KQoJfQp9Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "x_http_test.html", "\"e3tkZWZpbmUgImh0dHB0ZXN0In19Ci8vK2J1aWxkICFoZWxwZXJzCgpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImRhdGFiYXNlL3NxbCIKCSJlcnJvcnMiCgkiaW8vaW91dGlsIgoJImxvZyIKCSJuZXQvaHR0cCIKCSJuZXQvaHR0cC9odHRwdGVzdCIKCSJvcyIKCSJzdHJpbmdzIgoJInRlc3RpbmciCikKCmZ1bmMgVGVzdFJvdXRlSUQodCAqdGVzdGluZy5UKSB7CgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJcGF0aCAgICAgc3RyaW5nCgkJaWQgICAgICAgaW50NjQKCQlpdGVtLCBvayBib29sCgl9ewoJCXsiL3VzZXJzIiwgMCwgZmFsc2UsIHRydWV9LAoJCXsiL3VzZXJzLyIsIDAsIGZhbHNlLCB0cnVlfSwKCQl7Ii91c2Vycy80MiIsIDQyLCB0cnVlLCB0cnVlfSwKCQl7Ii91c2Vycy80Mi8iLCA0MiwgdHJ1ZSwgdHJ1ZX0sCgkJeyIvdXNlcnM0MiIsIDAsIGZhbHNlLCBmYWxzZX0sCgkJeyIvdXNlcnMvMCIsIDAsIGZhbHNlLCBmYWxzZX0sCgkJeyIvdXNlcnMvLTEiLCAwLCBmYWxzZSwgZmFsc2V9LAoJCXsiL3VzZXJzL2FiYyIsIDAsIGZhbHNlLCBmYWxzZX0sCgkJeyIvdXNlcnMvNDIvb3JkZXJzIiwgMCwgZmFsc2UsIGZhbHNlfSwKCQl7Ii9vcmRlcnMvNDIiLCAwLCBmYWxzZSwgZmFsc2V9LAoJfQoJZm9yIF8sIGMgOj0gcmFuZ2UgY2FzZXMgewoJCWlkLCBpdGVtLCBvayA6PSByb3V0ZUlEKCIvdXNlcnMiLCBjLnBhdGgpCgkJaWYgaWQgIT0gYy5pZCB8fCBpdGVtICE9IGMuaXRlbSB8fCBvayAhPSBjLm9rIHsKCQkJdC5FcnJvcmYoInJvdXRlSUQoJXEpID0gJWQsICV2LCAldiwgd2FudCAlZCwgJXYsICV2IiwgYy5wYXRoLCBpZCwgaXRlbSwgb2ssIGMuaWQsIGMuaXRlbSwgYy5vaykKCQl9Cgl9Cn0KCmZ1bmMgVGVzdFBhZ2VBcmdzKHQgKnRlc3RpbmcuVCkgewoJY2FzZXMgOj0gW11zdHJ1Y3QgewoJCXF1ZXJ5ICAgc3RyaW5nCgkJY3Vyc29yICBzdHJpbmcKCQluICAgICAgIGludAoJCXdhbnRFcnIgYm9vbAoJfXsKCQl7IiIsICIiLCBEZWZhdWx0SFRUUFBhZ2VTaXplLCBmYWxzZX0sCgkJeyJjdXJzb3I9YWJjJmxpbWl0PTUiLCAiYWJjIiwgNSwgZmFsc2V9LAoJCXsibGltaXQ9MTAwMDAwIiwgIiIsIE1heEhUVFBQYWdlU2l6ZSwgZmFsc2V9LAoJCXsibGltaXQ9MCIsICIiLCAwLCB0cnVlfSwKCQl7ImxpbWl0PXgiLCAiIiwgMCwgdHJ1ZX0sCgl9Cglmb3IgXywgYyA6PSByYW5nZSBjYXNlcyB7CgkJciA6PSBodHRwdGVzdC5OZXdSZXF1ZXN0KGh0dHAuTWV0aG9kR2V0LCAiL3VzZXJzPyIrYy5xdWVyeSwgbmlsKQoJCWN1cnNvciwgbiwgZXJyIDo9IHBhZ2VBcmdzKHIpCgkJaWYgKGVyciAhPSBuaWwpICE9IGMud2FudEVyciB7CgkJCXQuRXJyb3JmKCJwYWdlQXJncyglcSkgZXJyb3IgPSAldiwgd2FudCBlcnJvciAldiIsIGMucXVlcnksIGVyciwgYy53YW50RXJyKQoJCQljb250aW51ZQoJCX0KCQlpZiBjdXJzb3IgIT0gYy5jdXJzb3IgfHwgbiAhPSBjLm4gewoJCQl0LkVycm9yZigicGFnZUFyZ3MoJXEpID0gJXEsICVkLCB3YW50ICVxLCAlZCIsIGMucXVlcnksIGN1cnNvciwgbiwgYy5jdXJzb3IsIGMubikKCQl9Cgl9Cn0KCmZ1bmMgVGVzdFdyaXRlRXJyb3IodCAqdGVzdGluZy5UKSB7Cglsb2cuU2V0T3V0cHV0KGlvdXRpbC5EaXNjYXJkKQoJZGVmZXIgbG9nLlNldE91dHB1dChvcy5TdGRlcnIpCgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJZXJyICAgIGVycm9yCgkJc3RhdHVzIGludAoJCWJvZHkgICBzdHJpbmcKCX17CgkJe3NxbC5FcnJOb1Jvd3MsIGh0dHAuU3RhdHVzTm90Rm91bmQsIGB7ImVycm9yIjoibm90IGZvdW5kIn1gfSwKCQl7RXJyU3RhbGVPYmplY3QsIGh0dHAuU3RhdHVzQ29uZmxpY3QsIGB7ImVycm9yIjoiYCArIEVyclN0YWxlT2JqZWN0LkVycm9yKCkgKyBgIn1gfSwKCQl7RXJyRHVwbGljYXRlS2V5LCBodHRwLlN0YXR1c0NvbmZsaWN0LCBgeyJlcnJvciI6ImAgKyBFcnJEdXBsaWNhdGVLZXkuRXJyb3IoKSArIGAifWB9LAoJCXtFcnJJbnZhbGlkQ3Vyc29yLCBodHRwLlN0YXR1c0JhZFJlcXVlc3QsIGB7ImVycm9yIjoiaW52YWxpZCBjdXJzb3IifWB9LAoJCXtodHRwRXJyb3J7aHR0cC5TdGF0dXNCYWRSZXF1ZXN0LCAiYmFkIn0sIGh0dHAuU3RhdHVzQmFkUmVxdWVzdCwgYHsiZXJyb3IiOiJiYWQifWB9LAoJCXsKCQkJVmFsaWRhdGlvbkVycm9yc3tGaWVsZEVycm9ye0ZpZWxkOiAiRW1haWwiLCBDb2x1bW46ICJlbWFpbCIsIE1lc3NhZ2U6ICJtdXN0IGJlIGF0IG1vc3QgMyBjaGFyYWN0ZXJzIn19LAoJCQlodHRwLlN0YXR1c1VucHJvY2Vzc2FibGVFbnRpdHksCgkJCWB7ImVycm9yIjoiaW52YWxpZCB2YWx1ZXMiLCJmaWVsZHMiOlt7ImZpZWxkIjoiZW1haWwiLCJtZXNzYWdlIjoibXVzdCBiZSBhdCBtb3N0IDMgY2hhcmFjdGVycyJ9XX1gLAoJCX0sCgkJe2Vycm9ycy5OZXcoImNvbm5lY3Rpb24gcmVmdXNlZCIpLCBodHRwLlN0YXR1c0ludGVybmFsU2VydmVyRXJyb3IsIGB7ImVycm9yIjoiaW50ZXJuYWwgc2VydmVyIGVycm9yIn1gfSwKCX0KCWZvciBfLCBjIDo9IHJhbmdlIGNhc2VzIHsKCQl3IDo9IGh0dHB0ZXN0Lk5ld1JlY29yZGVyKCkKCQl3cml0ZUVycm9yKHcsIGh0dHB0ZXN0Lk5ld1JlcXVlc3QoaHR0cC5NZXRob2RHZXQsICIvdXNlcnMiLCBuaWwpLCBjLmVycikKCQlpZiB3LkNvZGUgIT0gYy5zdGF0dXMgewoJCQl0LkVycm9yZigid3JpdGVFcnJvcigldikgc3RhdHVzID0gJWQsIHdhbnQgJWQiLCBjLmVyciwgdy5Db2RlLCBjLnN0YXR1cykKCQl9CgkJaWYgYm9keSA6PSBzdHJpbmdzLlRyaW1TcGFjZSh3LkJvZHkuU3RyaW5nKCkpOyBib2R5ICE9IGMuYm9keSB7CgkJCXQuRXJyb3JmKCJ3cml0ZUVycm9yKCV2KSBib2R5ID0gJXMsIHdhbnQgJXMiLCBjLmVyciwgYm9keSwgYy5ib2R5KQoJCX0KCX0KfQp7e2VuZH19Cg==\"")
+	packr.PackJSONBytes("./tmpl", "x_integration_test.html", "\"e3tkZWZpbmUgImludGVncmF0aW9udGVzdCJ9fQpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImJ5dGVzIgoJImNvbnRleHQiCgkiZGF0YWJhc2Uvc3FsIgoJImRhdGFiYXNlL3NxbC9kcml2ZXIiCgkiZW5jb2RpbmcvanNvbiIKCSJmbXQiCgkibWF0aCIKCSJtYXRoL3JhbmQiCgkib3MiCgkicmVmbGVjdCIKCSJ0ZXN0aW5nIgoJInRpbWUiCgoJImdpdGh1Yi5jb20vZ28tc3FsLWRyaXZlci9teXNxbCIKKQoKLy8gdGVzdERTTkVudiBuYW1lcyB0aGUgZW52aXJvbm1lbnQgdmFyaWFibGUgaG9sZGluZyB0aGUgRFNOIG9mIHRoZSBkYXRhYmFzZQovLyB0aGUgaW50ZWdyYXRpb24gdGVzdHMgcnVuIGFnYWluc3QsIGV4OgovLyAgTU9ERUxHRU5fVEVTVF9EU049J3Jvb3Q6QHRjcChsb2NhbGhvc3Q6MzMwNikvbW9kZWxnZW5fdGVzdHMnIGdvIHRlc3QKLy8gVGhlIGludGVncmF0aW9uIHRlc3RzIGFyZSBza2lwcGVkIHdoZW4gaXQgaXMgdW5zZXQuCmNvbnN0IHRlc3REU05FbnYgPSAiTU9ERUxHRU5fVEVTVF9EU04iCgovLyB0ZXN0VHggYmVnaW5zIGEgdHJhbnNhY3Rpb24gb24gdGhlIHRlc3QgZGF0YWJhc2UsIGluIFVUQyBhbmQgd2l0aCBmb3JlaWduIGtleSBjaGVja3MgZGlzYWJsZWQKLy8gc28gcm93cyBjYW4gYmUgaW5zZXJ0ZWQgd2l0aG91dCB0aGUgcm93cyB0aGV5IHJlZmVyZW5jZS4gVGhlIHJldHVybmVkIGZ1bmN0aW9uCi8vIHJvbGxzIHRoZSB0cmFuc2FjdGlvbiBiYWNrLCBsZWF2aW5nIHRoZSBkYXRhYmFzZSBhcyBpdCB3YXMuCmZ1bmMgdGVzdFR4KHQgKnRlc3RpbmcuVCkgKCpzcWwuVHgsIGZ1bmMoKSkgewoJZHNuIDo9IG9zLkdldGVudih0ZXN0RFNORW52KQoJaWYgZHNuID09ICIiIHsKCQl0LlNraXBmKCIlcyBpcyBub3Qgc2V0IiwgdGVzdERTTkVudikKCX0KCWNmZywgZXJyIDo9IG15c3FsLlBhcnNlRFNOKGRzbikKCWlmIGVyciAhPSBuaWwgewoJCXQuRmF0YWxmKCJjYW5ub3QgcGFyc2UgJXM6ICV2IiwgdGVzdERTTkVudiwgZXJyKQoJfQoJY2ZnLlBhcnNlVGltZSA9IHRydWUKCWNmZy5Mb2MgPSB0aW1lLlVUQwoKCWRiLCBlcnIgOj0gc3FsLk9wZW4oIm15c3FsIiwgY2ZnLkZvcm1hdERTTigpKQoJaWYgZXJyICE9IG5pbCB7CgkJdC5GYXRhbGYoImNhbm5vdCBvcGVuIHRoZSB0ZXN0IGRhdGFiYXNlOiAldiIsIGVycikKCX0KCWN0eCA6PSBjb250ZXh0LkJhY2tncm91bmQoKQoJY29ubiwgZXJyIDo9IGRiLkNvbm4oY3R4KQoJaWYgZXJyICE9IG5pbCB7CgkJZGIuQ2xvc2UoKQoJCXQuRmF0YWxmKCJjYW5ub3QgY29ubmVjdCB0byB0aGUgdGVzdCBkYXRhYmFzZTogJXYiLCBlcnIpCgl9CglpZiBfLCBlcnIgOj0gY29ubi5FeGVjQ29udGV4dChjdHgsICJTRVQgRk9SRUlHTl9LRVlfQ0hFQ0tTPTAsIHRpbWVfem9uZT0nKzAwOjAwJyIpOyBlcnIgIT0gbmlsIHsKCQljb25uLkNsb3NlKCkKCQlkYi5DbG9zZSgpCgkJdC5GYXRhbGYoImNhbm5vdCBzZXQgdXAgdGhlIHRlc3Qgc2Vzc2lvbjogJXYiLCBlcnIpCgl9Cgl0eCwgZXJyIDo9IGNvbm4uQmVnaW5UeChjdHgsIG5pbCkKCWlmIGVyciAhPSBuaWwgewoJCWNvbm4uQ2xvc2UoKQoJCWRiLkNsb3NlKCkKCQl0LkZhdGFsZigiY2Fubm90IGJlZ2luIGEgdHJhbnNhY3Rpb246ICV2IiwgZXJyKQoJfQoJcmV0dXJuIHR4LCBmdW5jKCkgewoJCXR4LlJvbGxiYWNrKCkKCQljb25uLkV4ZWNDb250ZXh0KGN0eCwgIlNFVCBGT1JFSUdOX0tFWV9DSEVDS1M9MSIpCgkJY29ubi5DbG9zZSgpCgkJZGIuQ2xvc2UoKQoJfQp9CgovLyBhZmZlY3RpbmdRdWVyeWVyIGlzIGEgUXVlcnllckNvbnRleHQgcmVwb3J0aW5nIHRoZSBnaXZlbiBudW1iZXIgb2Ygcm93cyBhZmZlY3RlZCBieSBldmVyeSBzdGF0ZW1lbnQsCi8vIGZvciB0aGUgdGVzdHMgb2YgdGhlIG1vZGVsIG1ldGhvZHMgd2hpY2ggbmVlZCBubyBkYXRhYmFzZS4KdHlwZSBhZmZlY3RpbmdRdWVyeWVyIGludDY0CgpmdW5jIChxIGFmZmVjdGluZ1F1ZXJ5ZXIpIFF1ZXJ5Q29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pICgqc3FsLlJvd3MsIGVycm9yKSB7CglwYW5pYygibm90IGltcGxlbWVudGVkIikKfQoKZnVuYyAocSBhZmZlY3RpbmdRdWVyeWVyKSBRdWVyeVJvd0NvbnRleHQoY3R4IGNvbnRleHQuQ29udGV4dCwgcXVlcnkgc3RyaW5nLCBhcmdzIC4uLmludGVyZmFjZXt9KSAqc3FsLlJvdyB7CglwYW5pYygibm90IGltcGxlbWVudGVkIikKfQoKZnVuYyAocSBhZmZlY3RpbmdRdWVyeWVyKSBFeGVjQ29udGV4dChjdHggY29udGV4dC5Db250ZXh0LCBxdWVyeSBzdHJpbmcsIGFyZ3MgLi4uaW50ZXJmYWNle30pIChzcWwuUmVzdWx0LCBlcnJvcikgewoJcmV0dXJuIGRyaXZlci5Sb3dzQWZmZWN0ZWQocSksIG5pbAp9CgovLyBhc3NlcnRTYW1lIGZhaWxzIHRoZSB0ZXN0IGlmIHRoZSB2YWx1ZSByZWFkIGJhY2sgZnJvbSBhIGNvbHVtbgovLyBkb2VzIG5vdCBtYXRjaCB0aGUgdmFsdWUgd3JpdHRlbiB0byBpdC4KZnVuYyBhc3NlcnRTYW1lKHQgKnRlc3RpbmcuVCwgc3RlcCwgY29sdW1uIHN0cmluZywgZXhwLCBnb3QgaW50ZXJmYWNle30pIHsKCWlmICFzYW1lVmFsdWUoZXhwLCBnb3QpIHsKCQl0LkVycm9yZigiJXM6IGNvbHVtbiAlc1xuZXhwOiAldlxuZ290OiAldiIsIHN0ZXAsIGNvbHVtbiwgZXhwLCBnb3QpCgl9Cn0KCi8vIHNhbWVWYWx1ZSBjb21wYXJlcyB2YWx1ZXMgdGhlIHdheSBNeVNRTCBzdG9yZXMgdGhlbSwgaWdub3JpbmcgdGltZSB6b25lcywKLy8gZmxvYXQgcm91bmRpbmcgYW5kIEpTT04gZm9ybWF0dGluZy4KZnVuYyBzYW1lVmFsdWUoZXhwLCBnb3QgaW50ZXJmYWNle30pIGJvb2wgewoJc3dpdGNoIGUgOj0gZXhwLih0eXBlKSB7CgljYXNlIHRpbWUuVGltZToKCQlnLCBvayA6PSBnb3QuKHRpbWUuVGltZSkKCQlyZXR1cm4gb2sgJiYgZS5FcXVhbChnKQoJY2FzZSBOdWxsVGltZToKCQlnLCBvayA6PSBnb3QuKE51bGxUaW1lKQoJCXJldHVybiBvayAmJiBlLlZhbGlkID09IGcuVmFsaWQgJiYgKCFlLlZhbGlkIHx8IGUuVGltZS5FcXVhbChnLlRpbWUpKQoJY2FzZSBmbG9hdDY0OgoJCWcsIG9rIDo9IGdvdC4oZmxvYXQ2NCkKCQlyZXR1cm4gb2sgJiYgY2xvc2VGbG9hdChlLCBnKQoJY2FzZSBOdWxsRmxvYXQ2NDoKCQlnLCBvayA6PSBnb3QuKE51bGxGbG9hdDY0KQoJCXJldHVybiBvayAmJiBlLlZhbGlkID09IGcuVmFsaWQgJiYgKCFlLlZhbGlkIHx8IGNsb3NlRmxvYXQoZS5GbG9hdDY0LCBnLkZsb2F0NjQpKQoJY2FzZSBbXWJ5dGU6CgkJZywgb2sgOj0gZ290LihbXWJ5dGUpCgkJcmV0dXJuIG9rICYmIGJ5dGVzLkVxdWFsKGUsIGcpCgljYXNlIFJhd0pTT046CgkJZywgb2sgOj0gZ290LihSYXdKU09OKQoJCWlmICFvayB7CgkJCXJldHVybiBmYWxzZQoJCX0KCQlpZiBsZW4oZSkgPT0gMCB8fCBsZW4oZykgPT0gMCB7CgkJCXJldHVybiBsZW4oZSkgPT0gbGVuKGcpCgkJfQoJCXZhciBldiwgZ3YgaW50ZXJmYWNle30KCQlyZXR1cm4ganNvbi5Vbm1hcnNoYWwoZSwgJmV2KSA9PSBuaWwgJiYganNvbi5Vbm1hcnNoYWwoZywgJmd2KSA9PSBuaWwgJiYgcmVmbGVjdC5EZWVwRXF1YWwoZXYsIGd2KQoJZGVmYXVsdDoKCQlyZXR1cm4gcmVmbGVjdC5EZWVwRXF1YWwoZXhwLCBnb3QpCgl9Cn0KCmZ1bmMgY2xvc2VGbG9hdChhLCBiIGZsb2F0NjQpIGJvb2wgewoJcmV0dXJuIG1hdGguQWJzKGEtYikgPD0gMWUtNiptYXRoLk1heCgxLCBtYXRoLkFicyhhKSkKfQoKLyotLS0tLS0tLS0tLS0tLSsKfCBTYW1wbGUgdmFsdWVzIHwKKy0tLS0tLS0tLS0tLS0tKi8KCnZhciBzYW1wbGVSYW5kID0gcmFuZC5OZXcocmFuZC5OZXdTb3VyY2UodGltZS5Ob3coKS5Vbml4TmFubygpKSkKCmZ1bmMgc2FtcGxlSW50KGxvLCBoaSBpbnQ2NCkgaW50NjQgewoJcmV0dXJuIGxvICsgc2FtcGxlUmFuZC5JbnQ2M24oaGktbG8rMSkKfQoKZnVuYyBzYW1wbGVGbG9hdChkaWdpdHMsIHNjYWxlIGludCkgZmxvYXQ2NCB7Cgl3aG9sZSA6PSBmbG9hdDY0KHNhbXBsZVJhbmQuSW50NjNuKGludDY0KG1hdGguUG93MTAoZGlnaXRzKSkpKQoJZnJhY3Rpb24gOj0gZmxvYXQ2NChzYW1wbGVSYW5kLkludDYzbihpbnQ2NChtYXRoLlBvdzEwKHNjYWxlKSkpKSAvIG1hdGguUG93MTAoc2NhbGUpCglyZXR1cm4gd2hvbGUgKyBmcmFjdGlvbgp9CgpmdW5jIHNhbXBsZUJvb2woKSBib29sIHsKCXJldHVybiBzYW1wbGVSYW5kLkludG4oMikgPT0gMQp9CgpmdW5jIHNhbXBsZVN0cmluZyhuIGludCkgc3RyaW5nIHsKCWNvbnN0IGxldHRlcnMgPSAiYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXoiCgliIDo9IG1ha2UoW11ieXRlLCBuKQoJZm9yIGkgOj0gcmFuZ2UgYiB7CgkJYltpXSA9IGxldHRlcnNbc2FtcGxlUmFuZC5JbnRuKGxlbihsZXR0ZXJzKSldCgl9CglyZXR1cm4gc3RyaW5nKGIpCn0KCmZ1bmMgc2FtcGxlSlNPTigpIFJhd0pTT04gewoJcmV0dXJuIFJhd0pTT04oZm10LlNwcmludGYoYHsic2FtcGxlIjogJXF9YCwgc2FtcGxlU3RyaW5nKDgpKSkKfQoKLy8gc2FtcGxlVGltZSByZXR1cm5zIGEgdGltZSBiZXR3ZWVuIDIwMDAgYW5kIDIwMzcsIHdoaWNoIGZpdHMgVElNRVNUQU1QIGNvbHVtbnMgYXMgd2VsbC4KZnVuYyBzYW1wbGVUaW1lKCkgdGltZS5UaW1lIHsKCXJldHVybiB0aW1lLlVuaXgoc2FtcGxlSW50KDk0NjY4NDgwMCwgMjExNDM4MDgwMCksIDApLlVUQygpCn0KCmZ1bmMgc2FtcGxlRGF0ZSgpIHRpbWUuVGltZSB7CglyZXR1cm4gc2FtcGxlVGltZSgpLlRydW5jYXRlKDI0ICogdGltZS5Ib3VyKQp9CgpmdW5jIHNhbXBsZUNsb2NrKCkgc3RyaW5nIHsKCXJldHVybiB0aW1lLlVuaXgoc2FtcGxlSW50KDAsIDg2Mzk5KSwgMCkuVVRDKCkuRm9ybWF0KFN0ZFRpbWUpCn0Ke3tlbmR9fQo=\"")
+	packr.PackJSONBytes("./tmpl", "x_proto.html", "\"e3tkZWZpbmUgInByb3RvaGVscGVycyJ9fQpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJInRpbWUiCgoJImdpdGh1Yi5jb20vZ29sYW5nL3Byb3RvYnVmL3B0eXBlcy90aW1lc3RhbXAiCgkiZ2l0aHViLmNvbS9nb2xhbmcvcHJvdG9idWYvcHR5cGVzL3dyYXBwZXJzIgopCgovLyBUaGUgcHJvdG9idWYgbWVzc2FnZXMgb2YgdGhlIG1vZGVscyBlbmNvZGUgTlVMTCBhcyBhIG1pc3NpbmcKLy8gd2VsbCBrbm93biB3cmFwcGVyIG1lc3NhZ2UsIGFuZCBzbyBkbyB0aGV5IHplcm8gdGltZXMuCgpmdW5jIHRvVGltZXN0YW1wKHQgdGltZS5UaW1lKSAqdGltZXN0YW1wLlRpbWVzdGFtcCB7CglpZiB0LklzWmVybygpIHsKCQlyZXR1cm4gbmlsCgl9CglyZXR1cm4gJnRpbWVzdGFtcC5UaW1lc3RhbXB7U2Vjb25kczogdC5Vbml4KCksIE5hbm9zOiBpbnQzMih0Lk5hbm9zZWNvbmQoKSl9Cn0KCmZ1bmMgZnJvbVRpbWVzdGFtcCh0cyAqdGltZXN0YW1wLlRpbWVzdGFtcCkgdGltZS5UaW1lIHsKCWlmIHRzID09IG5pbCB7CgkJcmV0dXJuIHRpbWUuVGltZXt9Cgl9CglyZXR1cm4gdGltZS5Vbml4KHRzLlNlY29uZHMsIGludDY0KHRzLk5hbm9zKSkuVVRDKCkKfQoKZnVuYyB0b051bGxUaW1lc3RhbXAodCBOdWxsVGltZSkgKnRpbWVzdGFtcC5UaW1lc3RhbXAgewoJaWYgIXQuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiB0b1RpbWVzdGFtcCh0LlRpbWUpCn0KCmZ1bmMgZnJvbU51bGxUaW1lc3RhbXAodHMgKnRpbWVzdGFtcC5UaW1lc3RhbXApIE51bGxUaW1lIHsKCWlmIHRzID09IG5pbCB7CgkJcmV0dXJuIE51bGxUaW1le30KCX0KCXJldHVybiBOdWxsVGltZXtUaW1lOiBmcm9tVGltZXN0YW1wKHRzKSwgVmFsaWQ6IHRydWV9Cn0KCmZ1bmMgdG9TdHJpbmdWYWx1ZShzIE51bGxTdHJpbmcpICp3cmFwcGVycy5TdHJpbmdWYWx1ZSB7CglpZiAhcy5WYWxpZCB7CgkJcmV0dXJuIG5pbAoJfQoJcmV0dXJuICZ3cmFwcGVycy5TdHJpbmdWYWx1ZXtWYWx1ZTogcy5TdHJpbmd9Cn0KCmZ1bmMgZnJvbVN0cmluZ1ZhbHVlKHYgKndyYXBwZXJzLlN0cmluZ1ZhbHVlKSBOdWxsU3RyaW5nIHsKCWlmIHYgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbFN0cmluZ3t9Cgl9CglyZXR1cm4gTnVsbFN0cmluZ3tTdHJpbmc6IHYuVmFsdWUsIFZhbGlkOiB0cnVlfQp9CgpmdW5jIHRvSW50NjRWYWx1ZShpIE51bGxJbnQ2NCkgKndyYXBwZXJzLkludDY0VmFsdWUgewoJaWYgIWkuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiAmd3JhcHBlcnMuSW50NjRWYWx1ZXtWYWx1ZTogaS5JbnQ2NH0KfQoKZnVuYyBmcm9tSW50NjRWYWx1ZSh2ICp3cmFwcGVycy5JbnQ2NFZhbHVlKSBOdWxsSW50NjQgewoJaWYgdiA9PSBuaWwgewoJCXJldHVybiBOdWxsSW50NjR7fQoJfQoJcmV0dXJuIE51bGxJbnQ2NHtJbnQ2NDogdi5WYWx1ZSwgVmFsaWQ6IHRydWV9Cn0KCmZ1bmMgdG9Eb3VibGVWYWx1ZShmIE51bGxGbG9hdDY0KSAqd3JhcHBlcnMuRG91YmxlVmFsdWUgewoJaWYgIWYuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiAmd3JhcHBlcnMuRG91YmxlVmFsdWV7VmFsdWU6IGYuRmxvYXQ2NH0KfQoKZnVuYyBmcm9tRG91YmxlVmFsdWUodiAqd3JhcHBlcnMuRG91YmxlVmFsdWUpIE51bGxGbG9hdDY0IHsKCWlmIHYgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbEZsb2F0NjR7fQoJfQoJcmV0dXJuIE51bGxGbG9hdDY0e0Zsb2F0NjQ6IHYuVmFsdWUsIFZhbGlkOiB0cnVlfQp9CgpmdW5jIHRvQm9vbFZhbHVlKGIgTnVsbEJvb2wpICp3cmFwcGVycy5Cb29sVmFsdWUgewoJaWYgIWIuVmFsaWQgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiAmd3JhcHBlcnMuQm9vbFZhbHVle1ZhbHVlOiBiLkJvb2x9Cn0KCmZ1bmMgZnJvbUJvb2xWYWx1ZSh2ICp3cmFwcGVycy5Cb29sVmFsdWUpIE51bGxCb29sIHsKCWlmIHYgPT0gbmlsIHsKCQlyZXR1cm4gTnVsbEJvb2x7fQoJfQoJcmV0dXJuIE51bGxCb29se0Jvb2w6IHYuVmFsdWUsIFZhbGlkOiB0cnVlfQp9CgpmdW5jIHRvQnl0ZXNWYWx1ZShiIFtdYnl0ZSkgKndyYXBwZXJzLkJ5dGVzVmFsdWUgewoJaWYgYiA9PSBuaWwgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiAmd3JhcHBlcnMuQnl0ZXNWYWx1ZXtWYWx1ZTogYn0KfQoKZnVuYyBmcm9tQnl0ZXNWYWx1ZSh2ICp3cmFwcGVycy5CeXRlc1ZhbHVlKSBbXWJ5dGUgewoJaWYgdiA9PSBuaWwgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiBhcHBlbmQoW11ieXRle30sIHYuVmFsdWUuLi4pCn0KCmZ1bmMgdG9KU09OVmFsdWUoaiBSYXdKU09OKSAqd3JhcHBlcnMuU3RyaW5nVmFsdWUgewoJaWYgbGVuKGopID09IDAgewoJCXJldHVybiBuaWwKCX0KCXJldHVybiAmd3JhcHBlcnMuU3RyaW5nVmFsdWV7VmFsdWU6IHN0cmluZyhqKX0KfQoKZnVuYyBmcm9tSlNPTlZhbHVlKHYgKndyYXBwZXJzLlN0cmluZ1ZhbHVlKSBSYXdKU09OIHsKCWlmIHYgPT0gbmlsIHsKCQlyZXR1cm4gbmlsCgl9CglyZXR1cm4gUmF3SlNPTih2LlZhbHVlKQp9Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "x_query.html", "\"e3tkZWZpbmUgInF1ZXJ5In19CgpwYWNrYWdlIHt7IC5QYWNrYWdlTmFtZSB9fQoKLyotLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0rCnwgQ29kZSBnZW5lcmF0ZWQgYnkgbW9kZWxnZW4gfAp8ICAgICAgICBETyBOT1QgRURJVC4gICAgICAgIHwKKy0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLSovCgppbXBvcnQgKAoJImJ5dGVzIgoJImVuY29kaW5nL2Jhc2U2NCIKCSJlbmNvZGluZy9qc29uIgoJImVycm9ycyIKCSJmbXQiCgkic3RyaW5ncyIKCSJ0aW1lIgopCgovKi0tLS0tLS0tLS0tKwp8IENvbmRpdGlvbnMgfAorLS0tLS0tLS0tLS0qLwoKLy8gQ29uZGl0aW9uIGlzIGEgcGFyYW1ldGVyaXplZCBTUUwgZXhwcmVzc2lvbiB1c2VkIHRvIGZpbHRlciBtb2RlbCBxdWVyaWVzLgovLyBDb25kaXRpb25zIGFyZSBidWlsdCBmcm9tIHRoZSBnZW5lcmF0ZWQgY29sdW1uIGRlc2NyaXB0b3JzLCBleDogVXNlckNvbHVtbnMuRW1haWwuRXEoZW1haWwpLgp0eXBlIENvbmRpdGlvbiBzdHJ1Y3QgewoJZXhwciBzdHJpbmcKCWFyZ3MgW11pbnRlcmZhY2V7fQp9CgovLyBBbmQgam9pbnMgY29uZGl0aW9ucywgbWF0Y2hpbmcgcm93cyB3aGljaCBzYXRpc2Z5IGFsbCBvZiB0aGVtLgovLyBXaXRob3V0IGFueSBjb25kaXRpb25zLCBldmVyeSByb3cgbWF0Y2hlcy4KZnVuYyBBbmQoY29uZHMgLi4uQ29uZGl0aW9uKSBDb25kaXRpb24gewoJaWYgbGVuKGNvbmRzKSA9PSAwIHsKCQlyZXR1cm4gQ29uZGl0aW9ue2V4cHI6ICJUUlVFIn0KCX0KCXJldHVybiBqb2luKCIgQU5EICIsIGNvbmRzKQp9CgovLyBPciBqb2lucyBjb25kaXRpb25zLCBtYXRjaGluZyByb3dzIHdoaWNoIHNhdGlzZnkgYW55IG9mIHRoZW0uCi8vIFdpdGhvdXQgYW55IGNvbmRpdGlvbnMsIG5vIHJvdyBtYXRjaGVzLgpmdW5jIE9yKGNvbmRzIC4uLkNvbmRpdGlvbikgQ29uZGl0aW9uIHsKCWlmIGxlbihjb25kcykgPT0gMCB7CgkJcmV0dXJuIENvbmRpdGlvbntleHByOiAiRkFMU0UifQoJfQoJcmV0dXJuIGpvaW4oIiBPUiAiLCBjb25kcykKfQoKZnVuYyBqb2luKHNlcCBzdHJpbmcsIGNvbmRzIFtdQ29uZGl0aW9uKSBDb25kaXRpb24gewoJdmFyICgKCQlleHBycyBbXXN0cmluZwoJCWFyZ3MgIFtdaW50ZXJmYWNle30KCSkKCWZvciBfLCBjIDo9IHJhbmdlIGNvbmRzIHsKCQlleHBycyA9IGFwcGVuZChleHBycywgYy5leHByKQoJCWFyZ3MgPSBhcHBlbmQoYXJncywgYy5hcmdzLi4uKQoJfQoJcmV0dXJuIENvbmRpdGlvbnsKCQlleHByOiAiKCIgKyBzdHJpbmdzLkpvaW4oZXhwcnMsIHNlcCkgKyAiKSIsCgkJYXJnczogYXJncywKCX0KfQoKLy8gT3JkZXJpbmcgaXMgYSBzaW5nbGUgT1JERVIgQlkgdGVybSBvZiBhIG1vZGVsIHF1ZXJ5Lgp0eXBlIE9yZGVyaW5nIHN0cnVjdCB7CglleHByIHN0cmluZwp9CgovLyBBc3NpZ25tZW50IHNldHMgYSBjb2x1bW4gdG8gYSB2YWx1ZSBpbiBhIG1vZGVsIHF1ZXJ5IHVwZGF0ZS4KdHlwZSBBc3NpZ25tZW50IHN0cnVjdCB7CglleHByIHN0cmluZwoJYXJncyBbXWludGVyZmFjZXt9Cn0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLSsKfCBDb2x1bW4gZGVzY3JpcHRvcnMgfAorLS0tLS0tLS0tLS0tLS0tLS0tLSovCgovLyBDb2x1bW4gZGVzY3JpYmVzIGEgY29sdW1uIG9mIGEgZ2VuZXJhdGVkIG1vZGVsLAovLyBwcm92aWRpbmcgdGhlIG9wZXJhdGlvbnMgYXZhaWxhYmxlIGZvciBldmVyeSBjb2x1bW4gdHlwZS4KdHlwZSBDb2x1bW4gc3RydWN0IHsKCW5hbWUgc3RyaW5nCn0KCi8vIFNlbGVjdGFibGUgaXMgaW1wbGVtZW50ZWQgYnkgZXZlcnkgY29sdW1uIGRlc2NyaXB0b3IsCi8vIGFsbG93aW5nIGFueSBvZiB0aGVtIHRvIGJlIHBpY2tlZCBpbiBhIG1vZGVsIHF1ZXJ5IFNlbGVjdC4KdHlwZSBTZWxlY3RhYmxlIGludGVyZmFjZSB7Cgljb2x1bW4oKSBDb2x1bW4KfQoKZnVuYyAoYyBDb2x1bW4pIGNvbHVtbigpIENvbHVtbiB7CglyZXR1cm4gYwp9CgovLyBJc051bGwgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gaXMgTlVMTC4KZnVuYyAoYyBDb2x1bW4pIElzTnVsbCgpIENvbmRpdGlvbiB7CglyZXR1cm4gQ29uZGl0aW9ue2V4cHI6IGMubmFtZSArICIgSVMgTlVMTCJ9Cn0KCi8vIElzTm90TnVsbCBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBpcyBub3QgTlVMTC4KZnVuYyAoYyBDb2x1bW4pIElzTm90TnVsbCgpIENvbmRpdGlvbiB7CglyZXR1cm4gQ29uZGl0aW9ue2V4cHI6IGMubmFtZSArICIgSVMgTk9UIE5VTEwifQp9CgovLyBBc2Mgb3JkZXJzIHRoZSBxdWVyeSBieSB0aGUgY29sdW1uIGluIGFzY2VuZGluZyBvcmRlci4KZnVuYyAoYyBDb2x1bW4pIEFzYygpIE9yZGVyaW5nIHsKCXJldHVybiBPcmRlcmluZ3tleHByOiBjLm5hbWUgKyAiIEFTQyJ9Cn0KCi8vIERlc2Mgb3JkZXJzIHRoZSBxdWVyeSBieSB0aGUgY29sdW1uIGluIGRlc2NlbmRpbmcgb3JkZXIuCmZ1bmMgKGMgQ29sdW1uKSBEZXNjKCkgT3JkZXJpbmcgewoJcmV0dXJuIE9yZGVyaW5ne2V4cHI6IGMubmFtZSArICIgREVTQyJ9Cn0KCi8vIFNldE51bGwgc2V0cyB0aGUgY29sdW1uIHRvIE5VTEwuCmZ1bmMgKGMgQ29sdW1uKSBTZXROdWxsKCkgQXNzaWdubWVudCB7CglyZXR1cm4gQXNzaWdubWVudHtleHByOiBjLm5hbWUgKyAiPU5VTEwifQp9CgpmdW5jIChjIENvbHVtbikgY21wKG9wIHN0cmluZywgdiBpbnRlcmZhY2V7fSkgQ29uZGl0aW9uIHsKCXJldHVybiBDb25kaXRpb257ZXhwcjogYy5uYW1lICsgIiAiICsgb3AgKyAiID8iLCBhcmdzOiBbXWludGVyZmFjZXt9e3Z9fQp9CgpmdW5jIChjIENvbHVtbikgaW4odnMgW11pbnRlcmZhY2V7fSkgQ29uZGl0aW9uIHsKCWlmIGxlbih2cykgPT0gMCB7CgkJcmV0dXJuIENvbmRpdGlvbntleHByOiAiRkFMU0UifQoJfQoJcmV0dXJuIENvbmRpdGlvbnsKCQlleHByOiBjLm5hbWUgKyAiIElOICgiICsgc3RyaW5ncy5SZXBlYXQoIj8sICIsIGxlbih2cyktMSkgKyAiPykiLAoJCWFyZ3M6IHZzLAoJfQp9CgpmdW5jIChjIENvbHVtbikgc2V0KHYgaW50ZXJmYWNle30pIEFzc2lnbm1lbnQgewoJcmV0dXJuIEFzc2lnbm1lbnR7ZXhwcjogYy5uYW1lICsgIj0/IiwgYXJnczogW11pbnRlcmZhY2V7fXt2fX0KfQoKLy8gSW50NjRDb2x1bW4gZGVzY3JpYmVzIGFuIGludGVnZXIgY29sdW1uLgp0eXBlIEludDY0Q29sdW1uIHN0cnVjdHsgQ29sdW1uIH0KCi8vIEVxIG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGVxdWFscyB2LgpmdW5jIChjIEludDY0Q29sdW1uKSBFcSh2IGludDY0KSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIj0iLCB2KSB9CgovLyBOZSBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBkb2VzIG5vdCBlcXVhbCB2LgpmdW5jIChjIEludDY0Q29sdW1uKSBOZSh2IGludDY0KSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjw+IiwgdikgfQoKLy8gR3QgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gaXMgZ3JlYXRlciB0aGFuIHYuCmZ1bmMgKGMgSW50NjRDb2x1bW4pIEd0KHYgaW50NjQpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPiIsIHYpIH0KCi8vIEd0ZSBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBpcyBncmVhdGVyIHRoYW4gb3IgZXF1YWwgdG8gdi4KZnVuYyAoYyBJbnQ2NENvbHVtbikgR3RlKHYgaW50NjQpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPj0iLCB2KSB9CgovLyBMdCBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBpcyBsZXNzIHRoYW4gdi4KZnVuYyAoYyBJbnQ2NENvbHVtbikgTHQodiBpbnQ2NCkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI8IiwgdikgfQoKLy8gTHRlIG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGlzIGxlc3MgdGhhbiBvciBlcXVhbCB0byB2LgpmdW5jIChjIEludDY0Q29sdW1uKSBMdGUodiBpbnQ2NCkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI8PSIsIHYpIH0KCi8vIEluIG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGVxdWFscyBhbnkgb2YgdnMuCmZ1bmMgKGMgSW50NjRDb2x1bW4pIEluKHZzIC4uLmludDY0KSBDb25kaXRpb24gewoJYXJncyA6PSBtYWtlKFtdaW50ZXJmYWNle30sIGxlbih2cykpCglmb3IgaSwgdiA6PSByYW5nZSB2cyB7CgkJYXJnc1tpXSA9IHYKCX0KCXJldHVybiBjLmluKGFyZ3MpCn0KCi8vIFNldCBzZXRzIHRoZSBjb2x1bW4gdG8gdi4KZnVuYyAoYyBJbnQ2NENvbHVtbikgU2V0KHYgaW50NjQpIEFzc2lnbm1lbnQgeyByZXR1cm4gYy5zZXQodikgfQoKLy8gRmxvYXQ2NENvbHVtbiBkZXNjcmliZXMgYSBmbG9hdGluZyBwb2ludCBvciBkZWNpbWFsIGNvbHVtbi4KdHlwZSBGbG9hdDY0Q29sdW1uIHN0cnVjdHsgQ29sdW1uIH0KCi8vIEVxIG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGVxdWFscyB2LgpmdW5jIChjIEZsb2F0NjRDb2x1bW4pIEVxKHYgZmxvYXQ2NCkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI9IiwgdikgfQoKLy8gTmUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZG9lcyBub3QgZXF1YWwgdi4KZnVuYyAoYyBGbG9hdDY0Q29sdW1uKSBOZSh2IGZsb2F0NjQpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPD4iLCB2KSB9CgovLyBHdCBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBpcyBncmVhdGVyIHRoYW4gdi4KZnVuYyAoYyBGbG9hdDY0Q29sdW1uKSBHdCh2IGZsb2F0NjQpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPiIsIHYpIH0KCi8vIEd0ZSBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBpcyBncmVhdGVyIHRoYW4gb3IgZXF1YWwgdG8gdi4KZnVuYyAoYyBGbG9hdDY0Q29sdW1uKSBHdGUodiBmbG9hdDY0KSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIj49IiwgdikgfQoKLy8gTHQgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gaXMgbGVzcyB0aGFuIHYuCmZ1bmMgKGMgRmxvYXQ2NENvbHVtbikgTHQodiBmbG9hdDY0KSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjwiLCB2KSB9CgovLyBMdGUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gaXMgbGVzcyB0aGFuIG9yIGVxdWFsIHRvIHYuCmZ1bmMgKGMgRmxvYXQ2NENvbHVtbikgTHRlKHYgZmxvYXQ2NCkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI8PSIsIHYpIH0KCi8vIFNldCBzZXRzIHRoZSBjb2x1bW4gdG8gdi4KZnVuYyAoYyBGbG9hdDY0Q29sdW1uKSBTZXQodiBmbG9hdDY0KSBBc3NpZ25tZW50IHsgcmV0dXJuIGMuc2V0KHYpIH0KCi8vIFN0cmluZ0NvbHVtbiBkZXNjcmliZXMgYSB0ZXh0dWFsIGNvbHVtbi4KdHlwZSBTdHJpbmdDb2x1bW4gc3RydWN0eyBDb2x1bW4gfQoKLy8gRXEgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZXF1YWxzIHYuCmZ1bmMgKGMgU3RyaW5nQ29sdW1uKSBFcSh2IHN0cmluZykgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI9IiwgdikgfQoKLy8gTmUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZG9lcyBub3QgZXF1YWwgdi4KZnVuYyAoYyBTdHJpbmdDb2x1bW4pIE5lKHYgc3RyaW5nKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjw+IiwgdikgfQoKLy8gR3QgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gc29ydHMgYWZ0ZXIgdi4KZnVuYyAoYyBTdHJpbmdDb2x1bW4pIEd0KHYgc3RyaW5nKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIj4iLCB2KSB9CgovLyBHdGUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZXF1YWxzIG9yIHNvcnRzIGFmdGVyIHYuCmZ1bmMgKGMgU3RyaW5nQ29sdW1uKSBHdGUodiBzdHJpbmcpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPj0iLCB2KSB9CgovLyBMdCBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBzb3J0cyBiZWZvcmUgdi4KZnVuYyAoYyBTdHJpbmdDb2x1bW4pIEx0KHYgc3RyaW5nKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjwiLCB2KSB9CgovLyBMdGUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZXF1YWxzIG9yIHNvcnRzIGJlZm9yZSB2LgpmdW5jIChjIFN0cmluZ0NvbHVtbikgTHRlKHYgc3RyaW5nKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjw9IiwgdikgfQoKLy8gTGlrZSBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBtYXRjaGVzIHRoZSBMSUtFIHBhdHRlcm4uCmZ1bmMgKGMgU3RyaW5nQ29sdW1uKSBMaWtlKHBhdHRlcm4gc3RyaW5nKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIkxJS0UiLCBwYXR0ZXJuKSB9CgovLyBJbiBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBlcXVhbHMgYW55IG9mIHZzLgpmdW5jIChjIFN0cmluZ0NvbHVtbikgSW4odnMgLi4uc3RyaW5nKSBDb25kaXRpb24gewoJYXJncyA6PSBtYWtlKFtdaW50ZXJmYWNle30sIGxlbih2cykpCglmb3IgaSwgdiA6PSByYW5nZSB2cyB7CgkJYXJnc1tpXSA9IHYKCX0KCXJldHVybiBjLmluKGFyZ3MpCn0KCi8vIFNldCBzZXRzIHRoZSBjb2x1bW4gdG8gdi4KZnVuYyAoYyBTdHJpbmdDb2x1bW4pIFNldCh2IHN0cmluZykgQXNzaWdubWVudCB7IHJldHVybiBjLnNldCh2KSB9CgovLyBCb29sQ29sdW1uIGRlc2NyaWJlcyBhIGJvb2xlYW4gY29sdW1uLgp0eXBlIEJvb2xDb2x1bW4gc3RydWN0eyBDb2x1bW4gfQoKLy8gRXEgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZXF1YWxzIHYuCmZ1bmMgKGMgQm9vbENvbHVtbikgRXEodiBib29sKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIj0iLCB2KSB9CgovLyBTZXQgc2V0cyB0aGUgY29sdW1uIHRvIHYuCmZ1bmMgKGMgQm9vbENvbHVtbikgU2V0KHYgYm9vbCkgQXNzaWdubWVudCB7IHJldHVybiBjLnNldCh2KSB9CgovLyBUaW1lQ29sdW1uIGRlc2NyaWJlcyBhIGRhdGUgb3IgdGltZSBjb2x1bW4uCnR5cGUgVGltZUNvbHVtbiBzdHJ1Y3R7IENvbHVtbiB9CgovLyBFcSBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBlcXVhbHMgdi4KZnVuYyAoYyBUaW1lQ29sdW1uKSBFcSh2IHRpbWUuVGltZSkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI9IiwgdikgfQoKLy8gTmUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZG9lcyBub3QgZXF1YWwgdi4KZnVuYyAoYyBUaW1lQ29sdW1uKSBOZSh2IHRpbWUuVGltZSkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI8PiIsIHYpIH0KCi8vIEd0IG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGlzIGFmdGVyIHYuCmZ1bmMgKGMgVGltZUNvbHVtbikgR3QodiB0aW1lLlRpbWUpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPiIsIHYpIH0KCi8vIEd0ZSBtYXRjaGVzIHJvd3Mgd2hlcmUgdGhlIGNvbHVtbiBpcyB2IG9yIGFmdGVyLgpmdW5jIChjIFRpbWVDb2x1bW4pIEd0ZSh2IHRpbWUuVGltZSkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI+PSIsIHYpIH0KCi8vIEx0IG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGlzIGJlZm9yZSB2LgpmdW5jIChjIFRpbWVDb2x1bW4pIEx0KHYgdGltZS5UaW1lKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjwiLCB2KSB9CgovLyBMdGUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gaXMgdiBvciBiZWZvcmUuCmZ1bmMgKGMgVGltZUNvbHVtbikgTHRlKHYgdGltZS5UaW1lKSBDb25kaXRpb24geyByZXR1cm4gYy5jbXAoIjw9IiwgdikgfQoKLy8gU2V0IHNldHMgdGhlIGNvbHVtbiB0byB2LgpmdW5jIChjIFRpbWVDb2x1bW4pIFNldCh2IHRpbWUuVGltZSkgQXNzaWdubWVudCB7IHJldHVybiBjLnNldCh2KSB9CgovLyBCeXRlc0NvbHVtbiBkZXNjcmliZXMgYSBiaW5hcnkgY29sdW1uLgp0eXBlIEJ5dGVzQ29sdW1uIHN0cnVjdHsgQ29sdW1uIH0KCi8vIEVxIG1hdGNoZXMgcm93cyB3aGVyZSB0aGUgY29sdW1uIGVxdWFscyB2LgpmdW5jIChjIEJ5dGVzQ29sdW1uKSBFcSh2IFtdYnl0ZSkgQ29uZGl0aW9uIHsgcmV0dXJuIGMuY21wKCI9IiwgdikgfQoKLy8gTmUgbWF0Y2hlcyByb3dzIHdoZXJlIHRoZSBjb2x1bW4gZG9lcyBub3QgZXF1YWwgdi4KZnVuYyAoYyBCeXRlc0NvbHVtbikgTmUodiBbXWJ5dGUpIENvbmRpdGlvbiB7IHJldHVybiBjLmNtcCgiPD4iLCB2KSB9CgovLyBTZXQgc2V0cyB0aGUgY29sdW1uIHRvIHYuCmZ1bmMgKGMgQnl0ZXNDb2x1bW4pIFNldCh2IFtdYnl0ZSkgQXNzaWdubWVudCB7IHJldHVybiBjLnNldCh2KSB9CgovLyBKU09OQ29sdW1uIGRlc2NyaWJlcyBhIEpTT04gY29sdW1uLgp0eXBlIEpTT05Db2x1bW4gc3RydWN0eyBDb2x1bW4gfQoKLy8gU2V0IHNldHMgdGhlIGNvbHVtbiB0byB2LgpmdW5jIChjIEpTT05Db2x1bW4pIFNldCh2IFJhd0pTT04pIEFzc2lnbm1lbnQgeyByZXR1cm4gYy5zZXQodikgfQoKLyotLS0tLS0tLS0tLS0tLSsKfCBRdWVyeSBjbGF1c2VzIHwKKy0tLS0tLS0tLS0tLS0tKi8KCi8vIHF1ZXJ5IGhvbGRzIHRoZSBjbGF1c2VzIHNoYXJlZCBieSBldmVyeSBnZW5lcmF0ZWQgbW9kZWwgcXVlcnkuCi8vIEl0cyBtZXRob2RzIG5ldmVyIG1vZGlmeSB0aGUgcmVjZWl2ZXIsIHNvIHF1ZXJpZXMgbWF5IGJlIHNhZmVseSByZXVzZWQuCnR5cGUgcXVlcnkgc3RydWN0IHsKCXNlbGVjdGVkIFtdQ29sdW1uCgljb25kcyAgICBbXUNvbmRpdGlvbgoJc2NvcGUgICAgW11Db25kaXRpb24KCW9yZGVycyAgIFtdT3JkZXJpbmcKCWxpbWl0ICAgIGludAoJb2Zmc2V0ICAgaW50CglkZWxldGVkICBzb2Z0RGVsZXRlZAoJbG9jayAgICAgc3RyaW5nCn0KCi8vIHNvZnREZWxldGVkIHNlbGVjdHMgd2hpY2ggc29mdCBkZWxldGVkIHJvd3MgYSBxdWVyeSBtYXRjaGVzLgp0eXBlIHNvZnREZWxldGVkIGludAoKY29uc3QgKAoJd2l0aG91dERlbGV0ZWQgc29mdERlbGV0ZWQgPSBpb3RhCgl3aXRoRGVsZXRlZAoJb25seURlbGV0ZWQKKQoKLy8gc2NvcGVkIHJlc3RyaWN0cyB0aGUgcXVlcnkgdG8gdGhlIHJvd3MgaXRzIHNvZnQgZGVsZXRlIHNldHRpbmcgbWF0Y2hlcywKLy8gY29sdW1uIGJlaW5nIHRoZSBzb2Z0IGRlbGV0ZSBjb2x1bW4gb2YgdGhlIHF1ZXJpZWQgdGFibGUuCi8vIFVubGlrZSBjb25kaXRpb25zLCB0aGUgc2NvcGUgZG9lcyBub3QgYWxsb3cgbW9kaWZ5aW5nIGV2ZXJ5IHJvdyBvZiBhIHRhYmxlLgpmdW5jIChxIHF1ZXJ5KSBzY29wZWQoY29sdW1uIHN0cmluZykgcXVlcnkgewoJc3dpdGNoIHEuZGVsZXRlZCB7CgljYXNlIHdpdGhvdXREZWxldGVkOgoJCXEuc2NvcGUgPSBbXUNvbmRpdGlvbnsge2V4cHI6IGNvbHVtbiArICIgSVMgTlVMTCJ9IH0KCWNhc2Ugb25seURlbGV0ZWQ6CgkJcS5zY29wZSA9IFtdQ29uZGl0aW9ueyB7ZXhwcjogY29sdW1uICsgIiBJUyBOT1QgTlVMTCJ9IH0KCWRlZmF1bHQ6CgkJcS5zY29wZSA9IG5pbAoJfQoJcmV0dXJuIHEKfQoKZnVuYyAocSBxdWVyeSkgc2VsZWN0Q29sdW1ucyhjb2xzIFtdU2VsZWN0YWJsZSkgcXVlcnkgewoJcS5zZWxlY3RlZCA9IG1ha2UoW11Db2x1bW4sIGxlbihjb2xzKSkKCWZvciBpLCBjIDo9IHJhbmdlIGNvbHMgewoJCXEuc2VsZWN0ZWRbaV0gPSBjLmNvbHVtbigpCgl9CglyZXR1cm4gcQp9CgpmdW5jIChxIHF1ZXJ5KSB3aGVyZShjb25kcyBbXUNvbmRpdGlvbikgcXVlcnkgewoJcS5jb25kcyA9IGFwcGVuZChxLmNvbmRzWzpsZW4ocS5jb25kcyk6bGVuKHEuY29uZHMpXSwgY29uZHMuLi4pCglyZXR1cm4gcQp9CgpmdW5jIChxIHF1ZXJ5KSBvcmRlckJ5KG9yZGVycyBbXU9yZGVyaW5nKSBxdWVyeSB7CglxLm9yZGVycyA9IGFwcGVuZChxLm9yZGVyc1s6bGVuKHEub3JkZXJzKTpsZW4ocS5vcmRlcnMpXSwgb3JkZXJzLi4uKQoJcmV0dXJuIHEKfQoKZnVuYyAocSBxdWVyeSkgd2hlcmVDbGF1c2UoKSAoc3RyaW5nLCBbXWludGVyZmFjZXt9KSB7Cgljb25kcyA6PSBhcHBlbmQocS5jb25kc1s6bGVuKHEuY29uZHMpOmxlbihxLmNvbmRzKV0sIHEuc2NvcGUuLi4pCglpZiBsZW4oY29uZHMpID09IDAgewoJCXJldHVybiAiIiwgbmlsCgl9Cgl2YXIgKAoJCWV4cHJzIFtdc3RyaW5nCgkJYXJncyAgW11pbnRlcmZhY2V7fQoJKQoJZm9yIF8sIGMgOj0gcmFuZ2UgY29uZHMgewoJCWV4cHJzID0gYXBwZW5kKGV4cHJzLCBjLmV4cHIpCgkJYXJncyA9IGFwcGVuZChhcmdzLCBjLmFyZ3MuLi4pCgl9CglyZXR1cm4gIiBXSEVSRSAiICsgc3RyaW5ncy5Kb2luKGV4cHJzLCAiIEFORCAiKSwgYXJncwp9CgpmdW5jIChxIHF1ZXJ5KSBvcmRlckNsYXVzZSgpIHN0cmluZyB7CglpZiBsZW4ocS5vcmRlcnMpID09IDAgewoJCXJldHVybiAiIgoJfQoJZXhwcnMgOj0gbWFrZShbXXN0cmluZywgbGVuKHEub3JkZXJzKSkKCWZvciBpLCBvIDo9IHJhbmdlIHEub3JkZXJzIHsKCQlleHByc1tpXSA9IG8uZXhwcgoJfQoJcmV0dXJuICIgT1JERVIgQlkgIiArIHN0cmluZ3MuSm9pbihleHBycywgIiwgIikKfQoKLy8gcGFnZSByZXN0cmljdHMgdGhlIHF1ZXJ5IHRvIHRoZSBuIHJvd3MgZm9sbG93aW5nIHRoZSBhZnRlciB2YWx1ZXMgb2YgdGhlIGtleSBjb2x1bW5zLAovLyBmZXRjaGluZyBvbmUgbW9yZSByb3cgdG8gZmluZCBvdXQgd2hldGhlciBhbm90aGVyIHBhZ2UgZm9sbG93cy4KZnVuYyAocSBxdWVyeSkgcGFnZShrZXlzIFtdQ29sdW1uLCBhZnRlciBbXWludGVyZmFjZXt9LCBuIGludCkgKHF1ZXJ5LCBlcnJvcikgewoJaWYgbiA8PSAwIHsKCQlyZXR1cm4gcSwgZm10LkVycm9yZigiY2Fubm90IHBhZ2Ugd2l0aCBhIHBhZ2Ugc2l6ZSBvZiAlZCIsIG4pCgl9CglpZiBxLmxpbWl0ID4gMCB8fCBxLm9mZnNldCA+IDAgfHwgbGVuKHEub3JkZXJzKSA+IDAgewoJCXJldHVybiBxLCBmbXQuRXJyb3JmKCJjYW5ub3QgcGFnZSBhIHF1ZXJ5IHdpdGggYSBsaW1pdCwgb2Zmc2V0IG9yIG9yZGVyaW5nIikKCX0KCgluYW1lcyA6PSBtYWtlKFtdc3RyaW5nLCBsZW4oa2V5cykpCglmb3IgaSwgayA6PSByYW5nZSBrZXlzIHsKCQluYW1lc1tpXSA9IGsubmFtZQoJCXEub3JkZXJzID0gYXBwZW5kKHEub3JkZXJzWzpsZW4ocS5vcmRlcnMpOmxlbihxLm9yZGVycyldLCBrLkFzYygpKQoJCWlmIGxlbihxLnNlbGVjdGVkKSA+IDAgJiYgIWNvbnRhaW5zQ29sdW1uKHEuc2VsZWN0ZWQsIGspIHsKCQkJcmV0dXJuIHEsIGZtdC5FcnJvcmYoImNhbm5vdCBwYWdlIGEgcXVlcnkgd2hpY2ggZG9lcyBub3Qgc2VsZWN0IHRoZSAlcyBjb2x1bW4iLCBrLm5hbWUpCgkJfQoJfQoJaWYgYWZ0ZXIgIT0gbmlsIHsKCQlleHByIDo9IG5hbWVzWzBdICsgIiA+ID8iCgkJaWYgbGVuKG5hbWVzKSA+IDEgewoJCQlleHByID0gIigiICsgc3RyaW5ncy5Kb2luKG5hbWVzLCAiLCAiKSArICIpID4gKCIgKyBzdHJpbmdzLlJlcGVhdCgiPywgIiwgbGVuKG5hbWVzKS0xKSArICI/KSIKCQl9CgkJcSA9IHEud2hlcmUoW11Db25kaXRpb257IHtleHByOiBleHByLCBhcmdzOiBhZnRlcn0gfSkKCX0KCXEubGltaXQgPSBuICsgMQoJcmV0dXJuIHEsIG5pbAp9CgpmdW5jIGNvbnRhaW5zQ29sdW1uKGNvbHMgW11Db2x1bW4sIGMgQ29sdW1uKSBib29sIHsKCWZvciBfLCBjb2wgOj0gcmFuZ2UgY29scyB7CgkJaWYgY29sID09IGMgewoJCQlyZXR1cm4gdHJ1ZQoJCX0KCX0KCXJldHVybiBmYWxzZQp9CgovLyBzZWxlY3RTdG10IGJ1aWxkcyBhIFNFTEVDVCBvZiB0aGUgc2VsZWN0ZWQgY29sdW1ucywKLy8gb3Igb2YgYWxsIGNvbHVtbnMgaWYgbm9uZSB3ZXJlIHNlbGVjdGVkLgpmdW5jIChxIHF1ZXJ5KSBzZWxlY3RTdG10KHRhYmxlLCBhbGwgc3RyaW5nKSAoc3RyaW5nLCBbXWludGVyZmFjZXt9LCBlcnJvcikgewoJaWYgcS5saW1pdCA9PSAwICYmIHEub2Zmc2V0ID4gMCB7CgkJcmV0dXJuICIiLCBuaWwsIGZtdC5FcnJvcmYoImNhbm5vdCBxdWVyeSB3aXRoIG9mZnNldCBidXQgbm8gbGltaXQiKQoJfQoJY29sdW1ucyA6PSBhbGwKCWlmIGxlbihxLnNlbGVjdGVkKSA+IDAgewoJCW5hbWVzIDo9IG1ha2UoW11zdHJpbmcsIGxlbihxLnNlbGVjdGVkKSkKCQlmb3IgaSwgYyA6PSByYW5nZSBxLnNlbGVjdGVkIHsKCQkJbmFtZXNbaV0gPSBjLm5hbWUKCQl9CgkJY29sdW1ucyA9IHN0cmluZ3MuSm9pbihuYW1lcywgIiwgIikKCX0KCXdoZXJlLCBhcmdzIDo9IHEud2hlcmVDbGF1c2UoKQoJc3RtdCA6PSAiU0VMRUNUICIgKyBjb2x1bW5zICsgIiBGUk9NICIgKyB0YWJsZSArIHdoZXJlICsgcS5vcmRlckNsYXVzZSgpCglpZiBxLmxpbWl0ID4gMCB7CgkJc3RtdCArPSAiIExJTUlUID8iCgkJYXJncyA9IGFwcGVuZChhcmdzLCBxLmxpbWl0KQoJfQoJaWYgcS5vZmZzZXQgPiAwIHsKCQlzdG10ICs9ICIgT0ZGU0VUID8iCgkJYXJncyA9IGFwcGVuZChhcmdzLCBxLm9mZnNldCkKCX0KCXJldHVybiBzdG10ICsgcS5sb2NrLCBhcmdzLCBuaWwKfQoKLyotLS0tLS0tLS0tLS0tLSsKfCBMb2NraW5nIHJlYWRzIHwKKy0tLS0tLS0tLS0tLS0tKi8KCi8vIExvY2tPcHRpb24gc2V0cyBob3cgYSBsb2NraW5nIHJlYWQgdHJlYXRzIHJvd3MgbG9ja2VkIGJ5IGFub3RoZXIgdHJhbnNhY3Rpb24sCi8vIHJhdGhlciB0aGFuIHdhaXRpbmcgZm9yIHRoZWlyIGxvY2sgdG8gYmUgcmVsZWFzZWQuIExvY2sgb3B0aW9ucyByZXF1aXJlIE15U1FMIDguCnR5cGUgTG9ja09wdGlvbiBzdHJpbmcKCmNvbnN0ICgKCS8vIFNraXBMb2NrZWQgbGVhdmVzIHJvd3MgbG9ja2VkIGJ5IGFub3RoZXIgdHJhbnNhY3Rpb24gb3V0IG9mIHRoZSByZXN1bHQuCglTa2lwTG9ja2VkIExvY2tPcHRpb24gPSAiU0tJUCBMT0NLRUQiCgkvLyBOb1dhaXQgZmFpbHMgdGhlIHJlYWQgYXMgc29vbiBhcyBhIHJvdyBpcyBsb2NrZWQgYnkgYW5vdGhlciB0cmFuc2FjdGlvbi4KCU5vV2FpdCBMb2NrT3B0aW9uID0gIk5PV0FJVCIKKQoKLy8gbG9ja0NsYXVzZSByZXR1cm5zIHRoZSBjbGF1c2UgdHVybmluZyBhIFNFTEVDVCBpbnRvIGEgbG9ja2luZyByZWFkLgovLyBTaGFyZWQgbG9ja3Mgd2l0aG91dCBvcHRpb25zIHVzZSBMT0NLIElOIFNIQVJFIE1PREUsIHdoaWNoIG9sZGVyIE15U1FMIHZlcnNpb25zIHN1cHBvcnQgYXMgd2VsbC4KZnVuYyBsb2NrQ2xhdXNlKHNoYXJlIGJvb2wsIG9wdHMgW11Mb2NrT3B0aW9uKSAoc3RyaW5nLCBlcnJvcikgewoJaWYgbGVuKG9wdHMpID4gMSB7CgkJcmV0dXJuICIiLCBmbXQuRXJyb3JmKCJjYW5ub3QgbG9jayByb3dzIHdpdGggbW9yZSB0aGFuIG9uZSBsb2NrIG9wdGlvbiIpCgl9CglzdG10IDo9ICIgRk9SIFVQREFURSIKCWlmIHNoYXJlIHsKCQlpZiBsZW4ob3B0cykgPT0gMCB7CgkJCXJldHVybiAiIExPQ0sgSU4gU0hBUkUgTU9ERSIsIG5pbAoJCX0KCQlzdG10ID0gIiBGT1IgU0hBUkUiCgl9Cglmb3IgXywgb3B0IDo9IHJhbmdlIG9wdHMgewoJCXN3aXRjaCBvcHQgewoJCWNhc2UgU2tpcExvY2tlZCwgTm9XYWl0OgoJCQlzdG10ICs9ICIgIiArIHN0cmluZyhvcHQpCgkJZGVmYXVsdDoKCQkJcmV0dXJuICIiLCBmbXQuRXJyb3JmKCJ1bmtub3duIGxvY2sgb3B0aW9uICVxIiwgb3B0KQoJCX0KCX0KCXJldHVybiBzdG10LCBuaWwKfQoKLy8gbG9ja2VkIHR1cm5zIHRoZSBxdWVyeSBpbnRvIGEgbG9ja2luZyByZWFkLgpmdW5jIChxIHF1ZXJ5KSBsb2NrZWQoc2hhcmUgYm9vbCwgb3B0cyBbXUxvY2tPcHRpb24pIChxdWVyeSwgZXJyb3IpIHsKCWxvY2ssIGVyciA6PSBsb2NrQ2xhdXNlKHNoYXJlLCBvcHRzKQoJaWYgZXJyICE9IG5pbCB7CgkJcmV0dXJuIHEsIGVycgoJfQoJcS5sb2NrID0gbG9jawoJcmV0dXJuIHEsIG5pbAp9CgpmdW5jIChxIHF1ZXJ5KSBjb3VudFN0bXQodGFibGUgc3RyaW5nKSAoc3RyaW5nLCBbXWludGVyZmFjZXt9KSB7Cgl3aGVyZSwgYXJncyA6PSBxLndoZXJlQ2xhdXNlKCkKCXJldHVybiAiU0VMRUNUIENPVU5UKCopIEZST00gIiArIHRhYmxlICsgd2hlcmUsIGFyZ3MKfQoKLy8gbGltaXRDbGF1c2UgdmFsaWRhdGVzIHRoZSBPUkRFUiBCWSBhbmQgTElNSVQgY2xhdXNlcyBvZiBhIERFTEVURSBvciBVUERBVEUsCi8vIHdoaWNoIGNhbm5vdCB0YWtlIGFuIE9GRlNFVCwgYW5kIHJlZnVzZXMgdG8gdG91Y2ggZXZlcnkgcm93IG9mIGEgdGFibGUuCmZ1bmMgKHEgcXVlcnkpIGxpbWl0Q2xhdXNlKCkgKHN0cmluZywgW11pbnRlcmZhY2V7fSwgZXJyb3IpIHsKCWlmIGxlbihxLmNvbmRzKSA9PSAwIHsKCQlyZXR1cm4gIiIsIG5pbCwgZm10LkVycm9yZigiY2Fubm90IG1vZGlmeSByb3dzIHdpdGhvdXQgYW55IGNvbmRpdGlvbnMiKQoJfQoJaWYgcS5vZmZzZXQgPiAwIHsKCQlyZXR1cm4gIiIsIG5pbCwgZm10LkVycm9yZigiY2Fubm90IG1vZGlmeSByb3dzIHdpdGggYW4gb2Zmc2V0IikKCX0KCXN0bXQgOj0gcS5vcmRlckNsYXVzZSgpCglpZiBxLmxpbWl0ID4gMCB7CgkJcmV0dXJuIHN0bXQgKyAiIExJTUlUID8iLCBbXWludGVyZmFjZXt9e3EubGltaXR9LCBuaWwKCX0KCXJldHVybiBzdG10LCBuaWwsIG5pbAp9CgpmdW5jIChxIHF1ZXJ5KSBkZWxldGVTdG10KHRhYmxlIHN0cmluZykgKHN0cmluZywgW11pbnRlcmZhY2V7fSwgZXJyb3IpIHsKCWxpbWl0LCBsaW1pdEFyZ3MsIGVyciA6PSBxLmxpbWl0Q2xhdXNlKCkKCWlmIGVyciAhPSBuaWwgewoJCXJldHVybiAiIiwgbmlsLCBlcnIKCX0KCXdoZXJlLCBhcmdzIDo9IHEud2hlcmVDbGF1c2UoKQoJcmV0dXJuICJERUxFVEUgRlJPTSAiICsgdGFibGUgKyB3aGVyZSArIGxpbWl0LCBhcHBlbmQoYXJncywgbGltaXRBcmdzLi4uKSwgbmlsCn0KCmZ1bmMgKHEgcXVlcnkpIHVwZGF0ZVN0bXQodGFibGUgc3RyaW5nLCBzZXQgW11Bc3NpZ25tZW50KSAoc3RyaW5nLCBbXWludGVyZmFjZXt9LCBlcnJvcikgewoJaWYgbGVuKHNldCkgPT0gMCB7CgkJcmV0dXJuICIiLCBuaWwsIGZtdC5FcnJvcmYoImNhbm5vdCB1cGRhdGUgcm93cyB3aXRob3V0IGFueSBhc3NpZ25tZW50cyIpCgl9CglsaW1pdCwgbGltaXRBcmdzLCBlcnIgOj0gcS5saW1pdENsYXVzZSgpCglpZiBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gIiIsIG5pbCwgZXJyCgl9Cgl2YXIgKAoJCWV4cHJzIFtdc3RyaW5nCgkJYXJncyAgW11pbnRlcmZhY2V7fQoJKQoJZm9yIF8sIGEgOj0gcmFuZ2Ugc2V0IHsKCQlleHBycyA9IGFwcGVuZChleHBycywgYS5leHByKQoJCWFyZ3MgPSBhcHBlbmQoYXJncywgYS5hcmdzLi4uKQoJfQoJd2hlcmUsIHdoZXJlQXJncyA6PSBxLndoZXJlQ2xhdXNlKCkKCWFyZ3MgPSBhcHBlbmQoYXBwZW5kKGFyZ3MsIHdoZXJlQXJncy4uLiksIGxpbWl0QXJncy4uLikKCXJldHVybiAiVVBEQVRFICIgKyB0YWJsZSArICIgU0VUICIgKyBzdHJpbmdzLkpvaW4oZXhwcnMsICIsICIpICsgd2hlcmUgKyBsaW1pdCwgYXJncywgbmlsCn0KLyotLS0tLS0tLSsKfCBDdXJzb3JzIHwKKy0tLS0tLS0tKi8KCi8vIEVyclN0b3Agc3RvcHMgdGhlIGl0ZXJhdGlvbiBvZiBFYWNoIHdoZW4gcmV0dXJuZWQgYnkgaXRzIGNhbGxiYWNrLAovLyB3aXRob3V0IEVhY2ggcmV0dXJuaW5nIGFuIGVycm9yIGl0c2VsZi4KdmFyIEVyclN0b3AgPSBlcnJvcnMuTmV3KCJzdG9wIGl0ZXJhdGlvbiIpCgovLyBFcnJJbnZhbGlkQ3Vyc29yIGlzIHJldHVybmVkIHdoZW4gcGFnaW5nIGFmdGVyIGEgY3Vyc29yIHdoaWNoIHdhcyBub3QKLy8gcmV0dXJuZWQgYnkgYSBwcmV2aW91cyBwYWdlIG9mIHRoZSBzYW1lIGtleS4KdmFyIEVyckludmFsaWRDdXJzb3IgPSBlcnJvcnMuTmV3KCJpbnZhbGlkIGN1cnNvciIpCgovLyBlbmNvZGVDdXJzb3IgZW5jb2RlcyB0aGUga2V5IHZhbHVlcyBvZiBhIHJvdyBpbnRvIGFuIG9wYXF1ZSwgVVJMIHNhZmUgY3Vyc29yLgpmdW5jIGVuY29kZUN1cnNvcihrZXkgaW50ZXJmYWNle30pIChzdHJpbmcsIGVycm9yKSB7CgliLCBlcnIgOj0ganNvbi5NYXJzaGFsKGtleSkKCWlmIGVyciAhPSBuaWwgewoJCXJldHVybiAiIiwgZXJyCgl9CglyZXR1cm4gYmFzZTY0LlJhd1VSTEVuY29kaW5nLkVuY29kZVRvU3RyaW5nKGIpLCBuaWwKfQoKLy8gZGVjb2RlQ3Vyc29yIGRlY29kZXMgYSBjdXJzb3IgbWFkZSBieSBlbmNvZGVDdXJzb3IgaW50byBrZXkuCi8vIFRoZSBkZWNvZGVkIGtleSBtdXN0IGVuY29kZSBiYWNrIGludG8gdGhlIHNhbWUgY3Vyc29yLAovLyB3aGljaCByZWplY3RzIGN1cnNvcnMgbWFkZSBmb3IgdGhlIGtleXMgb2Ygb3RoZXIgdGFibGVzIG9yIGluZGV4ZXMuCmZ1bmMgZGVjb2RlQ3Vyc29yKGN1cnNvciBzdHJpbmcsIGtleSBpbnRlcmZhY2V7fSkgZXJyb3IgewoJYiwgZXJyIDo9IGJhc2U2NC5SYXdVUkxFbmNvZGluZy5EZWNvZGVTdHJpbmcoY3Vyc29yKQoJaWYgZXJyICE9IG5pbCB7CgkJcmV0dXJuIEVyckludmFsaWRDdXJzb3IKCX0KCWlmIGVyciA6PSBqc29uLlVubWFyc2hhbChiLCBrZXkpOyBlcnIgIT0gbmlsIHsKCQlyZXR1cm4gRXJySW52YWxpZEN1cnNvcgoJfQoJaWYgYWdhaW4sIGVyciA6PSBqc29uLk1hcnNoYWwoa2V5KTsgZXJyICE9IG5pbCB8fCAhYnl0ZXMuRXF1YWwoYiwgYWdhaW4pIHsKCQlyZXR1cm4gRXJySW52YWxpZEN1cnNvcgoJfQoJcmV0dXJuIG5pbAp9Cnt7ZW5kfX0K\"")
+	packr.PackJSONBytes("./tmpl", "x_query_test.html", "\"e3tkZWZpbmUgInF1ZXJ5dGVzdCJ9fQovLytidWlsZCAhaGVscGVycwoKcGFja2FnZSB7eyAuUGFja2FnZU5hbWUgfX0KCi8qLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tKwp8IENvZGUgZ2VuZXJhdGVkIGJ5IG1vZGVsZ2VuIHwKfCAgICAgICAgRE8gTk9UIEVESVQuICAgICAgICB8CistLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0tLS0qLwoKaW1wb3J0ICgKCSJyZWZsZWN0IgoJInRlc3RpbmciCgkidGltZSIKKQoKdmFyICgKCXRlc3RJRCAgICAgID0gSW50NjRDb2x1bW57Q29sdW1ueyJgaWRgIn19Cgl0ZXN0TmFtZSAgICA9IFN0cmluZ0NvbHVtbntDb2x1bW57ImBuYW1lYCJ9fQoJdGVzdENyZWF0ZWQgPSBUaW1lQ29sdW1ue0NvbHVtbnsiYGNyZWF0ZWRfYXRgIn19CikKCmZ1bmMgVGVzdFF1ZXJ5X3NlbGVjdFN0bXQodCAqdGVzdGluZy5UKSB7Cgl0aW0gOj0gdGltZS5EYXRlKDIwMTcsIDEsIDEsIDAsIDAsIDAsIDAsIHRpbWUuVVRDKQoJY2FzZXMgOj0gW11zdHJ1Y3QgewoJCW5hbWUgICAgc3RyaW5nCgkJcSAgICAgICBxdWVyeQoJCWV4cCAgICAgc3RyaW5nCgkJZXhwQXJncyBbXWludGVyZmFjZXt9CgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogIm5vIGNsYXVzZXMiLAoJCQlxOiAgICBxdWVyeXt9LAoJCQlleHA6ICAiU0VMRUNUIGBpZGAsIGBuYW1lYCBGUk9NIGB0YCIsCgkJfSwKCQl7CgkJCW5hbWU6ICJjb25kaXRpb25zIiwKCQkJcTogcXVlcnl7fS53aGVyZShbXUNvbmRpdGlvbnsKCQkJCXRlc3ROYW1lLkVxKCJhIiksCgkJCQlPcih0ZXN0SUQuTHQoMTApLCB0ZXN0Q3JlYXRlZC5HdGUodGltKSksCgkJCQl0ZXN0TmFtZS5Jc05vdE51bGwoKSwKCQkJfSksCgkJCWV4cDogICAgICJTRUxFQ1QgYGlkYCwgYG5hbWVgIEZST00gYHRgIFdIRVJFIGBuYW1lYCA9ID8gQU5EIChgaWRgIDwgPyBPUiBgY3JlYXRlZF9hdGAgPj0gPykgQU5EIGBuYW1lYCBJUyBOT1QgTlVMTCIsCgkJCWV4cEFyZ3M6IFtdaW50ZXJmYWNle317ImEiLCBpbnQ2NCgxMCksIHRpbX0sCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJpbiIsCgkJCXE6ICAgICAgIHF1ZXJ5e30ud2hlcmUoW11Db25kaXRpb257dGVzdElELkluKDEsIDIsIDMpfSksCgkJCWV4cDogICAgICJTRUxFQ1QgYGlkYCwgYG5hbWVgIEZST00gYHRgIFdIRVJFIGBpZGAgSU4gKD8sID8sID8pIiwKCQkJZXhwQXJnczogW11pbnRlcmZhY2V7fXtpbnQ2NCgxKSwgaW50NjQoMiksIGludDY0KDMpfSwKCQl9LAoJCXsKCQkJbmFtZTogImVtcHR5IGluIiwKCQkJcTogICAgcXVlcnl7fS53aGVyZShbXUNvbmRpdGlvbnt0ZXN0SUQuSW4oKX0pLAoJCQlleHA6ICAiU0VMRUNUIGBpZGAsIGBuYW1lYCBGUk9NIGB0YCBXSEVSRSBGQUxTRSIsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJvcmRlciwgbGltaXQgYW5kIG9mZnNldCIsCgkJCXE6ICAgICAgIHF1ZXJ5e2xpbWl0OiAxMCwgb2Zmc2V0OiAyMH0ub3JkZXJCeShbXU9yZGVyaW5ne3Rlc3ROYW1lLkFzYygpLCB0ZXN0SUQuRGVzYygpfSksCgkJCWV4cDogICAgICJTRUxFQ1QgYGlkYCwgYG5hbWVgIEZST00gYHRgIE9SREVSIEJZIGBuYW1lYCBBU0MsIGBpZGAgREVTQyBMSU1JVCA/IE9GRlNFVCA/IiwKCQkJZXhwQXJnczogW11pbnRlcmZhY2V7fXsxMCwgMjB9LAoJCX0sCgkJewoJCQluYW1lOiAgICAic2VsZWN0ZWQgY29sdW1ucyIsCgkJCXE6ICAgICAgIHF1ZXJ5e30uc2VsZWN0Q29sdW1ucyhbXVNlbGVjdGFibGV7dGVzdENyZWF0ZWQsIHRlc3RJRH0pLndoZXJlKFtdQ29uZGl0aW9ue3Rlc3RJRC5FcSgxKX0pLAoJCQlleHA6ICAgICAiU0VMRUNUIGBjcmVhdGVkX2F0YCwgYGlkYCBGUk9NIGB0YCBXSEVSRSBgaWRgID0gPyIsCgkJCWV4cEFyZ3M6IFtdaW50ZXJmYWNle317aW50NjQoMSl9LAoJCX0sCgkJewoJCQluYW1lOiAgICAib2Zmc2V0IHdpdGhvdXQgbGltaXQiLAoJCQlxOiAgICAgICBxdWVyeXtvZmZzZXQ6IDIwfSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJfQoKCWZvciBfLCBjIDo9IHJhbmdlIGNhc2VzIHsKCQl0LlJ1bihjLm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCXN0bXQsIGFyZ3MsIGVyciA6PSBjLnEuc2VsZWN0U3RtdCgiYHRgIiwgImBpZGAsIGBuYW1lYCIpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSBjLndhbnRFcnIgewoJCQkJdC5GYXRhbGYoImVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIGMud2FudEVycikKCQkJfQoJCQlpZiBzdG10ICE9IGMuZXhwIHsKCQkJCXQuRXJyb3JmKCJcbmV4cDogJXFcbmdvdDogJXEiLCBjLmV4cCwgc3RtdCkKCQkJfQoJCQlpZiAhcmVmbGVjdC5EZWVwRXF1YWwoYXJncywgYy5leHBBcmdzKSB7CgkJCQl0LkVycm9yZigiXG5leHAgYXJnczogJXZcbmdvdCBhcmdzOiAldiIsIGMuZXhwQXJncywgYXJncykKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdFF1ZXJ5X2RlbGV0ZVN0bXQodCAqdGVzdGluZy5UKSB7CgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQlxICAgICAgIHF1ZXJ5CgkJZXhwICAgICBzdHJpbmcKCQlleHBBcmdzIFtdaW50ZXJmYWNle30KCQl3YW50RXJyIGJvb2wKCX17CgkJewoJCQluYW1lOiAgICAiY29uZGl0aW9ucyIsCgkJCXE6ICAgICAgIHF1ZXJ5e2xpbWl0OiA1fS53aGVyZShbXUNvbmRpdGlvbnt0ZXN0SUQuR3QoMSl9KS5vcmRlckJ5KFtdT3JkZXJpbmd7dGVzdElELkFzYygpfSksCgkJCWV4cDogICAgICJERUxFVEUgRlJPTSBgdGAgV0hFUkUgYGlkYCA+ID8gT1JERVIgQlkgYGlkYCBBU0MgTElNSVQgPyIsCgkJCWV4cEFyZ3M6IFtdaW50ZXJmYWNle317aW50NjQoMSksIDV9LAoJCX0sCgkJewoJCQluYW1lOiAgICAibm8gY29uZGl0aW9ucyIsCgkJCXE6ICAgICAgIHF1ZXJ5e30sCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJvZmZzZXQiLAoJCQlxOiAgICAgICBxdWVyeXtsaW1pdDogNSwgb2Zmc2V0OiA1fS53aGVyZShbXUNvbmRpdGlvbnt0ZXN0SUQuR3QoMSl9KSwKCQkJd2FudEVycjogdHJ1ZSwKCQl9LAoJfQoKCWZvciBfLCBjIDo9IHJhbmdlIGNhc2VzIHsKCQl0LlJ1bihjLm5hbWUsIGZ1bmModCAqdGVzdGluZy5UKSB7CgkJCXN0bXQsIGFyZ3MsIGVyciA6PSBjLnEuZGVsZXRlU3RtdCgiYHRgIikKCQkJaWYgKGVyciAhPSBuaWwpICE9IGMud2FudEVyciB7CgkJCQl0LkZhdGFsZigiZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgYy53YW50RXJyKQoJCQl9CgkJCWlmIHN0bXQgIT0gYy5leHAgewoJCQkJdC5FcnJvcmYoIlxuZXhwOiAlcVxuZ290OiAlcSIsIGMuZXhwLCBzdG10KQoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChhcmdzLCBjLmV4cEFyZ3MpIHsKCQkJCXQuRXJyb3JmKCJcbmV4cCBhcmdzOiAldlxuZ290IGFyZ3M6ICV2IiwgYy5leHBBcmdzLCBhcmdzKQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0UXVlcnlfdXBkYXRlU3RtdCh0ICp0ZXN0aW5nLlQpIHsKCXEgOj0gcXVlcnl7bGltaXQ6IDF9LndoZXJlKFtdQ29uZGl0aW9ue3Rlc3RJRC5FcSgxKX0pCglzdG10LCBhcmdzLCBlcnIgOj0gcS51cGRhdGVTdG10KCJgdGAiLCBbXUFzc2lnbm1lbnR7dGVzdE5hbWUuU2V0KCJhIiksIHRlc3RDcmVhdGVkLlNldE51bGwoKX0pCglpZiBlcnIgIT0gbmlsIHsKCQl0LkZhdGFsZigidW5leHBlY3RlZCBlcnJvcjogJXYiLCBlcnIpCgl9CglleHAgOj0gIlVQREFURSBgdGAgU0VUIGBuYW1lYD0/LCBgY3JlYXRlZF9hdGA9TlVMTCBXSEVSRSBgaWRgID0gPyBMSU1JVCA/IgoJaWYgc3RtdCAhPSBleHAgewoJCXQuRXJyb3JmKCJcbmV4cDogJXFcbmdvdDogJXEiLCBleHAsIHN0bXQpCgl9CglleHBBcmdzIDo9IFtdaW50ZXJmYWNle317ImEiLCBpbnQ2NCgxKSwgMX0KCWlmICFyZWZsZWN0LkRlZXBFcXVhbChhcmdzLCBleHBBcmdzKSB7CgkJdC5FcnJvcmYoIlxuZXhwIGFyZ3M6ICV2XG5nb3QgYXJnczogJXYiLCBleHBBcmdzLCBhcmdzKQoJfQoKCWlmIF8sIF8sIGVyciA6PSBxLnVwZGF0ZVN0bXQoImB0YCIsIG5pbCk7IGVyciA9PSBuaWwgewoJCXQuRXJyb3IoImV4cGVjdGVkIGFuIGVycm9yIHVwZGF0aW5nIHdpdGhvdXQgYXNzaWdubWVudHMiKQoJfQp9CgpmdW5jIFRlc3RRdWVyeV93aGVyZSh0ICp0ZXN0aW5nLlQpIHsKCWJhc2UgOj0gcXVlcnl7Y29uZHM6IG1ha2UoW11Db25kaXRpb24sIDAsIDQpfS53aGVyZShbXUNvbmRpdGlvbnt0ZXN0SUQuRXEoMSl9KQoJYSA6PSBiYXNlLndoZXJlKFtdQ29uZGl0aW9ue3Rlc3ROYW1lLkVxKCJhIil9KQoJYiA6PSBiYXNlLndoZXJlKFtdQ29uZGl0aW9ue3Rlc3ROYW1lLkVxKCJiIil9KQoJaWYgYS5jb25kc1sxXS5hcmdzWzBdICE9ICJhIiB8fCBiLmNvbmRzWzFdLmFyZ3NbMF0gIT0gImIiIHsKCQl0LkZhdGFsKCJkZXJpdmVkIHF1ZXJpZXMgc2hhcmUgdGhlaXIgY29uZGl0aW9ucyIpCgl9CglpZiBsZW4oYmFzZS5jb25kcykgIT0gMSB7CgkJdC5GYXRhbGYoImV4cGVjdGVkIGJhc2UgcXVlcnkgdG8ga2VlcCAxIGNvbmRpdGlvbiwgZ290ICVkIiwgbGVuKGJhc2UuY29uZHMpKQoJfQp9CgpmdW5jIFRlc3RRdWVyeV9zY29wZWQodCAqdGVzdGluZy5UKSB7CgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQlkZWxldGVkIHNvZnREZWxldGVkCgkJZXhwICAgICBzdHJpbmcKCX17CgkJewoJCQluYW1lOiAgICAid2l0aG91dCBkZWxldGVkIiwKCQkJZGVsZXRlZDogd2l0aG91dERlbGV0ZWQsCgkJCWV4cDogICAgICJTRUxFQ1QgYGlkYCwgYG5hbWVgIEZST00gYHRgIFdIRVJFIGBpZGAgPiA/IEFORCBgZGVsZXRlZF9hdGAgSVMgTlVMTCIsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJ3aXRoIGRlbGV0ZWQiLAoJCQlkZWxldGVkOiB3aXRoRGVsZXRlZCwKCQkJZXhwOiAgICAgIlNFTEVDVCBgaWRgLCBgbmFtZWAgRlJPTSBgdGAgV0hFUkUgYGlkYCA+ID8iLAoJCX0sCgkJewoJCQluYW1lOiAgICAib25seSBkZWxldGVkIiwKCQkJZGVsZXRlZDogb25seURlbGV0ZWQsCgkJCWV4cDogICAgICJTRUxFQ1QgYGlkYCwgYG5hbWVgIEZST00gYHRgIFdIRVJFIGBpZGAgPiA/IEFORCBgZGVsZXRlZF9hdGAgSVMgTk9UIE5VTEwiLAoJCX0sCgl9CgoJZm9yIF8sIGMgOj0gcmFuZ2UgY2FzZXMgewoJCXQuUnVuKGMubmFtZSwgZnVuYyh0ICp0ZXN0aW5nLlQpIHsKCQkJcSA6PSBxdWVyeXtkZWxldGVkOiBjLmRlbGV0ZWR9LndoZXJlKFtdQ29uZGl0aW9ue3Rlc3RJRC5HdCgxKX0pLnNjb3BlZCgiYGRlbGV0ZWRfYXRgIikKCQkJc3RtdCwgXywgZXJyIDo9IHEuc2VsZWN0U3RtdCgiYHRgIiwgImBpZGAsIGBuYW1lYCIpCgkJCWlmIGVyciAhPSBuaWwgewoJCQkJdC5GYXRhbGYoInVuZXhwZWN0ZWQgZXJyb3I6ICV2IiwgZXJyKQoJCQl9CgkJCWlmIHN0bXQgIT0gYy5leHAgewoJCQkJdC5FcnJvcmYoIlxuZXhwOiAlcVxuZ290OiAlcSIsIGMuZXhwLCBzdG10KQoJCQl9CgkJfSkKCX0KCglpZiBfLCBfLCBlcnIgOj0gKHF1ZXJ5e30pLnNjb3BlZCgiYGRlbGV0ZWRfYXRgIikuZGVsZXRlU3RtdCgiYHRgIik7IGVyciA9PSBuaWwgewoJCXQuRXJyb3IoImV4cGVjdGVkIGFuIGVycm9yIGRlbGV0aW5nIHdpdGggb25seSB0aGUgc29mdCBkZWxldGUgc2NvcGUiKQoJfQp9CgpmdW5jIFRlc3RRdWVyeV9sb2NrZWQodCAqdGVzdGluZy5UKSB7CgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQlzaGFyZSAgIGJvb2wKCQlvcHRzICAgIFtdTG9ja09wdGlvbgoJCWV4cCAgICAgc3RyaW5nCgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogImZvciB1cGRhdGUiLAoJCQlleHA6ICAiU0VMRUNUIGBpZGAsIGBuYW1lYCBGUk9NIGB0YCBXSEVSRSBgaWRgID0gPyBMSU1JVCA/IEZPUiBVUERBVEUiLAoJCX0sCgkJewoJCQluYW1lOiAiZm9yIHVwZGF0ZSBza2lwIGxvY2tlZCIsCgkJCW9wdHM6IFtdTG9ja09wdGlvbntTa2lwTG9ja2VkfSwKCQkJZXhwOiAgIlNFTEVDVCBgaWRgLCBgbmFtZWAgRlJPTSBgdGAgV0hFUkUgYGlkYCA9ID8gTElNSVQgPyBGT1IgVVBEQVRFIFNLSVAgTE9DS0VEIiwKCQl9LAoJCXsKCQkJbmFtZTogICJzaGFyZSIsCgkJCXNoYXJlOiB0cnVlLAoJCQlleHA6ICAgIlNFTEVDVCBgaWRgLCBgbmFtZWAgRlJPTSBgdGAgV0hFUkUgYGlkYCA9ID8gTElNSVQgPyBMT0NLIElOIFNIQVJFIE1PREUiLAoJCX0sCgkJewoJCQluYW1lOiAgInNoYXJlIG5vd2FpdCIsCgkJCXNoYXJlOiB0cnVlLAoJCQlvcHRzOiAgW11Mb2NrT3B0aW9ue05vV2FpdH0sCgkJCWV4cDogICAiU0VMRUNUIGBpZGAsIGBuYW1lYCBGUk9NIGB0YCBXSEVSRSBgaWRgID0gPyBMSU1JVCA/IEZPUiBTSEFSRSBOT1dBSVQiLAoJCX0sCgkJewoJCQluYW1lOiAgICAic2V2ZXJhbCBvcHRpb25zIiwKCQkJb3B0czogICAgW11Mb2NrT3B0aW9ue1NraXBMb2NrZWQsIE5vV2FpdH0sCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJ1bmtub3duIG9wdGlvbiIsCgkJCW9wdHM6ICAgIFtdTG9ja09wdGlvbnsiV0FJVCA1In0sCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCX0KCglmb3IgXywgYyA6PSByYW5nZSBjYXNlcyB7CgkJdC5SdW4oYy5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlxLCBlcnIgOj0gcXVlcnl7bGltaXQ6IDF9LndoZXJlKFtdQ29uZGl0aW9ue3Rlc3RJRC5FcSgxKX0pLmxvY2tlZChjLnNoYXJlLCBjLm9wdHMpCgkJCWlmIChlcnIgIT0gbmlsKSAhPSBjLndhbnRFcnIgewoJCQkJdC5GYXRhbGYoImVycm9yID0gJXYsIHdhbnRFcnIgJXYiLCBlcnIsIGMud2FudEVycikKCQkJfQoJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCXJldHVybgoJCQl9CgkJCXN0bXQsIF8sIGVyciA6PSBxLnNlbGVjdFN0bXQoImB0YCIsICJgaWRgLCBgbmFtZWAiKQoJCQlpZiBlcnIgIT0gbmlsIHsKCQkJCXQuRmF0YWxmKCJ1bmV4cGVjdGVkIGVycm9yOiAldiIsIGVycikKCQkJfQoJCQlpZiBzdG10ICE9IGMuZXhwIHsKCQkJCXQuRXJyb3JmKCJcbmV4cDogJXFcbmdvdDogJXEiLCBjLmV4cCwgc3RtdCkKCQkJfQoJCX0pCgl9Cn0KCmZ1bmMgVGVzdFF1ZXJ5X3BhZ2UodCAqdGVzdGluZy5UKSB7CgljYXNlcyA6PSBbXXN0cnVjdCB7CgkJbmFtZSAgICBzdHJpbmcKCQlxICAgICAgIHF1ZXJ5CgkJa2V5cyAgICBbXUNvbHVtbgoJCWFmdGVyICAgW11pbnRlcmZhY2V7fQoJCWV4cCAgICAgc3RyaW5nCgkJZXhwQXJncyBbXWludGVyZmFjZXt9CgkJd2FudEVyciBib29sCgl9ewoJCXsKCQkJbmFtZTogICAgImZpcnN0IHBhZ2UiLAoJCQlxOiAgICAgICBxdWVyeXt9LndoZXJlKFtdQ29uZGl0aW9ue3Rlc3ROYW1lLklzTm90TnVsbCgpfSksCgkJCWtleXM6ICAgIFtdQ29sdW1ue3Rlc3RJRC5Db2x1bW59LAoJCQlleHA6ICAgICAiU0VMRUNUIGBpZGAsIGBuYW1lYCBGUk9NIGB0YCBXSEVSRSBgbmFtZWAgSVMgTk9UIE5VTEwgT1JERVIgQlkgYGlkYCBBU0MgTElNSVQgPyIsCgkJCWV4cEFyZ3M6IFtdaW50ZXJmYWNle317MTF9LAoJCX0sCgkJewoJCQluYW1lOiAgICAiYWZ0ZXIgc2luZ2xlIGtleSIsCgkJCWtleXM6ICAgIFtdQ29sdW1ue3Rlc3RJRC5Db2x1bW59LAoJCQlhZnRlcjogICBbXWludGVyZmFjZXt9e2ludDY0KDUpfSwKCQkJZXhwOiAgICAgIlNFTEVDVCBgaWRgLCBgbmFtZWAgRlJPTSBgdGAgV0hFUkUgYGlkYCA+ID8gT1JERVIgQlkgYGlkYCBBU0MgTElNSVQgPyIsCgkJCWV4cEFyZ3M6IFtdaW50ZXJmYWNle317aW50NjQoNSksIDExfSwKCQl9LAoJCXsKCQkJbmFtZTogICAgImFmdGVyIGNvbXBvc2l0ZSBrZXkiLAoJCQlrZXlzOiAgICBbXUNvbHVtbnt0ZXN0TmFtZS5Db2x1bW4sIHRlc3RJRC5Db2x1bW59LAoJCQlhZnRlcjogICBbXWludGVyZmFjZXt9eyJhIiwgaW50NjQoNSl9LAoJCQlleHA6ICAgICAiU0VMRUNUIGBpZGAsIGBuYW1lYCBGUk9NIGB0YCBXSEVSRSAoYG5hbWVgLCBgaWRgKSA+ICg/LCA/KSBPUkRFUiBCWSBgbmFtZWAgQVNDLCBgaWRgIEFTQyBMSU1JVCA/IiwKCQkJZXhwQXJnczogW11pbnRlcmZhY2V7fXsiYSIsIGludDY0KDUpLCAxMX0sCgkJfSwKCQl7CgkJCW5hbWU6ICAgICJvcmRlcmVkIHF1ZXJ5IiwKCQkJcTogICAgICAgcXVlcnl7fS5vcmRlckJ5KFtdT3JkZXJpbmd7dGVzdE5hbWUuRGVzYygpfSksCgkJCWtleXM6ICAgIFtdQ29sdW1ue3Rlc3RJRC5Db2x1bW59LAoJCQl3YW50RXJyOiB0cnVlLAoJCX0sCgkJewoJCQluYW1lOiAgICAia2V5IG5vdCBzZWxlY3RlZCIsCgkJCXE6ICAgICAgIHF1ZXJ5e30uc2VsZWN0Q29sdW1ucyhbXVNlbGVjdGFibGV7dGVzdE5hbWV9KSwKCQkJa2V5czogICAgW11Db2x1bW57dGVzdElELkNvbHVtbn0sCgkJCXdhbnRFcnI6IHRydWUsCgkJfSwKCX0KCglmb3IgXywgYyA6PSByYW5nZSBjYXNlcyB7CgkJdC5SdW4oYy5uYW1lLCBmdW5jKHQgKnRlc3RpbmcuVCkgewoJCQlxLCBlcnIgOj0gYy5xLnBhZ2UoYy5rZXlzLCBjLmFmdGVyLCAxMCkKCQkJaWYgKGVyciAhPSBuaWwpICE9IGMud2FudEVyciB7CgkJCQl0LkZhdGFsZigiZXJyb3IgPSAldiwgd2FudEVyciAldiIsIGVyciwgYy53YW50RXJyKQoJCQl9CgkJCWlmIGVyciAhPSBuaWwgewoJCQkJcmV0dXJuCgkJCX0KCQkJc3RtdCwgYXJncywgZXJyIDo9IHEuc2VsZWN0U3RtdCgiYHRgIiwgImBpZGAsIGBuYW1lYCIpCgkJCWlmIGVyciAhPSBuaWwgewoJCQkJdC5GYXRhbGYoInVuZXhwZWN0ZWQgZXJyb3I6ICV2IiwgZXJyKQoJCQl9CgkJCWlmIHN0bXQgIT0gYy5leHAgewoJCQkJdC5FcnJvcmYoIlxuZXhwOiAlcVxuZ290OiAlcSIsIGMuZXhwLCBzdG10KQoJCQl9CgkJCWlmICFyZWZsZWN0LkRlZXBFcXVhbChhcmdzLCBjLmV4cEFyZ3MpIHsKCQkJCXQuRXJyb3JmKCJcbmV4cCBhcmdzOiAldlxuZ290IGFyZ3M6ICV2IiwgYy5leHBBcmdzLCBhcmdzKQoJCQl9CgkJfSkKCX0KfQoKZnVuYyBUZXN0Q3Vyc29yKHQgKnRlc3RpbmcuVCkgewoJdHlwZSBrZXkgc3RydWN0IHsKCQlOYW1lIHN0cmluZyAgICBganNvbjoibmFtZSJgCgkJQXQgICB0aW1lLlRpbWUgYGpzb246ImF0ImAKCX0KCWluIDo9IGtleXtOYW1lOiAiYS9iK2MiLCBBdDogdGltZS5EYXRlKDIwMTcsIDEsIDEsIDAsIDAsIDAsIDAsIHRpbWUuVVRDKX0KCWN1cnNvciwgZXJyIDo9IGVuY29kZUN1cnNvcihpbikKCWlmIGVyciAhPSBuaWwgewoJCXQuRmF0YWxmKCJ1bmV4cGVjdGVkIGVycm9yOiAldiIsIGVycikKCX0KCgl2YXIgb3V0IGtleQoJaWYgZXJyIDo9IGRlY29kZUN1cnNvcihjdXJzb3IsICZvdXQpOyBlcnIgIT0gbmlsIHsKCQl0LkZhdGFsZigidW5leHBlY3RlZCBlcnJvcjogJXYiLCBlcnIpCgl9CglpZiAhcmVmbGVjdC5EZWVwRXF1YWwoaW4sIG91dCkgewoJCXQuRmF0YWxmKCJcbmV4cDogJXZcbmdvdDogJXYiLCBpbiwgb3V0KQoJfQoKCXZhciBvdGhlciBzdHJ1Y3QgewoJCUlEIGludDY0IGBqc29uOiJpZCJgCgl9CglpZiBlcnIgOj0gZGVjb2RlQ3Vyc29yKGN1cnNvciwgJm90aGVyKTsgZXJyICE9IEVyckludmFsaWRDdXJzb3IgewoJCXQuRXJyb3JmKCJleHBlY3RlZCBFcnJJbnZhbGlkQ3Vyc29yIGRlY29kaW5nIGludG8gYW5vdGhlciBrZXksIGdvdCAldiIsIGVycikKCX0KCWlmIGVyciA6PSBkZWNvZGVDdXJzb3IoIm5vdCBhIGN1cnNvciEiLCAmb3V0KTsgZXJyICE9IEVyckludmFsaWRDdXJzb3IgewoJCXQuRXJyb3JmKCJleHBlY3RlZCBFcnJJbnZhbGlkQ3Vyc29yIGRlY29kaW5nIGdhcmJhZ2UsIGdvdCAldiIsIGVycikKCX0KfQp7e2VuZH19Cg==\"")
 }
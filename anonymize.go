@@ -0,0 +1,215 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"log"
+	mathrand "math/rand"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/LUSHDigital/modelgen/sqltypes"
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+// anonymizeKeyEnv names the environment variable holding the key replacements are derived from.
+// Dumps made with the same key replace a value the same way, while without a key a random
+// one is picked, keeping replacements consistent within a single dump only.
+const anonymizeKeyEnv = "MODELGEN_ANONYMIZE_KEY"
+
+// maxAnonymizeAttempts caps the replacements tried for a value of a unique column, which
+// only runs out when the column is too short to tell its replacements apart.
+const maxAnonymizeAttempts = 1000
+
+// anonymizeKinds lists the kinds of values a column can be anonymized as.
+var anonymizeKinds = map[string]bool{"email": true, "name": true, "phone": true, "text": true}
+
+// anonymizeAnnotation matches the column comment annotation picking the kind of a column, such as modelgen:anonymize=email.
+var anonymizeAnnotation = regexp.MustCompile(`\bmodelgen:anonymize=(\w+)`)
+
+// piiColumn matches the names of columns likely to hold personal data, which are warned about when left as is.
+var piiColumn = regexp.MustCompile(`(?i)(e_?mail|name|phone|mobile|address|postcode|zip|birth|ip_?addr)`)
+
+// anonymizeRules returns the kind of every column to anonymize of a model, by column name. The kinds
+// come from column comment annotations, or from config entries such as user.email=email, which win.
+func anonymizeRules(model tmpl.TmplStruct, config []string) (map[string]string, error) {
+	rules := make(map[string]string)
+	for _, fl := range model.Fields {
+		if m := anonymizeAnnotation.FindStringSubmatch(fl.Comment); m != nil {
+			rules[fl.ColumnName] = m[1]
+		}
+	}
+	for _, entry := range config {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid anonymize column %q, use table.column=kind", entry)
+		}
+		if strings.HasPrefix(parts[0], model.TableName+".") {
+			rules[strings.TrimPrefix(parts[0], model.TableName+".")] = parts[1]
+		}
+	}
+	for column, kind := range rules {
+		if !anonymizeKinds[kind] {
+			return nil, fmt.Errorf("%s.%s: unknown anonymize kind %q, use email, name, phone or text", model.TableName, column, kind)
+		}
+		fl, ok := dumpField(model.Fields, column)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %s.%s to anonymize", model.TableName, column)
+		}
+		if fl.Type != "string" && fl.Type != "NullString" {
+			return nil, fmt.Errorf("%s.%s: only string columns can be anonymized", model.TableName, column)
+		}
+	}
+	return rules, nil
+}
+
+func dumpField(fields []tmpl.TmplField, column string) (tmpl.TmplField, bool) {
+	for _, fl := range fields {
+		if fl.ColumnName == column {
+			return fl, true
+		}
+	}
+	return tmpl.TmplField{}, false
+}
+
+// anonymizeKey returns the key set in the environment, or a random one.
+func anonymizeKey() []byte {
+	if key := os.Getenv(anonymizeKeyEnv); key != "" {
+		return []byte(key)
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatal(err)
+	}
+	return key
+}
+
+// anonymizer replaces values with fake ones derived from the HMAC of the value, so equal values
+// are replaced with equal ones, across columns and tables, keeping the rows referencing each other
+// by those values consistent. Replacements of unique columns are told apart when they collide.
+type anonymizer struct {
+	key []byte
+	// used holds the replacements of every unique column, and the values they replaced.
+	used map[string]map[string]string
+}
+
+func newAnonymizer(key []byte) *anonymizer {
+	return &anonymizer{key: key, used: make(map[string]map[string]string)}
+}
+
+// anonymize replaces the values of the columns of a dump following the rules, leaving NULL alone.
+// Replacements of the unique columns never collide.
+func (a *anonymizer) anonymize(d *tableDump, rules map[string]string, unique map[string]bool) {
+	for j, fl := range d.fields {
+		kind, ok := rules[fl.ColumnName]
+		if !ok {
+			if piiColumn.MatchString(fl.ColumnName) && (fl.Type == "string" || fl.Type == "NullString") {
+				log.Printf("column %s.%s is dumped as is, annotate it to anonymize it", d.model.TableName, fl.ColumnName)
+			}
+			continue
+		}
+		column := d.model.TableName + "." + fl.ColumnName
+		max := columnLength(fl.ColumnType)
+		for _, row := range d.rows {
+			if s, ok := row[j].(string); ok {
+				row[j] = a.replace(kind, column, unique[fl.ColumnName], max, s)
+			}
+		}
+	}
+}
+
+// replace returns the replacement of a value of a column, fitting max bytes when it is not zero.
+func (a *anonymizer) replace(kind, column string, unique bool, max int, value string) string {
+	if value == "" {
+		return ""
+	}
+	used := a.used[column]
+	if unique && used == nil {
+		used = make(map[string]string)
+		a.used[column] = used
+	}
+	for attempt := 0; attempt < maxAnonymizeAttempts; attempt++ {
+		mac := hmac.New(sha256.New, a.key)
+		fmt.Fprintf(mac, "%s\x00%s\x00%d", kind, value, attempt)
+		s := fake(kind, mac.Sum(nil), len(value))
+		if unique && kind == "name" && attempt > 0 {
+			// there are too few names to go around, so number them
+			s += " " + strconv.Itoa(attempt+1)
+		}
+		if max > 0 && len(s) > max {
+			s = s[:max]
+		}
+		if !unique {
+			return s
+		}
+		if original, ok := used[s]; !ok || original == value {
+			used[s] = value
+			return s
+		}
+	}
+	log.Fatalf("%s is too short to hold a unique %s for every row", column, kind)
+	return ""
+}
+
+var (
+	firstNames = []string{"Alex", "Ana", "Ben", "Chloe", "Dev", "Elif", "Femi", "Grace", "Hugo", "Isla", "Jun", "Kofi", "Lena", "Max", "Nia", "Omar", "Priya", "Quinn", "Rosa", "Sam", "Tariq", "Uma", "Vera", "Wei", "Yara", "Zoe"}
+	lastNames  = []string{"Adams", "Baker", "Chen", "Diaz", "Evans", "Fischer", "Garcia", "Hughes", "Ito", "Jones", "Khan", "Lopez", "Murphy", "Novak", "Okafor", "Patel", "Quinn", "Rossi", "Smith", "Taylor", "Usman", "Varga", "Walsh", "Young", "Zhang"}
+	loremWords = strings.Fields("lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod tempor incididunt ut labore et dolore magna aliqua enim ad minim veniam quis nostrud exercitation ullamco laboris nisi aliquip ex ea commodo consequat")
+)
+
+// fake returns a fake value of a kind, derived from a hash. Free text is as long as the text it replaces.
+func fake(kind string, hash []byte, length int) string {
+	r := mathrand.New(mathrand.NewSource(int64(binary.BigEndian.Uint64(hash))))
+	switch kind {
+	case "email":
+		return hex.EncodeToString(hash[:6]) + "@example.com"
+	case "name":
+		return firstNames[r.Intn(len(firstNames))] + " " + lastNames[r.Intn(len(lastNames))]
+	case "phone":
+		return fmt.Sprintf("+1 555 %03d %04d", r.Intn(1000), r.Intn(10000))
+	default:
+		var words []string
+		n := 0
+		for n < length {
+			w := loremWords[r.Intn(len(loremWords))]
+			words = append(words, w)
+			n += len(w) + 1
+		}
+		s := strings.Join(words, " ")
+		s = strings.ToUpper(s[:1]) + s[1:]
+		if len(s) > length {
+			s = strings.TrimSpace(s[:length])
+		}
+		return s
+	}
+}
+
+// uniqueColumns returns the columns of the unique indexes of a table, nullable ones included.
+// Replacing the distinct values of every column of an index with distinct values keeps it unique.
+func uniqueColumns(indexes []sqltypes.Index) map[string]bool {
+	unique := make(map[string]bool)
+	for _, idx := range indexes {
+		for _, column := range idx.Columns {
+			unique[column] = true
+		}
+	}
+	return unique
+}
+
+var lengthRegExp = regexp.MustCompile(`^(?i)(?:var)?char\((\d+)\)`)
+
+// columnLength returns the maximum length of a char or varchar column, or zero for other columns.
+func columnLength(columnType string) int {
+	m := lengthRegExp.FindStringSubmatch(columnType)
+	if m == nil {
+		return 0
+	}
+	n, _ := strconv.Atoi(m[1])
+	return n
+}
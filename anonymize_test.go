@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/LUSHDigital/modelgen/sqltypes"
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+var anonymizeModel = tmpl.TmplStruct{
+	Name:      "User",
+	TableName: "user",
+	Fields: []tmpl.TmplField{
+		{Name: "ID", Type: "int64", ColumnName: "id", ColumnType: "bigint(20)"},
+		{Name: "Email", Type: "string", ColumnName: "email", ColumnType: "varchar(255)", Comment: "login modelgen:anonymize=email"},
+		{Name: "Name", Type: "NullString", ColumnName: "name", ColumnType: "varchar(8)"},
+		{Name: "Bio", Type: "string", ColumnName: "bio", ColumnType: "text"},
+		{Name: "Age", Type: "int64", ColumnName: "age", ColumnType: "int(11)"},
+	},
+}
+
+func TestAnonymizeRules(t *testing.T) {
+	rules, err := anonymizeRules(anonymizeModel, []string{"user.name=name", "user.bio=text", "order.note=text"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 3 || rules["email"] != "email" || rules["name"] != "name" || rules["bio"] != "text" {
+		t.Errorf("anonymizeRules() = %v", rules)
+	}
+	for _, config := range []string{"user.age=text", "user.bio=address", "user.missing=text", "user.bio"} {
+		if _, err := anonymizeRules(anonymizeModel, []string{config}); err == nil {
+			t.Errorf("anonymizeRules(%s) succeeded, want an error", config)
+		}
+	}
+}
+
+func TestAnonymize(t *testing.T) {
+	rows := func() [][]interface{} {
+		return [][]interface{}{
+			{int64(1), "ada@lush.com", "Ada Lovelace", "Wrote the first program.", int64(36)},
+			{int64(2), "alan@lush.com", nil, "", int64(41)},
+			{int64(3), "ada@lush.com", "Ada Lovelace", "Wrote the first program.", int64(36)},
+		}
+	}
+	rules := map[string]string{"email": "email", "name": "name", "bio": "text"}
+	d := tableDump{model: anonymizeModel, fields: anonymizeModel.Fields, rows: rows()}
+	newAnonymizer([]byte("key")).anonymize(&d, rules, map[string]bool{"email": true})
+
+	first := d.rows[0]
+	if first[0] != int64(1) || first[4] != int64(36) {
+		t.Errorf("columns without rules changed: %v", first)
+	}
+	email := first[1].(string)
+	if strings.Contains(email, "ada") || !strings.HasSuffix(email, "@example.com") {
+		t.Errorf("email = %s", email)
+	}
+	if name := first[2].(string); name == "Ada Lovelace" || len(name) > 8 {
+		t.Errorf("name = %s, want another one of at most 8 bytes", name)
+	}
+	if bio := first[3].(string); len(bio) != len("Wrote the first program.") || strings.Contains(bio, "program") {
+		t.Errorf("bio = %q", bio)
+	}
+	if d.rows[1][2] != nil || d.rows[1][3] != "" {
+		t.Errorf("NULL and empty values changed: %v", d.rows[1])
+	}
+	if d.rows[2][1] != email {
+		t.Errorf("equal values were replaced differently: %v and %v", email, d.rows[2][1])
+	}
+
+	again := tableDump{model: anonymizeModel, fields: anonymizeModel.Fields, rows: rows()}
+	newAnonymizer([]byte("key")).anonymize(&again, rules, map[string]bool{"email": true})
+	if again.rows[0][1] != email {
+		t.Errorf("replacements differ across dumps with the same key")
+	}
+}
+
+func TestAnonymizeUnique(t *testing.T) {
+	a := newAnonymizer([]byte("key"))
+	seen := make(map[string]bool)
+	for i := 0; i < 200; i++ {
+		// two hex characters only hold 256 replacements
+		s := a.replace("email", "user.email", true, 2, strings.Repeat("x", i+1))
+		if seen[s] {
+			t.Fatalf("replacement %s repeated", s)
+		}
+		seen[s] = true
+	}
+}
+
+func TestAnonymizeUniqueNames(t *testing.T) {
+	a := newAnonymizer([]byte("key"))
+	seen := make(map[string]bool)
+	for i := 0; i < 2000; i++ {
+		s := a.replace("name", "user.name", true, 0, "name "+strconv.Itoa(i))
+		if seen[s] {
+			t.Fatalf("replacement %s repeated", s)
+		}
+		seen[s] = true
+	}
+}
+
+func TestUniqueColumns(t *testing.T) {
+	unique := uniqueColumns([]sqltypes.Index{
+		{Name: "email", Columns: []string{"email"}},
+		{Name: "name_status", Columns: []string{"name", "status"}},
+	})
+	if len(unique) != 3 || !unique["email"] || !unique["name"] || !unique["status"] {
+		t.Errorf("uniqueColumns() = %v, want email, name and status", unique)
+	}
+}
@@ -0,0 +1,441 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+	"github.com/spf13/cobra"
+)
+
+// seedBatchSize caps the number of rows inserted by a single statement of a seed migration.
+const seedBatchSize = 100
+
+func dump(cmd *cobra.Command, args []string) {
+	validate()
+	switch *dumpFormat {
+	case "sql", "go", "json", "yaml":
+	default:
+		log.Fatalf("unknown dump format %q, use sql, go, json or yaml", *dumpFormat)
+	}
+	if len(*dumpTables) == 0 {
+		log.Fatal("Please provide the tables to dump")
+	}
+	connect()
+
+	all := getTables()
+	tables := make(map[string]string)
+	for _, table := range *dumpTables {
+		comment, ok := all[table]
+		if !ok {
+			log.Fatalf("unknown table %s, or it has no id primary key", table)
+		}
+		tables[table] = comment
+	}
+	models := ToStructs(tables)
+	sortDumps(models, tables)
+
+	var dumps []tableDump
+	for _, model := range models {
+		fields := dumpFields(model, *dumpColumns)
+		rows, err := readRows(model.TableName, fields, *dumpLimit)
+		if err != nil {
+			log.Fatal(err)
+		}
+		dumps = append(dumps, tableDump{model: model, fields: fields, rows: rows})
+	}
+	if *anonymize || len(*anonColumns) > 0 {
+		a := newAnonymizer(anonymizeKey())
+		for i := range dumps {
+			rules, err := anonymizeRules(dumps[i].model, *anonColumns)
+			if err != nil {
+				log.Fatal(err)
+			}
+			a.anonymize(&dumps[i], rules, uniqueColumns(getUniqueIndexes(dumps[i].model.TableName)))
+		}
+	}
+	for _, col := range *dumpColumns {
+		if !dumpColumnUsed(dumps, col) {
+			log.Fatalf("unknown column %s", col)
+		}
+	}
+	writeDumps(dumps, *dumpFormat)
+}
+
+// sortDumps orders the models to dump by the order comments of their tables, seeding the
+// referenced tables first as migrate creates them, and by name, so dumps of the same
+// database do not change from one run to the next.
+func sortDumps(models []tmpl.TmplStruct, tables map[string]string) {
+	sort.Slice(models, func(i, j int) bool {
+		oi, oj := GetOrderFromComment(tables[models[i].TableName]), GetOrderFromComment(tables[models[j].TableName])
+		if oi != oj {
+			return oi < oj
+		}
+		return models[i].TableName < models[j].TableName
+	})
+}
+
+// tableDump holds the rows read from a table. Values are nil for NULL,
+// or of the type matching their field: int64, json.Number, bool, string,
+// time.Time, []byte or json.RawMessage.
+type tableDump struct {
+	model  tmpl.TmplStruct
+	fields []tmpl.TmplField
+	rows   [][]interface{}
+}
+
+// dumpFields returns the fields of a model to dump: the id, and the columns listed
+// either as column or as table.column, or every field when none is listed.
+func dumpFields(model tmpl.TmplStruct, columns []string) []tmpl.TmplField {
+	if len(columns) == 0 {
+		return model.Fields
+	}
+	var fields []tmpl.TmplField
+	for _, fl := range model.Fields {
+		if fl.ColumnName == "id" {
+			fields = append(fields, fl)
+			continue
+		}
+		for _, col := range columns {
+			if col == fl.ColumnName || col == model.TableName+"."+fl.ColumnName {
+				fields = append(fields, fl)
+				break
+			}
+		}
+	}
+	return fields
+}
+
+// dumpColumnUsed reports whether a column given to --columns names a column of a dumped table.
+func dumpColumnUsed(dumps []tableDump, col string) bool {
+	for _, d := range dumps {
+		for _, fl := range d.fields {
+			if col == fl.ColumnName || col == d.model.TableName+"."+fl.ColumnName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readRows reads up to limit rows of a table ordered by id, or every row when limit is zero.
+func readRows(table string, fields []tmpl.TmplField, limit int) ([][]interface{}, error) {
+	cols := make([]string, len(fields))
+	for i, fl := range fields {
+		cols[i] = backtick(fl.ColumnName)
+	}
+	stmt := fmt.Sprintf("SELECT %s FROM %s ORDER BY `id`", strings.Join(cols, ", "), backtick(table))
+	if limit > 0 {
+		stmt += " LIMIT " + strconv.Itoa(limit)
+	}
+	rows, err := database.Query(stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var set [][]interface{}
+	for rows.Next() {
+		raw := make([]interface{}, len(fields))
+		ptrs := make([]interface{}, len(fields))
+		for i := range raw {
+			ptrs[i] = &raw[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		row := make([]interface{}, len(fields))
+		for i, fl := range fields {
+			if row[i], err = dumpValue(fl, raw[i]); err != nil {
+				return nil, fmt.Errorf("%s.%s: %v", table, fl.ColumnName, err)
+			}
+		}
+		set = append(set, row)
+	}
+	return set, rows.Err()
+}
+
+// dumpValue converts a value read from the column of a field to the type matching the field.
+func dumpValue(fl tmpl.TmplField, raw interface{}) (interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var text string
+	switch v := raw.(type) {
+	case time.Time:
+		return v, nil
+	case []byte:
+		text = string(v)
+	default:
+		text = fmt.Sprint(v)
+	}
+	switch strings.TrimPrefix(fl.Type, "Null") {
+	case "int64", "Int64":
+		return strconv.ParseInt(text, 10, 64)
+	case "float64", "Float64":
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			return nil, err
+		}
+		return json.Number(text), nil
+	case "bool", "Bool":
+		n, err := strconv.ParseInt(text, 10, 64)
+		return n != 0, err
+	case "time.Time", "Time":
+		t, err := time.Parse("2006-01-02 15:04:05.999999", text)
+		if err != nil {
+			t, err = time.Parse("2006-01-02", text)
+		}
+		return t, err
+	case "[]byte":
+		return []byte(text), nil
+	case "RawJSON":
+		return json.RawMessage(text), nil
+	default:
+		return text, nil
+	}
+}
+
+// writeDumps writes the rows of every table in the given format.
+func writeDumps(dumps []tableDump, format string) {
+	out := *output
+	os.Mkdir(out, 0777)
+
+	if format == "go" {
+		t := loadTemplates()
+		for _, d := range dumps {
+			writeTemplate(t, "fixtures", goFixtures(d), filepath.Join(out, d.model.TableName+"_fixtures.go"))
+		}
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, d := range dumps {
+		switch format {
+		case "sql":
+			if len(d.rows) == 0 {
+				log.Printf("table %s has no rows to seed", d.model.TableName)
+				continue
+			}
+			up, down := seedMigration(d)
+			writeFile(filepath.Join(out, fmt.Sprintf("%d_seed_%s.up.sql", now, d.model.TableName)), up)
+			writeFile(filepath.Join(out, fmt.Sprintf("%d_seed_%s.down.sql", now, d.model.TableName)), down)
+			// Ensure the time increments properly, as migrate does
+			now++
+		case "json":
+			writeFile(filepath.Join(out, d.model.TableName+".json"), jsonDump(d))
+		case "yaml":
+			writeFile(filepath.Join(out, d.model.TableName+".yaml"), yamlDump(d))
+		}
+	}
+}
+
+func writeFile(path string, b []byte) {
+	if err := ioutil.WriteFile(path, b, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// seedMigration returns the up migration inserting the rows of a table, and the down migration deleting them by id.
+func seedMigration(d tableDump) (up, down []byte) {
+	cols := make([]string, len(d.fields))
+	for i, fl := range d.fields {
+		cols[i] = backtick(fl.ColumnName)
+	}
+	var ids []string
+	buf := new(bytes.Buffer)
+	for i, row := range d.rows {
+		if i%seedBatchSize == 0 {
+			if i > 0 {
+				buf.WriteString(";\n")
+			}
+			fmt.Fprintf(buf, "INSERT INTO %s (%s) VALUES\n", backtick(d.model.TableName), strings.Join(cols, ", "))
+		} else {
+			buf.WriteString(",\n")
+		}
+		values := make([]string, len(row))
+		for j, v := range row {
+			values[j] = sqlLiteral(v)
+			if d.fields[j].ColumnName == "id" {
+				ids = append(ids, values[j])
+			}
+		}
+		fmt.Fprintf(buf, "(%s)", strings.Join(values, ", "))
+	}
+	buf.WriteString(";\n")
+	down = []byte(fmt.Sprintf("DELETE FROM %s WHERE `id` IN (%s);\n", backtick(d.model.TableName), strings.Join(ids, ", ")))
+	return buf.Bytes(), down
+}
+
+// sqlLiteral returns the MySQL literal of a dumped value.
+func sqlLiteral(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case json.Number:
+		return string(v)
+	case bool:
+		if v {
+			return "1"
+		}
+		return "0"
+	case time.Time:
+		return "'" + v.Format("2006-01-02 15:04:05.999999") + "'"
+	case []byte:
+		if len(v) == 0 {
+			return "''"
+		}
+		return "X'" + hex.EncodeToString(v) + "'"
+	case json.RawMessage:
+		return sqlString(string(v))
+	default:
+		return sqlString(fmt.Sprint(v))
+	}
+}
+
+// sqlString quotes s as a MySQL string literal.
+func sqlString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `''`, "\x00", `\0`, "\n", `\n`, "\r", `\r`, "\x1a", `\Z`)
+	return "'" + r.Replace(s) + "'"
+}
+
+// jsonValue returns the JSON encoding of a dumped value, the way the generated models encode it.
+func jsonValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case json.RawMessage:
+		if json.Valid(v) {
+			return string(v)
+		}
+		return jsonString(string(v))
+	case []byte:
+		return jsonString(base64.StdEncoding.EncodeToString(v))
+	case time.Time:
+		return jsonString(v.Format(time.RFC3339Nano))
+	case string:
+		return jsonString(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func jsonString(s string) string {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSpace(buf.String())
+}
+
+// jsonDump returns the rows of a table as an indented JSON array of objects, keyed by column in column order.
+func jsonDump(d tableDump) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte('[')
+	for i, row := range d.rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for j, v := range row {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			buf.WriteString(jsonString(d.fields[j].ColumnName) + ":" + jsonValue(v))
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+	indented := new(bytes.Buffer)
+	if err := json.Indent(indented, buf.Bytes(), "", "  "); err != nil {
+		log.Fatal(err)
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes()
+}
+
+// yamlDump returns the rows of a table as a YAML sequence of mappings. Values are written
+// in their JSON encoding, which YAML reads as flow scalars and collections.
+func yamlDump(d tableDump) []byte {
+	if len(d.rows) == 0 {
+		return []byte("[]\n")
+	}
+	buf := new(bytes.Buffer)
+	for _, row := range d.rows {
+		for j, v := range row {
+			indent := "  "
+			if j == 0 {
+				indent = "- "
+			}
+			fmt.Fprintf(buf, "%s%s: %s\n", indent, yamlKey(d.fields[j].ColumnName), jsonValue(v))
+		}
+	}
+	return buf.Bytes()
+}
+
+// yamlKey quotes a column name unless it is made of letters, digits and underscores only.
+func yamlKey(s string) string {
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return jsonString(s)
+		}
+	}
+	if s == "" {
+		return `""`
+	}
+	return s
+}
+
+// goFixtures returns the Go fixtures of the rows of a table, literals of its model.
+func goFixtures(d tableDump) tmpl.TmplFixtures {
+	f := tmpl.TmplFixtures{PackageName: *pkgName, Model: d.model}
+	for _, row := range d.rows {
+		values := make([]tmpl.TmplFixtureValue, len(row))
+		for j, v := range row {
+			if _, ok := v.(time.Time); ok && len(f.Imports) == 0 {
+				f.Imports = []string{"time"}
+			}
+			values[j] = tmpl.TmplFixtureValue{Field: d.fields[j], Value: goLiteral(d.fields[j], v)}
+		}
+		f.Rows = append(f.Rows, values)
+	}
+	return f
+}
+
+// goLiteral returns the Go expression of a dumped value, of the type of its field.
+func goLiteral(fl tmpl.TmplField, v interface{}) string {
+	if v == nil {
+		return tmpl.GetNullValue(fl)
+	}
+	var expr string
+	switch v := v.(type) {
+	case time.Time:
+		expr = fmt.Sprintf("time.Date(%d, %d, %d, %d, %d, %d, %d, time.UTC)",
+			v.Year(), v.Month(), v.Day(), v.Hour(), v.Minute(), v.Second(), v.Nanosecond())
+	case []byte:
+		return "[]byte(" + strconv.Quote(string(v)) + ")"
+	case json.RawMessage:
+		return "RawJSON(" + strconv.Quote(string(v)) + ")"
+	case string:
+		expr = strconv.Quote(v)
+	default:
+		expr = fmt.Sprint(v)
+	}
+	if strings.HasPrefix(fl.Type, "Null") {
+		field := strings.TrimPrefix(fl.Type, "Null")
+		return fmt.Sprintf("%s{%s: %s, Valid: true}", fl.Type, field, expr)
+	}
+	return expr
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+var testDump = tableDump{
+	model: tmpl.TmplStruct{Name: "Country", TableName: "country"},
+	fields: []tmpl.TmplField{
+		{Name: "ID", Type: "int64", ColumnName: "id"},
+		{Name: "Name", Type: "NullString", ColumnName: "name"},
+		{Name: "Rate", Type: "float64", ColumnName: "rate"},
+		{Name: "Active", Type: "bool", ColumnName: "active"},
+		{Name: "Founded", Type: "time.Time", ColumnName: "founded"},
+		{Name: "Flag", Type: "[]byte", ColumnName: "flag"},
+		{Name: "Meta", Type: "RawJSON", ColumnName: "meta"},
+	},
+	rows: [][]interface{}{
+		{int64(1), "Côte d'Ivoire", json.Number("1.50"), true, time.Date(1960, 8, 7, 0, 0, 0, 0, time.UTC), []byte{0xca, 0xfe}, json.RawMessage(`{"a":1}`)},
+		{int64(2), nil, json.Number("0"), false, time.Date(2011, 7, 9, 12, 30, 0, 0, time.UTC), nil, nil},
+	},
+}
+
+func TestSeedMigration(t *testing.T) {
+	up, down := seedMigration(testDump)
+	wantUp := "INSERT INTO `country` (`id`, `name`, `rate`, `active`, `founded`, `flag`, `meta`) VALUES\n" +
+		"(1, 'Côte d''Ivoire', 1.50, 1, '1960-08-07 00:00:00', X'cafe', '{\"a\":1}'),\n" +
+		"(2, NULL, 0, 0, '2011-07-09 12:30:00', NULL, NULL);\n"
+	if string(up) != wantUp {
+		t.Errorf("up migration:\n%s\nwant:\n%s", up, wantUp)
+	}
+	if want := "DELETE FROM `country` WHERE `id` IN (1, 2);\n"; string(down) != want {
+		t.Errorf("down migration = %q, want %q", down, want)
+	}
+}
+
+func TestSeedMigrationBatches(t *testing.T) {
+	d := tableDump{model: testDump.model, fields: testDump.fields[:1]}
+	for i := 0; i < seedBatchSize+1; i++ {
+		d.rows = append(d.rows, []interface{}{int64(i + 1)})
+	}
+	up, _ := seedMigration(d)
+	if n := strings.Count(string(up), "INSERT INTO"); n != 2 {
+		t.Errorf("got %d INSERT statements, want 2", n)
+	}
+}
+
+func TestSQLString(t *testing.T) {
+	if got, want := sqlString("a'b\\c\nd"), `'a''b\\c\nd'`; got != want {
+		t.Errorf("sqlString() = %s, want %s", got, want)
+	}
+}
+
+func TestJSONDump(t *testing.T) {
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(jsonDump(testDump), &rows); err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(rows))
+	}
+	if rows[0]["flag"] != "yv4=" || rows[0]["founded"] != "1960-08-07T00:00:00Z" || rows[1]["name"] != nil {
+		t.Errorf("unexpected rows %v", rows)
+	}
+	if got := string(jsonDump(tableDump{})); got != "[]\n" {
+		t.Errorf("empty dump = %q", got)
+	}
+}
+
+func TestYAMLDump(t *testing.T) {
+	want := `- id: 1
+  name: "Côte d'Ivoire"
+  rate: 1.50
+  active: true
+  founded: "1960-08-07T00:00:00Z"
+  flag: "yv4="
+  meta: {"a":1}
+- id: 2
+  name: null
+  rate: 0
+  active: false
+  founded: "2011-07-09T12:30:00Z"
+  flag: null
+  meta: null
+`
+	if got := string(yamlDump(testDump)); got != want {
+		t.Errorf("yamlDump() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestGoLiteral(t *testing.T) {
+	tests := []struct {
+		field int
+		value interface{}
+		want  string
+	}{
+		{1, "Côte d'Ivoire", `NullString{String: "Côte d'Ivoire", Valid: true}`},
+		{1, nil, "NullString{}"},
+		{4, time.Date(1960, 8, 7, 0, 0, 0, 5, time.UTC), "time.Date(1960, 8, 7, 0, 0, 0, 5, time.UTC)"},
+		{5, nil, "nil"},
+		{6, json.RawMessage(`{"a":1}`), `RawJSON("{\"a\":1}")`},
+	}
+	for _, tt := range tests {
+		if got := goLiteral(testDump.fields[tt.field], tt.value); got != tt.want {
+			t.Errorf("goLiteral(%v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDumpFields(t *testing.T) {
+	model := tmpl.TmplStruct{TableName: "country", Fields: testDump.fields}
+	fields := dumpFields(model, []string{"name", "country.rate", "currency.active"})
+	var names []string
+	for _, fl := range fields {
+		names = append(names, fl.ColumnName)
+	}
+	if got := strings.Join(names, ","); got != "id,name,rate" {
+		t.Errorf("dumpFields() = %s, want id,name,rate", got)
+	}
+}
+
+func TestSortDumps(t *testing.T) {
+	tables := map[string]string{"region": "", "currency": "", "user": "modelgen:1", "order": "modelgen:2", "country": ""}
+	var models []tmpl.TmplStruct
+	for _, table := range []string{"region", "order", "country", "user", "currency"} {
+		models = append(models, tmpl.TmplStruct{TableName: table})
+	}
+	sortDumps(models, tables)
+	var names []string
+	for _, m := range models {
+		names = append(names, m.TableName)
+	}
+	if got := strings.Join(names, ","); got != "country,currency,region,user,order" {
+		t.Errorf("sortDumps() = %s, want country,currency,region,user,order", got)
+	}
+}
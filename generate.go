@@ -2,23 +2,26 @@ package main
 
 import (
 	"bytes"
+	"database/sql"
 	"go/format"
-	"html/template"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/LUSHDigital/modelgen/sqlfmt"
 	"github.com/LUSHDigital/modelgen/sqltypes"
 	"github.com/LUSHDigital/modelgen/tmpl"
+	"github.com/go-sql-driver/mysql"
 	"github.com/spf13/cobra"
 )
 
 func generate(cmd *cobra.Command, args []string) {
 	validate()
+	validateLang()
 	connect()
 
 	// get the list of tables from the database
@@ -29,53 +32,159 @@ func generate(cmd *cobra.Command, args []string) {
 
 	// make structs from tables
 	asStructs := ToStructs(tables)
-
-	// load the model template
-	modelTpl, err := box.MustBytes("model.html")
-	if err != nil {
-		log.Fatal("cannot load model template")
+	if *lang == "typescript" {
+		writeTypeScript(asStructs)
+		return
 	}
-	t := template.Must(template.New("model").Funcs(tmpl.FuncMap).Parse(string(modelTpl)))
 
-	writeModels(asStructs, t)
+	writeModels(asStructs, getLookups(asStructs), loadTemplates())
 
 	// copy in helpers and test suite
 	copyFile("x_helpers.html", "x_helpers.go", "helpers")
 	copyFile("x_helpers_test.html", "x_helpers_test.go", "helperstest")
+	copyFile("x_query.html", "x_query.go", "query")
+	copyFile("x_query_test.html", "x_query_test.go", "querytest")
+	if *protoPkg != "" {
+		copyFile("x_proto.html", "x_proto.go", "protohelpers")
+	}
+	if *httpHandler {
+		copyFile("x_http.html", "x_http.go", "httphelpers")
+		copyFile("x_http_test.html", "x_http_test.go", "httptest")
+	}
+	if *integration {
+		copyFile("x_integration_test.html", "x_integration_test.go", "integrationtest")
+	}
+}
+
+// testDSNEnv names the environment variable the generated integration tests
+// read the DSN of their database from.
+const testDSNEnv = "MODELGEN_TEST_DSN"
+
+// factoriesDir names the directory of the factories package, within the models package.
+const factoriesDir = "factories"
+
+// loadTemplates parses the templates rendered for every model.
+func loadTemplates() *template.Template {
+	t := template.New("model").Funcs(tmpl.FuncMap)
+	for _, name := range []string{"model.html", "model_test.html", "repository.html", "proto.html", "model_proto.html", "model_http.html", "typescript.html", "factory.html", "x_factories.html", "fixtures.html", "lookup.html"} {
+		src, err := box.MustBytes(name)
+		if err != nil {
+			log.Fatalf("cannot load %s template", name)
+		}
+		template.Must(t.Parse(string(src)))
+	}
+	return t
 }
 
-func writeModels(models []tmpl.TmplStruct, t *template.Template) {
+func writeModels(models []tmpl.TmplStruct, lookups map[string]tmpl.TmplLookup, t *template.Template) {
+	out := *output
+	os.Mkdir(out, 0777)
+
+	if *modelsPath != "" {
+		os.Mkdir(filepath.Join(out, factoriesDir), 0777)
+		writeTemplate(t, "factories", tmpl.TmplFactory{Import: *modelsPath, Package: *pkgName}, filepath.Join(out, factoriesDir, "x_factories.go"))
+	}
+
+	var lock protoLock
+	if *protoPkg != "" {
+		lock = loadProtoLock(filepath.Join(out, protoLockFile))
+	}
+
 	for _, model := range models {
 		m := tmpl.StructTmplData{
 			Model:       model,
 			Receiver:    strings.ToLower(string(model.Name[0])),
 			PackageName: *pkgName,
+			ContextOnly: *contextOnly,
+			SoftDelete:  softDeleteColumn(model, *softDelete),
+			Version:     versionColumn(model, *lockVersion),
+			Validate:    *validateAll,
+			Lookup:      lookups[model.TableName],
+		}
+		if lock != nil {
+			m.Proto = lock.message(model, *protoPkg)
 		}
 
-		buf := new(bytes.Buffer)
-		err := t.Execute(buf, m)
-		if err != nil {
-			log.Fatal(err)
+		p := filepath.Join(out, model.TableName)
+		writeTemplate(t, "model", m, p+".go")
+		writeTemplate(t, "repository", m, p+"_repository.go")
+		if m.Lookup.Type != "" {
+			writeTemplate(t, "lookup", m, p+"_lookup.go")
+		}
+		if lock != nil {
+			writeTemplate(t, "proto", m, p+".proto")
+			writeTemplate(t, "modelproto", m, p+"_proto.go")
+		}
+		if *httpHandler {
+			writeTemplate(t, "modelhttp", m, p+"_handler.go")
+		}
+		if *modelsPath != "" {
+			m.Factory = tmpl.GetFactory(model, models, *modelsPath, *pkgName, m.SoftDelete, m.Version)
+			writeTemplate(t, "factory", m, filepath.Join(out, factoriesDir, model.TableName+".go"))
 		}
+		if *integration {
+			writeTemplate(t, "modeltest", m, p+"_test.go")
+		}
+	}
 
-		formatted, err := format.Source(buf.Bytes())
-		if err != nil {
+	if lock != nil {
+		lock.save(filepath.Join(out, protoLockFile))
+	}
+}
+
+// writeTemplate renders the named template to a formatted Go source file.
+func writeTemplate(t *template.Template, name string, data interface{}, path string) {
+	buf := new(bytes.Buffer)
+	err := t.ExecuteTemplate(buf, name, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	formatted := buf.Bytes()
+	if filepath.Ext(path) == ".go" {
+		if formatted, err = format.Source(formatted); err != nil {
 			log.Fatal(err)
 		}
+	}
 
-		buf = bytes.NewBuffer(formatted)
+	f, err := os.Create(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	f.Write(formatted)
+	f.Close()
+}
 
-		out := *output
-		os.Mkdir(out, 0777)
+// softDeleteColumn returns the column soft deleted rows of the model are marked with,
+// which needs to be a nullable time column, or nothing if the model has no such column.
+func softDeleteColumn(model tmpl.TmplStruct, column string) string {
+	for _, fl := range model.Fields {
+		if column == "" || fl.ColumnName != column {
+			continue
+		}
+		if fl.Type != "NullTime" {
+			log.Printf("cannot soft delete %s rows, the %s column must be a nullable date or time", model.TableName, column)
+			return ""
+		}
+		return column
+	}
+	return ""
+}
 
-		p := filepath.Join(out, model.TableName)
-		f, err := os.Create(p + ".go")
-		if err != nil {
-			log.Fatal(err)
+// versionColumn returns the column rows of the model are optimistically locked with,
+// which needs to be a non nullable integer column, or nothing if the model has no such column.
+func versionColumn(model tmpl.TmplStruct, column string) string {
+	for _, fl := range model.Fields {
+		if column == "" || fl.ColumnName != column {
+			continue
+		}
+		if fl.Type != "int64" {
+			log.Printf("cannot lock %s rows, the %s column must be a non nullable integer", model.TableName, column)
+			return ""
 		}
-		buf.WriteTo(f)
-		f.Close()
+		return column
 	}
+	return ""
 }
 
 func getTables() (tables map[string]string) {
@@ -124,21 +233,21 @@ func GetOrderFromComment(comment string) (order int) {
 
 // backtick is needed is the user picked a table name
 // which conflicts with a builtin keyword, example "order"
-func backtick(s string) string { return "`" + s + "`" }
+func backtick(s string) string { return tmpl.QuoteIdent(s) }
 
-// ToStructs takes an 'EXPLAIN' statement and transforms it's output
+// ToStructs takes a 'SHOW FULL COLUMNS' statement and transforms it's output
 // into structs.
 func ToStructs(tables map[string]string) []tmpl.TmplStruct {
 	var explained = make(map[string][]sqltypes.Explain)
 	for table := range tables {
 		var expl []sqltypes.Explain
-		rows, err := database.Query("EXPLAIN " + backtick(table))
+		rows, err := database.Query("SHOW FULL COLUMNS FROM " + backtick(table))
 		if err != nil {
 			log.Fatal(err)
 		}
 		for rows.Next() {
 			var ex sqltypes.Explain
-			if err := rows.Scan(&ex.Field, &ex.Type, &ex.Null, &ex.Key, &ex.Default, &ex.Extra); err != nil {
+			if err := rows.Scan(&ex.Field, &ex.Type, &ex.Collation, &ex.Null, &ex.Key, &ex.Default, &ex.Extra, &ex.Privileges, &ex.Comment); err != nil {
 				log.Fatal(err)
 			}
 			expl = append(expl, ex)
@@ -147,6 +256,11 @@ func ToStructs(tables map[string]string) []tmpl.TmplStruct {
 		explained[table] = expl
 	}
 
+	var indexed = make(map[string][]sqltypes.Index)
+	for table := range tables {
+		indexed[table] = getUniqueIndexes(table)
+	}
+
 	var structStore tmpl.TmplStructs
 	for k, explain := range explained {
 		t := tmpl.TmplStruct{
@@ -160,19 +274,188 @@ func ToStructs(tables map[string]string) []tmpl.TmplStruct {
 				Name:       sqlfmt.ToPascalCase(*expl.Field),
 				Type:       sqltypes.AssertType(*expl.Type, *expl.Null),
 				ColumnName: strings.ToLower(*expl.Field),
+				ColumnType: *expl.Type,
 				Nullable:   *expl.Null == "YES",
+				AutoInc:    expl.Extra != nil && strings.Contains(*expl.Extra, "auto_increment"),
+			}
+			if expl.Comment != nil {
+				f.Comment = *expl.Comment
+			}
+			if expl.Default != nil {
+				f.Default = *expl.Default
+				f.HasDefault = true
 			}
 			t.Fields = append(t.Fields, f)
 			if imp, ok := sqltypes.NeedsImport(f.Type); ok {
 				t.Imports[imp] = struct{}{}
 			}
 		}
+		t.Keys = ToKeys(t.Fields, indexed[k])
+		t.Uniques = ToUniques(t.Fields, indexed[k])
+		t.Checks = getChecks(k)
+		t.ForeignKeys = getForeignKeys(k)
 		structStore = append(structStore, t)
 	}
 
 	return structStore
 }
 
+// getUniqueIndexes reads the unique secondary indexes of a table.
+func getUniqueIndexes(table string) (indexes []sqltypes.Index) {
+	const stmt = `SELECT index_name, column_name
+				  FROM information_schema.statistics
+				  WHERE table_schema = ?
+				  AND table_name = ?
+				  AND non_unique = 0
+				  AND index_name <> "PRIMARY"
+				  ORDER BY index_name, seq_in_index`
+
+	rows, err := database.Query(stmt, *dbName, table)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		var column sql.NullString
+		if err := rows.Scan(&name, &column); err != nil {
+			log.Fatal(err)
+		}
+		if len(indexes) == 0 || indexes[len(indexes)-1].Name != name {
+			indexes = append(indexes, sqltypes.Index{Name: name})
+		}
+		// functional indexes have no column, which ToKeys will skip
+		idx := &indexes[len(indexes)-1]
+		idx.Columns = append(idx.Columns, column.String)
+	}
+	return indexes
+}
+
+// getChecks reads the CHECK constraints of a table.
+// Servers without check constraints, before MySQL 8.0.16, have none to report.
+func getChecks(table string) (checks []tmpl.TmplCheck) {
+	const stmt = `SELECT cc.constraint_name, cc.check_clause
+				  FROM information_schema.table_constraints AS tc
+				  JOIN information_schema.check_constraints AS cc
+				  ON cc.constraint_schema = tc.constraint_schema
+				  AND cc.constraint_name = tc.constraint_name
+				  WHERE tc.table_schema = ?
+				  AND tc.table_name = ?
+				  AND tc.constraint_type = "CHECK"
+				  ORDER BY cc.constraint_name`
+
+	rows, err := database.Query(stmt, *dbName, table)
+	if e, ok := err.(*mysql.MySQLError); ok && e.Number == 1109 {
+		// unknown table information_schema.check_constraints
+		return nil
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var check tmpl.TmplCheck
+		if err := rows.Scan(&check.Name, &check.Clause); err != nil {
+			log.Fatal(err)
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// getForeignKeys reads the foreign keys of a table made of a single column.
+func getForeignKeys(table string) (keys []tmpl.TmplForeignKey) {
+	const stmt = `SELECT constraint_name, MIN(column_name), MIN(referenced_table_name), MIN(referenced_column_name)
+				  FROM information_schema.key_column_usage
+				  WHERE table_schema = ?
+				  AND table_name = ?
+				  AND referenced_table_schema = table_schema
+				  GROUP BY constraint_name
+				  HAVING COUNT(*) = 1
+				  ORDER BY constraint_name`
+
+	rows, err := database.Query(stmt, *dbName, table)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var key tmpl.TmplForeignKey
+		if err := rows.Scan(&key.Name, &key.Column, &key.RefTable, &key.RefColumn); err != nil {
+			log.Fatal(err)
+		}
+		key.Column = strings.ToLower(key.Column)
+		key.RefColumn = strings.ToLower(key.RefColumn)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ToKeys returns the keys usable for keyset pagination: the primary id,
+// followed by every unique index made only of non nullable columns.
+func ToKeys(fields []tmpl.TmplField, indexes []sqltypes.Index) []tmpl.TmplKey {
+	byColumn := make(map[string]tmpl.TmplField)
+	for _, f := range fields {
+		byColumn[f.ColumnName] = f
+	}
+
+	var keys []tmpl.TmplKey
+	seen := make(map[string]bool)
+	if id, ok := byColumn["id"]; ok {
+		keys = append(keys, tmpl.TmplKey{Fields: []tmpl.TmplField{id}})
+		seen[""], seen[id.Name] = true, true
+	}
+
+indexes:
+	for _, idx := range indexes {
+		var key tmpl.TmplKey
+		for _, col := range idx.Columns {
+			f, ok := byColumn[strings.ToLower(col)]
+			if !ok || f.Nullable {
+				continue indexes
+			}
+			key.Name += f.Name
+			key.Fields = append(key.Fields, f)
+		}
+		if len(key.Fields) == 0 || seen[key.Name] {
+			continue
+		}
+		seen[key.Name] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ToUniques returns the unique secondary indexes of a table, nullable columns included,
+// skipping those on expressions rather than columns.
+func ToUniques(fields []tmpl.TmplField, indexes []sqltypes.Index) []tmpl.TmplKey {
+	byColumn := make(map[string]tmpl.TmplField)
+	for _, f := range fields {
+		byColumn[f.ColumnName] = f
+	}
+
+	var uniques []tmpl.TmplKey
+indexes:
+	for _, idx := range indexes {
+		var key tmpl.TmplKey
+		for _, col := range idx.Columns {
+			f, ok := byColumn[strings.ToLower(col)]
+			if !ok {
+				continue indexes
+			}
+			key.Name += f.Name
+			key.Fields = append(key.Fields, f)
+		}
+		if len(key.Fields) > 0 {
+			uniques = append(uniques, key)
+		}
+	}
+	return uniques
+}
+
 func copyFile(src, dst, templateName string) {
 	dbFile, err := box.MustBytes(src)
 	if err != nil {
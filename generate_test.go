@@ -1,6 +1,18 @@
 package main
 
-import "testing"
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/LUSHDigital/modelgen/sqltypes"
+	"github.com/LUSHDigital/modelgen/tmpl"
+	"github.com/gobuffalo/packr"
+)
 
 func TestGetOrderFromComment(t *testing.T) {
 	type args struct {
@@ -34,3 +46,255 @@ func TestGetOrderFromComment(t *testing.T) {
 		})
 	}
 }
+
+func TestToKeys(t *testing.T) {
+	fields := []tmpl.TmplField{
+		{Name: "ID", ColumnName: "id"},
+		{Name: "Email", ColumnName: "email"},
+		{Name: "Nickname", ColumnName: "nickname", Nullable: true},
+		{Name: "TenantID", ColumnName: "tenant_id"},
+		{Name: "Slug", ColumnName: "slug"},
+	}
+	tests := []struct {
+		name     string
+		indexes  []sqltypes.Index
+		wantKeys []string
+	}{
+		{
+			name:     "primary only",
+			wantKeys: []string{""},
+		},
+		{
+			name: "unique indexes",
+			indexes: []sqltypes.Index{
+				{Name: "email", Columns: []string{"email"}},
+				{Name: "tenant_slug", Columns: []string{"tenant_id", "slug"}},
+			},
+			wantKeys: []string{"", "Email", "TenantIDSlug"},
+		},
+		{
+			name: "skipped indexes",
+			indexes: []sqltypes.Index{
+				{Name: "id", Columns: []string{"id"}},
+				{Name: "nickname", Columns: []string{"nickname"}},
+				{Name: "functional", Columns: []string{""}},
+				{Name: "email", Columns: []string{"email"}},
+				{Name: "email_again", Columns: []string{"email"}},
+			},
+			wantKeys: []string{"", "Email"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotKeys []string
+			for _, k := range ToKeys(fields, tt.indexes) {
+				gotKeys = append(gotKeys, k.Name)
+			}
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("ToKeys() = %q, want %q", gotKeys, tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestToUniques(t *testing.T) {
+	fields := []tmpl.TmplField{
+		{Name: "ID", ColumnName: "id"},
+		{Name: "Email", ColumnName: "email"},
+		{Name: "Nickname", ColumnName: "nickname", Nullable: true},
+		{Name: "TenantID", ColumnName: "tenant_id"},
+	}
+	indexes := []sqltypes.Index{
+		{Name: "email", Columns: []string{"email"}},
+		{Name: "tenant_nickname", Columns: []string{"tenant_id", "nickname"}},
+		{Name: "functional", Columns: []string{""}},
+	}
+	var got []string
+	for _, k := range ToUniques(fields, indexes) {
+		got = append(got, k.Name)
+	}
+	if want := []string{"Email", "TenantIDNickname"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToUniques() = %q, want %q", got, want)
+	}
+}
+
+func TestSoftDeleteColumn(t *testing.T) {
+	model := tmpl.TmplStruct{
+		TableName: "user",
+		Fields: []tmpl.TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id"},
+			{Name: "DeletedAt", Type: "NullTime", ColumnName: "deleted_at"},
+			{Name: "ArchivedAt", Type: "time.Time", ColumnName: "archived_at"},
+		},
+	}
+	tests := []struct {
+		name   string
+		column string
+		want   string
+	}{
+		{
+			name:   "nullable time column",
+			column: "deleted_at",
+			want:   "deleted_at",
+		},
+		{
+			name:   "non nullable column",
+			column: "archived_at",
+			want:   "",
+		},
+		{
+			name:   "missing column",
+			column: "removed_at",
+			want:   "",
+		},
+		{
+			name:   "disabled",
+			column: "",
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := softDeleteColumn(model, tt.column); got != tt.want {
+				t.Errorf("softDeleteColumn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionColumn(t *testing.T) {
+	model := tmpl.TmplStruct{
+		TableName: "user",
+		Fields: []tmpl.TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id"},
+			{Name: "Version", Type: "int64", ColumnName: "version"},
+			{Name: "Revision", Type: "NullInt64", ColumnName: "revision"},
+		},
+	}
+	tests := []struct {
+		name   string
+		column string
+		want   string
+	}{
+		{
+			name:   "integer column",
+			column: "version",
+			want:   "version",
+		},
+		{
+			name:   "nullable column",
+			column: "revision",
+			want:   "",
+		},
+		{
+			name:   "missing column",
+			column: "lock_version",
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versionColumn(model, tt.column); got != tt.want {
+				t.Errorf("versionColumn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelsKeepTheirReceiver(t *testing.T) {
+	box = packr.NewBox("./tmpl")
+	templates := loadTemplates()
+	fields := []tmpl.TmplField{
+		{Name: "ID", Type: "int64", ColumnName: "id"},
+		{Name: "Name", Type: "string", ColumnName: "name"},
+		{Name: "Meta", Type: "RawJSON", ColumnName: "meta", Nullable: true},
+		{Name: "UpdatedAt", Type: "NullTime", ColumnName: "updated_at", Nullable: true},
+		{Name: "DeletedAt", Type: "NullTime", ColumnName: "deleted_at", Nullable: true},
+		{Name: "Version", Type: "int64", ColumnName: "version"},
+	}
+	for letter := 'a'; letter <= 'z'; letter++ {
+		model := tmpl.TmplStruct{
+			Name:      strings.ToUpper(string(letter)) + "Row",
+			TableName: string(letter) + "_row",
+			Fields:    fields,
+			Keys:      ToKeys(fields, []sqltypes.Index{{Name: "name", Columns: []string{"name"}}}),
+			Uniques:   ToUniques(fields, []sqltypes.Index{{Name: "name", Columns: []string{"name"}}}),
+		}
+		m := tmpl.StructTmplData{
+			Model:       model,
+			Receiver:    string(letter),
+			PackageName: "models",
+			SoftDelete:  "deleted_at",
+			Version:     "version",
+			Validate:    true,
+		}
+		for _, name := range []string{"model", "repository"} {
+			buf := new(bytes.Buffer)
+			if err := templates.ExecuteTemplate(buf, name, m); err != nil {
+				t.Fatal(err)
+			}
+			f, err := parser.ParseFile(token.NewFileSet(), model.TableName+".go", buf, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, decl := range f.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || len(fn.Recv.List[0].Names) == 0 {
+					continue
+				}
+				receiver := fn.Recv.List[0].Names[0].Name
+				for _, ident := range declaredIdents(fn) {
+					if ident == receiver {
+						t.Errorf("%s template: %s.%s declares %s, which is its receiver", name, model.Name, fn.Name.Name, ident)
+					}
+				}
+			}
+		}
+	}
+}
+
+// declaredIdents returns the names of the parameters, results and local variables a method declares.
+func declaredIdents(fn *ast.FuncDecl) []string {
+	var idents []string
+	fields := func(list *ast.FieldList) {
+		if list == nil {
+			return
+		}
+		for _, field := range list.List {
+			for _, name := range field.Names {
+				idents = append(idents, name.Name)
+			}
+		}
+	}
+	fields(fn.Type.Params)
+	fields(fn.Type.Results)
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncType:
+			fields(n.Params)
+			fields(n.Results)
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				for _, expr := range n.Lhs {
+					if ident, ok := expr.(*ast.Ident); ok {
+						idents = append(idents, ident.Name)
+					}
+				}
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				for _, expr := range []ast.Expr{n.Key, n.Value} {
+					if ident, ok := expr.(*ast.Ident); ok {
+						idents = append(idents, ident.Name)
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for _, name := range n.Names {
+				idents = append(idents, name.Name)
+			}
+		}
+		return true
+	})
+	return idents
+}
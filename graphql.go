@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+	"github.com/spf13/cobra"
+)
+
+func graphql(cmd *cobra.Command, args []string) {
+	validate()
+	connect()
+
+	tables := getTables()
+	if len(tables) == 0 {
+		log.Fatal("No tables to read")
+	}
+	writeGraphQL(ToStructs(tables), loadGraphQLTemplates())
+}
+
+// loadGraphQLTemplates parses the templates of the GraphQL schema and resolvers.
+func loadGraphQLTemplates() *template.Template {
+	t := template.New("graphql").Funcs(tmpl.FuncMap)
+	for _, name := range []string{"graphql_schema.html", "model_graphql.html", "x_graphql.html"} {
+		src, err := box.MustBytes(name)
+		if err != nil {
+			log.Fatalf("cannot load %s template", name)
+		}
+		template.Must(t.Parse(string(src)))
+	}
+	return t
+}
+
+// writeGraphQL writes the schema.graphql schema of the models, a <table>_resolver.go
+// file resolving the type of every model and the x_graphql.go file they share.
+func writeGraphQL(models []tmpl.TmplStruct, t *template.Template) {
+	sort.Slice(models, func(i, j int) bool {
+		return models[i].TableName < models[j].TableName
+	})
+	types := tmpl.GetGraphQLTypes(models, "r.m")
+
+	schema := new(bytes.Buffer)
+	if err := t.ExecuteTemplate(schema, "graphqlschema", types); err != nil {
+		log.Fatal(err)
+	}
+
+	out := *output
+	os.Mkdir(out, 0777)
+	writeTemplate(t, "graphqlschema", types, filepath.Join(out, "schema.graphql"))
+	writeTemplate(t, "graphql", map[string]string{
+		"PackageName": *pkgName,
+		"Schema":      schema.String(),
+	}, filepath.Join(out, "x_graphql.go"))
+	for _, typ := range types {
+		writeTemplate(t, "modelgraphql", map[string]interface{}{
+			"PackageName": *pkgName,
+			"Type":        typ,
+		}, filepath.Join(out, typ.Model.TableName+"_resolver.go"))
+	}
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+// lookupAnnotation matches the table comment annotation marking a lookup table, such as
+// modelgen:lookup or modelgen:lookup=code to pick the column its constants are named after.
+var lookupAnnotation = regexp.MustCompile(`\bmodelgen:lookup(?:=(\w+))?`)
+
+// lookupCodeColumns lists the columns the constants of a lookup table are named after
+// by default, the first one the table holds being picked.
+var lookupCodeColumns = []string{"code", "slug", "key", "name"}
+
+// lookupColumns returns the code column of every lookup table, by table name. Tables are marked
+// by their comment, by table name, or by config entries such as order_status or order_status=code,
+// which win.
+func lookupColumns(models []tmpl.TmplStruct, comments map[string]string, config []string) (map[string]string, error) {
+	byTable := make(map[string]tmpl.TmplStruct)
+	marked := make(map[string]string)
+	for _, model := range models {
+		byTable[model.TableName] = model
+		if m := lookupAnnotation.FindStringSubmatch(comments[model.TableName]); m != nil {
+			marked[model.TableName] = m[1]
+		}
+	}
+	for _, entry := range config {
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) == 2 {
+			marked[parts[0]] = parts[1]
+		} else {
+			marked[parts[0]] = ""
+		}
+	}
+
+	columns := make(map[string]string)
+	for table, column := range marked {
+		model, ok := byTable[table]
+		if !ok {
+			return nil, fmt.Errorf("unknown lookup table %s", table)
+		}
+		if id, ok := dumpField(model.Fields, "id"); !ok || id.Type != "int64" {
+			return nil, fmt.Errorf("lookup table %s needs a non nullable integer id column", table)
+		}
+		if column == "" {
+			for _, name := range lookupCodeColumns {
+				if tmpl.HasColumn(model.Fields, name) {
+					column = name
+					break
+				}
+			}
+			if column == "" {
+				return nil, fmt.Errorf("lookup table %s has no %s column, pick one with %s=<column>", table, strings.Join(lookupCodeColumns, ", "), table)
+			}
+		}
+		fl, ok := dumpField(model.Fields, column)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %s.%s to name lookup constants after", table, column)
+		}
+		if fl.Type != "string" {
+			return nil, fmt.Errorf("%s.%s: lookup constants can only be named after a non nullable string column", table, column)
+		}
+		columns[table] = column
+	}
+	return columns, nil
+}
+
+// getTableComments reads the comment of every table.
+func getTableComments() map[string]string {
+	const stmt = `SELECT table_name, table_comment
+				  FROM information_schema.tables
+				  WHERE table_schema = ?`
+
+	rows, err := database.Query(stmt, *dbName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer rows.Close()
+	comments := make(map[string]string)
+	for rows.Next() {
+		var name, comment string
+		if err := rows.Scan(&name, &comment); err != nil {
+			log.Fatal(err)
+		}
+		comments[name] = comment
+	}
+	return comments
+}
+
+// getLookups reads the rows of every lookup table, returning their constants by table name.
+func getLookups(models []tmpl.TmplStruct) map[string]tmpl.TmplLookup {
+	columns, err := lookupColumns(models, getTableComments(), *lookups)
+	if err != nil {
+		log.Fatal(err)
+	}
+	lookup := make(map[string]tmpl.TmplLookup)
+	for _, model := range models {
+		column, ok := columns[model.TableName]
+		if !ok {
+			continue
+		}
+		id, _ := dumpField(model.Fields, "id")
+		code, _ := dumpField(model.Fields, column)
+		rows, err := readRows(model.TableName, []tmpl.TmplField{id, code}, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ids, codes := make([]int64, len(rows)), make([]string, len(rows))
+		for i, row := range rows {
+			ids[i], codes[i] = row[0].(int64), row[1].(string)
+		}
+		if lookup[model.TableName], err = tmpl.GetLookup(model, models, column, ids, codes); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := lookupClash(lookup); err != nil {
+		log.Fatal(err)
+	}
+	return lookup
+}
+
+// lookupClash reports the constants of distinct lookup tables sharing a name, such as those of
+// the history_none row of order_status and of the none row of order_status_history.
+func lookupClash(lookup map[string]tmpl.TmplLookup) error {
+	tables := make([]string, 0, len(lookup))
+	for table := range lookup {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	named := make(map[string]string)
+	for _, table := range tables {
+		for _, v := range lookup[table].Values {
+			if other, ok := named[v.Name]; ok {
+				return fmt.Errorf("lookup tables %s and %s both name a constant %s", other, table, v.Name)
+			}
+			named[v.Name] = table
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+var lookupModels = []tmpl.TmplStruct{
+	{
+		Name:      "OrderStatus",
+		TableName: "order_status",
+		Fields: []tmpl.TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id"},
+			{Name: "Code", Type: "string", ColumnName: "code"},
+			{Name: "Label", Type: "string", ColumnName: "label"},
+			{Name: "Note", Type: "NullString", ColumnName: "note"},
+		},
+	},
+	{
+		Name:      "Country",
+		TableName: "country",
+		Fields: []tmpl.TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id"},
+			{Name: "Iso", Type: "string", ColumnName: "iso"},
+		},
+	},
+}
+
+func TestLookupColumns(t *testing.T) {
+	comments := map[string]string{"order_status": "statuses modelgen:lookup", "country": "modelgen:lookup=iso"}
+	columns, err := lookupColumns(lookupModels, comments, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 2 || columns["order_status"] != "code" || columns["country"] != "iso" {
+		t.Errorf("lookupColumns() = %v", columns)
+	}
+
+	columns, err = lookupColumns(lookupModels, nil, []string{"order_status=label"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 1 || columns["order_status"] != "label" {
+		t.Errorf("lookupColumns(order_status=label) = %v", columns)
+	}
+
+	for _, config := range []string{"country", "order_status=note", "order_status=missing", "missing"} {
+		if _, err := lookupColumns(lookupModels, nil, []string{config}); err == nil {
+			t.Errorf("lookupColumns(%s) succeeded, want an error", config)
+		}
+	}
+}
+
+func TestLookupClash(t *testing.T) {
+	lookup := map[string]tmpl.TmplLookup{
+		"order_status":         {Values: []tmpl.TmplLookupValue{{Name: "OrderStatusHistoryNone", ID: 1, Code: "history_none"}}},
+		"order_status_history": {Values: []tmpl.TmplLookupValue{{Name: "OrderStatusHistoryNone", ID: 1, Code: "none"}}},
+	}
+	if err := lookupClash(lookup); err == nil {
+		t.Error("lookupClash() succeeded, want an error")
+	}
+	delete(lookup, "order_status")
+	if err := lookupClash(lookup); err != nil {
+		t.Errorf("lookupClash() = %v", err)
+	}
+}
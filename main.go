@@ -15,13 +15,30 @@ import (
 )
 
 var (
-	output   *string
-	dbName   *string
-	pkgName  *string
-	conn     *string
-	database *sql.DB
-	version  string
-	box      packr.Box
+	output      *string
+	dbName      *string
+	pkgName     *string
+	conn        *string
+	contextOnly *bool
+	softDelete  *string
+	lockVersion *string
+	integration *bool
+	validateAll *bool
+	protoPkg    *string
+	modelsPath  *string
+	httpHandler *bool
+	lang        *string
+	schemaFmt   *string
+	dumpTables  *[]string
+	dumpColumns *[]string
+	dumpFormat  *string
+	dumpLimit   *int
+	anonymize   *bool
+	anonColumns *[]string
+	lookups     *[]string
+	database    *sql.DB
+	version     string
+	box         packr.Box
 )
 
 func init() {
@@ -43,6 +60,16 @@ func main() {
 		Run:   generate,
 		Short: "Generate models from a database connection",
 	}
+	lang = generateCmd.Flags().String("lang", "go", "language to generate the models in, go or typescript")
+	contextOnly = generateCmd.Flags().Bool("context-only", false, "only generate the context aware model methods")
+	softDelete = generateCmd.Flags().String("soft-delete-column", "deleted_at", "nullable time column marking rows as soft deleted, empty to disable")
+	lockVersion = generateCmd.Flags().String("version-column", "version", "integer column used for optimistic locking, empty to disable")
+	validateAll = generateCmd.Flags().Bool("validate", false, "validate models before inserting, updating or upserting them")
+	protoPkg = generateCmd.Flags().String("proto", "", "import path of the package protoc generates from the .proto files, generates them and the converters when set")
+	modelsPath = generateCmd.Flags().String("factories", "", "import path of the models package, generates a factories package next to it when set")
+	lookups = generateCmd.Flags().StringSlice("lookup-tables", nil, "lookup tables to generate a constant per row of, as table or table=column naming the constants")
+	httpHandler = generateCmd.Flags().Bool("http-handlers", false, "generate net/http handlers serving the rows of every table as JSON")
+	integration = generateCmd.Flags().Bool("integration-tests", false, "generate a test per model, run against the database "+testDSNEnv+" points to")
 
 	migrateCmd := &cobra.Command{
 		Use:   "migrate",
@@ -50,6 +77,31 @@ func main() {
 		Short: "Generate migration files from a database connection",
 	}
 
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Run:   schema,
+		Short: "Generate JSON schemas of the models from a database connection",
+	}
+	schemaFmt = schemaCmd.Flags().String("format", "jsonschema", "format of the schemas, jsonschema or openapi")
+
+	graphqlCmd := &cobra.Command{
+		Use:   "graphql",
+		Run:   graphql,
+		Short: "Generate a GraphQL schema and resolvers of the models from a database connection",
+	}
+
+	dumpCmd := &cobra.Command{
+		Use:   "dump",
+		Run:   dump,
+		Short: "Export the rows of tables as seed migrations or fixtures",
+	}
+	dumpTables = dumpCmd.Flags().StringSlice("tables", nil, "tables to dump, comma separated")
+	dumpColumns = dumpCmd.Flags().StringSlice("columns", nil, "columns to dump besides the id, as column or table.column, comma separated, all when empty")
+	dumpFormat = dumpCmd.Flags().String("format", "sql", "format of the dump, sql, go, json or yaml")
+	dumpLimit = dumpCmd.Flags().Int("limit", 0, "maximum number of rows to dump per table, all when zero")
+	anonymize = dumpCmd.Flags().Bool("anonymize", false, "replace the values of the columns annotated with modelgen:anonymize=<kind> by fake ones")
+	anonColumns = dumpCmd.Flags().StringSlice("anonymize-columns", nil, "columns to anonymize besides the annotated ones, as table.column=kind, kind being email, name, phone or text")
+
 	versionCmd := &cobra.Command{
 		Use: "version",
 		Run: func(cmd *cobra.Command, args []string) {
@@ -58,7 +110,7 @@ func main() {
 		Short: "Returns the current version name",
 	}
 
-	rootCmd.AddCommand(generateCmd, migrateCmd, versionCmd)
+	rootCmd.AddCommand(generateCmd, migrateCmd, schemaCmd, graphqlCmd, dumpCmd, versionCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatal(err)
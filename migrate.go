@@ -52,7 +52,7 @@ func makeMigrations(tables map[string]string, dst string) {
 
 	for table, comment := range tables {
 		// get the create statement
-		row := database.QueryRow(fmt.Sprintf("SHOW CREATE TABLE `%s`", table))
+		row := database.QueryRow("SHOW CREATE TABLE " + backtick(table))
 		var tbl, stmt string
 		row.Scan(&tbl, &stmt)
 		order := GetOrderFromComment(comment)
@@ -82,7 +82,7 @@ func makeMigrations(tables map[string]string, dst string) {
 		if err != nil {
 			log.Fatal(err)
 		}
-		_, err = down.WriteString(fmt.Sprintf("DROP TABLE IF EXISTS %s;", st.tbl))
+		_, err = down.WriteString(fmt.Sprintf("DROP TABLE IF EXISTS %s;", backtick(st.tbl)))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+// protoLockFile names the file the protobuf field numbers are kept in, next to the .proto files.
+const protoLockFile = "proto.lock"
+
+// protoLock records the field numbers given to the columns of every table,
+// so regenerating the .proto files never renumbers a field nor reuses a number.
+type protoLock map[string]*protoLockMessage
+
+type protoLockMessage struct {
+	Fields        map[string]protoLockField `json:"fields"`
+	Reserved      []int                     `json:"reserved,omitempty"`
+	ReservedNames []string                  `json:"reserved_names,omitempty"`
+}
+
+type protoLockField struct {
+	Number int    `json:"number"`
+	Type   string `json:"type"`
+}
+
+// loadProtoLock reads the lock file at path, if there is one yet.
+func loadProtoLock(path string) protoLock {
+	lock := make(protoLock)
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return lock
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := json.Unmarshal(b, &lock); err != nil {
+		log.Fatalf("cannot read %s: %v", path, err)
+	}
+	return lock
+}
+
+func (l protoLock) save(path string) {
+	b, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, append(b, '\n'), 0666); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// message numbers the fields of the protobuf message of a model. Columns keep the number
+// they were locked to, while new columns get numbers never used before. The numbers
+// and names of dropped columns are reserved, and so are the numbers of columns whose
+// protobuf type changed, as decoding them with their new type would fail.
+func (l protoLock) message(model tmpl.TmplStruct, pkg string) tmpl.TmplProto {
+	msg, ok := l[model.TableName]
+	if !ok {
+		msg = &protoLockMessage{Fields: make(map[string]protoLockField)}
+		l[model.TableName] = msg
+	}
+
+	last := 0
+	for _, f := range msg.Fields {
+		last = maxInt(last, f.Number)
+	}
+	for _, n := range msg.Reserved {
+		last = maxInt(last, n)
+	}
+
+	present := make(map[string]bool)
+	for _, fl := range model.Fields {
+		present[tmpl.ProtoFieldName(fl.ColumnName)] = true
+	}
+	for name, f := range msg.Fields {
+		if !present[name] {
+			msg.Reserved = append(msg.Reserved, f.Number)
+			msg.ReservedNames = append(msg.ReservedNames, name)
+			delete(msg.Fields, name)
+		}
+	}
+	// a column added back takes a new number, under its old name
+	names := msg.ReservedNames[:0]
+	for _, name := range msg.ReservedNames {
+		if !present[name] {
+			names = append(names, name)
+		}
+	}
+	msg.ReservedNames = names
+
+	proto := tmpl.TmplProto{Package: pkg}
+	for _, fl := range model.Fields {
+		name := tmpl.ProtoFieldName(fl.ColumnName)
+		typ := tmpl.GetProtoType(fl)
+		f, ok := msg.Fields[name]
+		if ok && f.Type != typ {
+			msg.Reserved = append(msg.Reserved, f.Number)
+			ok = false
+		}
+		if !ok {
+			last = nextProtoNumber(last)
+			f = protoLockField{Number: last, Type: typ}
+			msg.Fields[name] = f
+		}
+		proto.Fields = append(proto.Fields, tmpl.TmplProtoField{
+			Field:  fl,
+			Name:   name,
+			GoName: tmpl.ProtoGoName(name),
+			Number: f.Number,
+		})
+	}
+
+	sort.Ints(msg.Reserved)
+	sort.Strings(msg.ReservedNames)
+	proto.Reserved, proto.ReservedNames = msg.Reserved, msg.ReservedNames
+	return proto
+}
+
+// nextProtoNumber returns the field number following n,
+// skipping the range protobuf reserves for itself.
+func nextProtoNumber(n int) int {
+	n++
+	if n >= 19000 && n <= 19999 {
+		return 20000
+	}
+	return n
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
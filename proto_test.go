@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+func TestProtoLock(t *testing.T) {
+	numbers := func(p tmpl.TmplProto) map[string]int {
+		n := make(map[string]int)
+		for _, f := range p.Fields {
+			n[f.Name] = f.Number
+		}
+		return n
+	}
+	model := func(fields ...tmpl.TmplField) tmpl.TmplStruct {
+		return tmpl.TmplStruct{TableName: "user", Fields: fields}
+	}
+	id := tmpl.TmplField{ColumnName: "id", Type: "int64"}
+	email := tmpl.TmplField{ColumnName: "email", Type: "string"}
+	name := tmpl.TmplField{ColumnName: "name", Type: "NullString"}
+	age := tmpl.TmplField{ColumnName: "age", Type: "int64"}
+
+	lock := make(protoLock)
+	p := lock.message(model(id, email, name), "pb")
+	if got, want := numbers(p), map[string]int{"id": 1, "email": 2, "name": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("first numbers = %v, want %v", got, want)
+	}
+
+	// dropping email, adding age and making name a string
+	name.Type = "string"
+	p = lock.message(model(age, id, name), "pb")
+	if got, want := numbers(p), map[string]int{"id": 1, "age": 4, "name": 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("second numbers = %v, want %v", got, want)
+	}
+	if want := []int{2, 3}; !reflect.DeepEqual(p.Reserved, want) {
+		t.Errorf("reserved = %v, want %v", p.Reserved, want)
+	}
+	if want := []string{"email"}; !reflect.DeepEqual(p.ReservedNames, want) {
+		t.Errorf("reserved names = %v, want %v", p.ReservedNames, want)
+	}
+
+	// adding email back
+	p = lock.message(model(id, email, name, age), "pb")
+	if got, want := numbers(p), map[string]int{"id": 1, "email": 6, "age": 4, "name": 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("third numbers = %v, want %v", got, want)
+	}
+	if len(p.ReservedNames) != 0 {
+		t.Errorf("reserved names = %v, want none", p.ReservedNames)
+	}
+}
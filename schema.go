@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+	"github.com/spf13/cobra"
+)
+
+// jsonSchemaDraft identifies the JSON Schema dialect of the generated schemas.
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+func schema(cmd *cobra.Command, args []string) {
+	validate()
+	if *schemaFmt != "jsonschema" && *schemaFmt != "openapi" {
+		log.Fatalf("unknown schema format %q, use jsonschema or openapi", *schemaFmt)
+	}
+	connect()
+
+	tables := getTables()
+	if len(tables) == 0 {
+		log.Fatal("No tables to read")
+	}
+	writeSchemas(ToStructs(tables), *schemaFmt)
+}
+
+// openAPIComponents is an OpenAPI 3 document holding only schema components,
+// to be referenced from, or merged into, the document of an API.
+type openAPIComponents struct {
+	Components struct {
+		Schemas map[string]tmpl.Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// writeSchemas writes a <table>.schema.json JSON Schema, or a <table>.openapi.json
+// OpenAPI components document, for every model.
+func writeSchemas(models []tmpl.TmplStruct, format string) {
+	out := *output
+	os.Mkdir(out, 0777)
+
+	for _, model := range models {
+		var doc interface{}
+		p := filepath.Join(out, model.TableName)
+		switch format {
+		case "openapi":
+			var components openAPIComponents
+			components.Components.Schemas = map[string]tmpl.Schema{
+				model.Name: tmpl.GetModelSchema(model, true),
+			}
+			doc = components
+			p += ".openapi.json"
+		default:
+			s := tmpl.GetModelSchema(model, false)
+			s.Schema = jsonSchemaDraft
+			doc = s
+			p += ".schema.json"
+		}
+
+		buf := new(bytes.Buffer)
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(doc); err != nil {
+			log.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, buf.Bytes(), 0666); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -6,14 +6,23 @@ import (
 	"strings"
 )
 
-// Explain wraps the explain query results for a given table
+// Explain wraps the SHOW FULL COLUMNS query results for a given table
 type Explain struct {
-	Field   *string
-	Type    *string
-	Null    *string
-	Key     *string
-	Default *string
-	Extra   *string
+	Field      *string
+	Type       *string
+	Collation  *string
+	Null       *string
+	Key        *string
+	Default    *string
+	Extra      *string
+	Privileges *string
+	Comment    *string
+}
+
+// Index wraps the columns of a unique index for a given table, in index order
+type Index struct {
+	Name    string
+	Columns []string
 }
 
 // SQLType unwraps a SQL data type
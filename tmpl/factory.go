@@ -0,0 +1,176 @@
+package tmpl
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// GetFactory returns the factory of a model, filling every column with a fake value
+// but for auto incremented columns, the soft delete and version columns, nullable
+// foreign keys, and the foreign keys to other models, whose referenced rows are inserted first.
+func GetFactory(m TmplStruct, models []TmplStruct, importPath, pkg, softDelete, version string) TmplFactory {
+	f := TmplFactory{Import: importPath, Package: pkg}
+	byTable := make(map[string]TmplStruct)
+	for _, model := range models {
+		byTable[model.TableName] = model
+	}
+	parents := make(map[string]bool)
+	for _, key := range m.ForeignKeys {
+		if fl, found := fieldByColumn(m.Fields, key.Column); found && fl.Nullable {
+			// left NULL rather than referencing a row which does not exist
+			parents[fl.ColumnName] = true
+			continue
+		}
+		to, ok := byTable[key.RefTable]
+		fl, found := fieldByColumn(m.Fields, key.Column)
+		if !ok || !found || parents[fl.ColumnName] || to.TableName == m.TableName {
+			continue
+		}
+		if key.RefColumn != "id" || !HasColumn(to.Fields, "id") || fl.Type != "int64" {
+			continue
+		}
+		parents[fl.ColumnName] = true
+		f.Parents = append(f.Parents, TmplFactoryParent{Field: fl, Model: to})
+	}
+	for _, fl := range m.Fields {
+		if fl.AutoInc || parents[fl.ColumnName] || fl.ColumnName == softDelete || fl.ColumnName == version {
+			continue
+		}
+		f.Fields = append(f.Fields, TmplFactoryField{Field: fl, Value: GetFakeValue(pkg, m, fl)})
+	}
+	return f
+}
+
+// GetFakeValue returns an expression of the fake value of a field of a model, made of the fake
+// functions of the generated factories package and of the sequence number n. Strings hold n,
+// so they stay unique as long as they fit their column, and so do integers within their range,
+// which CHECK constraints comparing the column with a number narrow.
+func GetFakeValue(pkg string, m TmplStruct, fl TmplField) string {
+	base, args, unsigned := parseColumnType(fl.ColumnType)
+	var expr string
+	switch strings.TrimPrefix(fl.Type, "Null") {
+	case "int64", "Int64":
+		lo, hi := int64(1), intRanges[base]
+		if base == "year" {
+			lo, hi = 1901, 2155
+		} else if unsigned {
+			hi = hi*2 + 1
+		}
+		if hi == 0 {
+			hi = 127
+		}
+		lo, hi = checkBounds(m.Checks, fl.ColumnName, lo, hi)
+		expr = fmt.Sprintf("fakeInt(n, %d, %d)", lo, hi)
+	case "float64", "Float64":
+		digits, scale := 6, 2
+		if base == "decimal" {
+			if p, s, ok := parsePrecision(args); ok {
+				digits, scale = minInt(p-s, 6), minInt(s, 6)
+			}
+		}
+		expr = fmt.Sprintf("fakeFloat(n, %d, %d)", digits, scale)
+	case "bool", "Bool":
+		expr = "fakeBool(n)"
+	case "string", "String":
+		expr = fakeString(m.TableName, fl.ColumnName, base, args)
+	case "[]byte":
+		if base == "bit" {
+			return "fakeBits(n)"
+		}
+		n, _ := strconv.Atoi(args)
+		return fmt.Sprintf("[]byte(fakeText(%s, n, %d))", QuoteString(fl.ColumnName), n)
+	case "RawJSON":
+		return "fakeJSON(n)"
+	case "time.Time", "Time":
+		if base == "date" {
+			expr = "fakeDate(n)"
+		} else {
+			expr = "fakeTime(n)"
+		}
+	default:
+		return pkg + "." + fl.Type + "{}"
+	}
+	if strings.HasPrefix(fl.Type, "Null") {
+		field := strings.TrimPrefix(fl.Type, "Null")
+		return fmt.Sprintf("%s.%s{%s: %s, Valid: true}", pkg, fl.Type, field, expr)
+	}
+	return expr
+}
+
+// fakeString returns the fake value expression of a string column, guessing
+// the kind of value from the column name when its type tells nothing more.
+func fakeString(table, column, base, args string) string {
+	switch base {
+	case "enum", "set":
+		members := quotedValues(args)
+		for i, member := range members {
+			members[i] = QuoteString(member)
+		}
+		return fmt.Sprintf("fakePick(n, %s)", strings.Join(members, ", "))
+	case "time":
+		return "fakeClock(n)"
+	}
+	max := textSizes[base]
+	if base == "char" || base == "varchar" {
+		max, _ = strconv.Atoi(args)
+	}
+	name := strings.ToLower(column)
+	switch {
+	case base == "char" && max == 36:
+		return "fakeUUID(n)"
+	case strings.Contains(name, "email"):
+		return fmt.Sprintf("fakeEmail(%s, n, %d)", QuoteString(table), max)
+	case strings.Contains(name, "url") || strings.Contains(name, "website"):
+		return fmt.Sprintf("fakeURL(%s, n, %d)", QuoteString(table), max)
+	case strings.Contains(name, "phone"):
+		return fmt.Sprintf("fakePhone(n, %d)", max)
+	default:
+		return fmt.Sprintf("fakeText(%s, n, %d)", QuoteString(column), max)
+	}
+}
+
+// checkBounds narrows the range of an integer column to the numbers its CHECK constraints allow.
+// The range is left as is when the constraints exclude every number of it.
+func checkBounds(checks []TmplCheck, column string, lo, hi int64) (int64, int64) {
+	from, to := lo, hi
+	for _, check := range checks {
+		col, length, op, number, ok := parseCheck(check)
+		if !ok || length || col != column {
+			continue
+		}
+		f, err := strconv.ParseFloat(number, 64)
+		if err != nil || math.Abs(f) > 1<<53 {
+			continue
+		}
+		// the integers past a number which is not one are those past its floor or ceiling
+		floor, ceil := int64(math.Floor(f)), int64(math.Ceil(f))
+		switch op {
+		case ">":
+			if floor+1 > from {
+				from = floor + 1
+			}
+		case ">=":
+			if ceil > from {
+				from = ceil
+			}
+		case "<":
+			if ceil-1 < to {
+				to = ceil - 1
+			}
+		case "<=":
+			if floor < to {
+				to = floor
+			}
+		case "==":
+			if floor == ceil {
+				from, to = floor, floor
+			}
+		}
+	}
+	if from > to {
+		return lo, hi
+	}
+	return from, to
+}
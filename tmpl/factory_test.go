@@ -0,0 +1,81 @@
+package tmpl
+
+import "testing"
+
+func TestGetFakeValue(t *testing.T) {
+	m := TmplStruct{
+		TableName: "user",
+		Checks: []TmplCheck{
+			{Name: "age_min", Clause: "(`age` >= 18)"},
+			{Name: "age_max", Clause: "(`age` < 130)"},
+			{Name: "score", Clause: "(`score` > 200)"},
+			{Name: "rating_min", Clause: "(`rating` > 1.5)"},
+			{Name: "rating_max", Clause: "(`rating` <= 9.9)"},
+		},
+	}
+	tests := []struct {
+		fl   TmplField
+		want string
+	}{
+		{TmplField{Type: "int64", ColumnName: "age", ColumnType: "int(11)"}, "fakeInt(n, 18, 129)"},
+		{TmplField{Type: "int64", ColumnName: "score", ColumnType: "tinyint(4)"}, "fakeInt(n, 1, 127)"},
+		{TmplField{Type: "int64", ColumnName: "rating", ColumnType: "int(11)"}, "fakeInt(n, 2, 9)"},
+		{TmplField{Type: "NullInt64", ColumnName: "rank", ColumnType: "smallint(5) unsigned"}, "models.NullInt64{Int64: fakeInt(n, 1, 65535), Valid: true}"},
+		{TmplField{Type: "float64", ColumnName: "price", ColumnType: "decimal(5,2)"}, "fakeFloat(n, 3, 2)"},
+		{TmplField{Type: "string", ColumnName: "email", ColumnType: "varchar(64)"}, `fakeEmail("user", n, 64)`},
+		{TmplField{Type: "string", ColumnName: "token", ColumnType: "char(36)"}, "fakeUUID(n)"},
+		{TmplField{Type: "NullString", ColumnName: "bio", ColumnType: "text"}, `models.NullString{String: fakeText("bio", n, 65535), Valid: true}`},
+		{TmplField{Type: "string", ColumnName: "status", ColumnType: "enum('on','off')"}, `fakePick(n, "on", "off")`},
+		{TmplField{Type: "[]byte", ColumnName: "flags", ColumnType: "bit(1)"}, "fakeBits(n)"},
+		{TmplField{Type: "time.Time", ColumnName: "born", ColumnType: "date"}, "fakeDate(n)"},
+		{TmplField{Type: "RawJSON", ColumnName: "meta", ColumnType: "json"}, "fakeJSON(n)"},
+	}
+	for _, tt := range tests {
+		if got := GetFakeValue("models", m, tt.fl); got != tt.want {
+			t.Errorf("GetFakeValue(%s %s) = %s, want %s", tt.fl.ColumnName, tt.fl.ColumnType, got, tt.want)
+		}
+	}
+}
+
+func TestGetFactory(t *testing.T) {
+	user := TmplStruct{
+		Name:      "User",
+		TableName: "user",
+		Fields: []TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id", ColumnType: "bigint(20) unsigned", AutoInc: true},
+			{Name: "ManagerID", Type: "int64", ColumnName: "manager_id", ColumnType: "bigint(20) unsigned"},
+		},
+		ForeignKeys: []TmplForeignKey{{Name: "user_manager", Column: "manager_id", RefTable: "user", RefColumn: "id"}},
+	}
+	order := TmplStruct{
+		Name:      "Order",
+		TableName: "order",
+		Fields: []TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id", ColumnType: "bigint(20) unsigned", AutoInc: true},
+			{Name: "UserID", Type: "int64", ColumnName: "user_id", ColumnType: "bigint(20) unsigned"},
+			{Name: "ReviewerID", Type: "NullInt64", ColumnName: "reviewer_id", ColumnType: "bigint(20) unsigned", Nullable: true},
+			{Name: "Note", Type: "string", ColumnName: "note", ColumnType: "varchar(16)"},
+			{Name: "DeletedAt", Type: "NullTime", ColumnName: "deleted_at", ColumnType: "datetime"},
+			{Name: "Version", Type: "int64", ColumnName: "version", ColumnType: "int(11)"},
+		},
+		ForeignKeys: []TmplForeignKey{
+			{Name: "order_user", Column: "user_id", RefTable: "user", RefColumn: "id"},
+			{Name: "order_reviewer", Column: "reviewer_id", RefTable: "user", RefColumn: "id"},
+		},
+	}
+	models := []TmplStruct{user, order}
+
+	f := GetFactory(order, models, "example.com/models", "models", "deleted_at", "version")
+	if len(f.Parents) != 1 || f.Parents[0].Field.Name != "UserID" || f.Parents[0].Model.Name != "User" {
+		t.Errorf("order parents = %+v", f.Parents)
+	}
+	if len(f.Fields) != 1 || f.Fields[0].Field.Name != "Note" {
+		t.Errorf("order fields = %+v", f.Fields)
+	}
+
+	// a row referencing its own table cannot have its parent inserted first
+	f = GetFactory(user, models, "example.com/models", "models", "", "")
+	if len(f.Parents) != 0 || len(f.Fields) != 1 || f.Fields[0].Field.Name != "ManagerID" {
+		t.Errorf("user factory = %+v", f)
+	}
+}
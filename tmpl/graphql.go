@@ -0,0 +1,303 @@
+package tmpl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// TmplGraphQLType defines the GraphQL object type of a model, and the fields of the Query type
+// loading one row of the model by id, Single, or paginating through all of them, Plural.
+type TmplGraphQLType struct {
+	Model  TmplStruct
+	Single string
+	Plural string
+	Fields []TmplGraphQLField
+	Enums  []TmplGraphQLEnum
+	// Refs resolve the foreign keys of the model to the rows they reference.
+	Refs []TmplGraphQLRef
+	// Lists resolve the foreign keys referencing the model to connections of the rows referencing it.
+	Lists []TmplGraphQLRef
+}
+
+// TmplGraphQLField defines the GraphQL field of a column: its name and type in the schema,
+// the Go type its resolver returns and the expression converting the model field to it.
+type TmplGraphQLField struct {
+	Field  TmplField
+	Name   string
+	Type   string
+	GoType string
+	Value  string
+}
+
+// TmplGraphQLEnum defines the GraphQL enum of an enum column.
+type TmplGraphQLEnum struct {
+	Name   string
+	Values []TmplGraphQLEnumValue
+}
+
+// TmplGraphQLEnumValue pairs the name of a GraphQL enum value with the column value it stands for.
+type TmplGraphQLEnumValue struct {
+	Name  string
+	Value string
+}
+
+// TmplGraphQLRef defines a GraphQL field following a foreign key: Column is
+// the foreign key column and Model the model on the other side of it.
+type TmplGraphQLRef struct {
+	Name   string
+	Column TmplField
+	Model  TmplStruct
+}
+
+// GetGraphQLTypes returns the GraphQL types of a set of models, with their resolvers
+// reading fields from the model named value. Only the foreign keys of an integer column
+// referencing the id of another model become fields, and fields whose names clash
+// with those of the columns are left out.
+func GetGraphQLTypes(models []TmplStruct, value string) []TmplGraphQLType {
+	byTable := make(map[string]TmplStruct)
+	for _, m := range models {
+		byTable[m.TableName] = m
+	}
+	type fk struct {
+		key    TmplForeignKey
+		from   TmplStruct
+		column TmplField
+		to     TmplStruct
+	}
+	var fks []fk
+	for _, m := range models {
+		for _, key := range m.ForeignKeys {
+			to, ok := byTable[key.RefTable]
+			col, found := fieldByColumn(m.Fields, key.Column)
+			if !ok || !found || key.RefColumn != "id" || !HasColumn(to.Fields, "id") {
+				continue
+			}
+			if col.Type != "int64" && col.Type != "NullInt64" {
+				continue
+			}
+			fks = append(fks, fk{key, m, col, to})
+		}
+	}
+
+	var types []TmplGraphQLType
+	for _, m := range models {
+		t := TmplGraphQLType{Model: m, Single: graphQLName(m.Name)}
+		t.Plural = pluralName(t.Single)
+		taken := make(map[string]bool)
+		add := func(name string) bool {
+			k := strings.ToLower(strings.Replace(name, "_", "", -1))
+			if taken[k] {
+				return false
+			}
+			taken[k] = true
+			return true
+		}
+
+		for _, fl := range m.Fields {
+			f := graphQLField(fl, value+"."+fl.Name)
+			if fl.ColumnName == "id" {
+				f.Type, f.GoType, f.Value = "ID!", "graphql.ID", "gqlID("+value+"."+fl.Name+")"
+			}
+			for _, k := range fks {
+				if k.from.TableName == m.TableName && k.column.ColumnName == fl.ColumnName {
+					f = graphQLIDField(fl, value+"."+fl.Name)
+				}
+			}
+			if base, args, _ := parseColumnType(fl.ColumnType); base == "enum" && (fl.Type == "string" || fl.Type == "NullString") {
+				if enum, ok := graphQLEnum(m.Name+fl.Name, quotedValues(args)); ok {
+					t.Enums = append(t.Enums, enum)
+					f.Type, f.GoType = enum.Name, "string"
+					f.Value = "gqlEnum(" + value + "." + fl.Name + ")"
+					if fl.Type == "NullString" {
+						f.GoType = "*string"
+						f.Value = "gqlNullEnum(" + value + "." + fl.Name + ")"
+					} else {
+						f.Type += "!"
+					}
+				}
+			}
+			f.Name = graphQLName(fl.ColumnName)
+			add(f.Name)
+			t.Fields = append(t.Fields, f)
+		}
+
+		for _, k := range fks {
+			if k.from.TableName == m.TableName {
+				name := graphQLName(strings.TrimSuffix(k.column.ColumnName, "_id"))
+				if name == graphQLName(k.column.ColumnName) {
+					name += "Ref"
+				}
+				if add(name) {
+					t.Refs = append(t.Refs, TmplGraphQLRef{Name: name, Column: k.column, Model: k.to})
+				}
+			}
+		}
+		for _, k := range fks {
+			if k.to.TableName != m.TableName {
+				continue
+			}
+			name := pluralName(graphQLName(k.from.Name))
+			for _, other := range fks {
+				if other.to.TableName == m.TableName && other.from.TableName == k.from.TableName && other.column.ColumnName != k.column.ColumnName {
+					name += "By" + k.column.Name
+					break
+				}
+			}
+			if add(name) {
+				t.Lists = append(t.Lists, TmplGraphQLRef{Name: name, Column: k.column, Model: k.from})
+			}
+		}
+		types = append(types, t)
+	}
+	return types
+}
+
+func fieldByColumn(fields []TmplField, column string) (TmplField, bool) {
+	for _, fl := range fields {
+		if fl.ColumnName == column {
+			return fl, true
+		}
+	}
+	return TmplField{}, false
+}
+
+// graphQLField returns the GraphQL type of a field, and how its resolver converts it.
+func graphQLField(fl TmplField, value string) TmplGraphQLField {
+	f := TmplGraphQLField{Field: fl, Value: value}
+	base, _, unsigned := parseColumnType(fl.ColumnType)
+	int32s := base == "tinyint" || base == "smallint" || base == "mediumint" || (base == "int" && !unsigned)
+	switch fl.Type {
+	case "int64":
+		if int32s {
+			f.Type, f.GoType, f.Value = "Int!", "int32", "int32("+value+")"
+		} else {
+			f.Type, f.GoType, f.Value = "Int64!", "Int64", "Int64("+value+")"
+		}
+	case "NullInt64":
+		if int32s {
+			f.Type, f.GoType, f.Value = "Int", "*int32", "gqlNullInt32("+value+")"
+		} else {
+			f.Type, f.GoType, f.Value = "Int64", "*Int64", "gqlNullInt64("+value+")"
+		}
+	case "float64":
+		f.Type, f.GoType = "Float!", "float64"
+	case "NullFloat64":
+		f.Type, f.GoType, f.Value = "Float", "*float64", "gqlNullFloat64("+value+")"
+	case "bool":
+		f.Type, f.GoType = "Boolean!", "bool"
+	case "NullBool":
+		f.Type, f.GoType, f.Value = "Boolean", "*bool", "gqlNullBool("+value+")"
+	case "time.Time":
+		f.Type, f.GoType, f.Value = "Time!", "graphql.Time", "gqlTime("+value+")"
+	case "NullTime":
+		f.Type, f.GoType, f.Value = "Time", "*graphql.Time", "gqlNullTime("+value+")"
+	case "[]byte":
+		// base64 encoded, or null when nil
+		f.Type, f.GoType, f.Value = "String", "*string", "gqlBytes("+value+")"
+	case "RawJSON":
+		f.Type, f.GoType, f.Value = "JSON", "*RawJSON", "gqlJSON("+value+")"
+	case "NullString":
+		f.Type, f.GoType, f.Value = "String", "*string", "gqlNullString("+value+")"
+	default:
+		f.Type, f.GoType = "String!", "string"
+	}
+	return f
+}
+
+// graphQLIDField returns the GraphQL field of a foreign key column, an ID as the id it references.
+func graphQLIDField(fl TmplField, value string) TmplGraphQLField {
+	if fl.Type == "NullInt64" {
+		return TmplGraphQLField{Field: fl, Type: "ID", GoType: "*graphql.ID", Value: "gqlNullID(" + value + ")"}
+	}
+	return TmplGraphQLField{Field: fl, Type: "ID!", GoType: "graphql.ID", Value: "gqlID(" + value + ")"}
+}
+
+// graphQLEnum returns the GraphQL enum of the members of an enum column, with values
+// named after the members in upper case. It reports false if a member has no such name.
+func graphQLEnum(name string, members []string) (TmplGraphQLEnum, bool) {
+	enum := TmplGraphQLEnum{Name: name}
+	seen := make(map[string]bool)
+	for _, member := range members {
+		value := strings.ToUpper(member)
+		if !validGraphQLName(value) || seen[value] || value == "TRUE" || value == "FALSE" || value == "NULL" {
+			return TmplGraphQLEnum{}, false
+		}
+		seen[value] = true
+		enum.Values = append(enum.Values, TmplGraphQLEnumValue{Name: value, Value: member})
+	}
+	return enum, len(enum.Values) > 0
+}
+
+func validGraphQLName(name string) bool {
+	if name == "" || isDigit(name[0]) {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !isLower(c) && !isDigit(c) && c != '_' && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// graphQLName returns the lower camel case GraphQL name of a column or model name,
+// replacing the characters a GraphQL name cannot hold.
+func graphQLName(s string) string {
+	var b []byte
+	upper := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' || c == ' ' || c == '-':
+			upper = len(b) > 0
+			continue
+		case !isLower(c) && !isDigit(c) && (c < 'A' || c > 'Z'):
+			c = '_'
+		case len(b) == 0:
+			c = strings.ToLower(string(c))[0]
+		case upper && isLower(c):
+			c ^= ' '
+		}
+		upper = false
+		b = append(b, c)
+	}
+	if len(b) == 0 || isDigit(b[0]) {
+		b = append([]byte{'_'}, b...)
+	}
+	return string(b)
+}
+
+// pluralName names the fields holding connections of rows, which
+// get a List suffix when the name looks like a plural already.
+func pluralName(name string) string {
+	switch {
+	case strings.HasSuffix(name, "s"):
+		return name + "List"
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsRune("aeiou", rune(name[len(name)-2])):
+		return name[:len(name)-1] + "ies"
+	default:
+		return name + "s"
+	}
+}
+
+// GetGraphQLSingle returns the name of the Query field loading a row of a model by id.
+func GetGraphQLSingle(m TmplStruct) string {
+	return graphQLName(m.Name)
+}
+
+// GraphQLString quotes a GraphQL description, using only escapes
+// which are valid within Go raw strings as well.
+func GraphQLString(s string) string {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(CommentText(s))
+	return strings.Replace(strings.TrimSpace(buf.String()), "`", `\u0060`, -1)
+}
+
+// GraphQLFieldMethod returns the name of the Go method resolving a GraphQL field.
+func GraphQLFieldMethod(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
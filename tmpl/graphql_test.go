@@ -0,0 +1,87 @@
+package tmpl
+
+import "testing"
+
+func TestGraphQLName(t *testing.T) {
+	tests := []struct {
+		in, name, plural string
+	}{
+		{"created_at", "createdAt", "createdAts"},
+		{"User", "user", "users"},
+		{"OrderItem", "orderItem", "orderItems"},
+		{"Category", "category", "categories"},
+		{"Day", "day", "days"},
+		{"Address", "address", "addressList"},
+		{"2fa", "_2fa", "_2fas"},
+		{"col.name", "col_name", "col_names"},
+	}
+	for _, tt := range tests {
+		name := graphQLName(tt.in)
+		if name != tt.name {
+			t.Errorf("graphQLName(%q) = %q, want %q", tt.in, name, tt.name)
+		}
+		if plural := pluralName(name); plural != tt.plural {
+			t.Errorf("pluralName(%q) = %q, want %q", name, plural, tt.plural)
+		}
+	}
+}
+
+func TestGetGraphQLTypes(t *testing.T) {
+	user := TmplStruct{
+		Name:      "User",
+		TableName: "user",
+		Fields: []TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id", ColumnType: "bigint(20) unsigned"},
+			{Name: "Status", Type: "NullString", ColumnName: "status", ColumnType: "enum('active','banned')"},
+			{Name: "Mood", Type: "string", ColumnName: "mood", ColumnType: "enum('ok','not ok')"},
+			{Name: "Age", Type: "int64", ColumnName: "age", ColumnType: "int(11)"},
+		},
+	}
+	order := TmplStruct{
+		Name:      "Order",
+		TableName: "order",
+		Fields: []TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id", ColumnType: "bigint(20) unsigned"},
+			{Name: "UserID", Type: "int64", ColumnName: "user_id", ColumnType: "bigint(20) unsigned"},
+			{Name: "ReviewerID", Type: "NullInt64", ColumnName: "reviewer_id", ColumnType: "bigint(20) unsigned"},
+			{Name: "Code", Type: "string", ColumnName: "code", ColumnType: "varchar(8)"},
+		},
+		ForeignKeys: []TmplForeignKey{
+			{Name: "order_user", Column: "user_id", RefTable: "user", RefColumn: "id"},
+			{Name: "order_reviewer", Column: "reviewer_id", RefTable: "user", RefColumn: "id"},
+			{Name: "order_code", Column: "code", RefTable: "user", RefColumn: "id"},
+		},
+	}
+	types := GetGraphQLTypes([]TmplStruct{user, order}, "r.m")
+	if len(types) != 2 {
+		t.Fatalf("got %d types, want 2", len(types))
+	}
+
+	u := types[0]
+	if u.Single != "user" || u.Plural != "users" {
+		t.Errorf("user query fields = %q, %q", u.Single, u.Plural)
+	}
+	want := map[string]string{"id": "ID!", "status": "UserStatus", "mood": "String!", "age": "Int!"}
+	for _, f := range u.Fields {
+		if f.Type != want[f.Name] {
+			t.Errorf("user.%s: type %q, want %q", f.Name, f.Type, want[f.Name])
+		}
+	}
+	if len(u.Enums) != 1 || u.Enums[0].Values[1].Name != "BANNED" || u.Enums[0].Values[1].Value != "banned" {
+		t.Errorf("user enums = %+v", u.Enums)
+	}
+	if len(u.Lists) != 2 || u.Lists[0].Name != "ordersByUserID" || u.Lists[1].Name != "ordersByReviewerID" {
+		t.Errorf("user lists = %+v", u.Lists)
+	}
+
+	o := types[1]
+	want = map[string]string{"id": "ID!", "userId": "ID!", "reviewerId": "ID", "code": "String!"}
+	for _, f := range o.Fields {
+		if f.Type != want[f.Name] {
+			t.Errorf("order.%s: type %q, want %q", f.Name, f.Type, want[f.Name])
+		}
+	}
+	if len(o.Refs) != 2 || o.Refs[0].Name != "user" || o.Refs[1].Name != "reviewer" || o.Refs[1].Model.Name != "User" {
+		t.Errorf("order refs = %+v", o.Refs)
+	}
+}
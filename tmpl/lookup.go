@@ -0,0 +1,59 @@
+package tmpl
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/LUSHDigital/modelgen/sqlfmt"
+)
+
+// lookupSuffixes lists the suffixes of the identifiers generated for every model,
+// which the constants of lookup tables cannot be named as.
+var lookupSuffixes = []string{"", "ID", "ByCode", "Columns", "Connection", "ConnectionResolver", "Cursor",
+	"Edge", "EdgeResolver", "Fixtures", "Handler", "Option", "Page", "Query", "Repository", "Resolver"}
+
+// GetLookup returns the constants of the rows of a lookup table, given the id and the code of
+// every row, read from column. Constants are named after the model and the code, such as
+// OrderStatusShipped for a shipped code, which needs to make up a distinct Go identifier,
+// clashing with none of the identifiers generated for the models of the package.
+func GetLookup(m TmplStruct, models []TmplStruct, column string, ids []int64, codes []string) (TmplLookup, error) {
+	l := TmplLookup{Type: m.Name + "ID", Column: column}
+	reserved := make(map[string]bool)
+	for _, suffix := range lookupSuffixes {
+		reserved[m.Name+suffix] = true
+		for _, model := range models {
+			reserved[model.Name+suffix] = true
+		}
+	}
+	named := make(map[string]string)
+	for i, id := range ids {
+		suffix := LookupName(codes[i])
+		if suffix == "" {
+			return TmplLookup{}, fmt.Errorf("%s row %d: code %q holds no letters or digits to name a constant after", m.TableName, id, codes[i])
+		}
+		name := m.Name + suffix
+		if reserved[name] {
+			return TmplLookup{}, fmt.Errorf("%s row %d: code %q names the constant %s, which is taken", m.TableName, id, codes[i], name)
+		}
+		if code, ok := named[name]; ok {
+			return TmplLookup{}, fmt.Errorf("%s row %d: codes %q and %q both name the constant %s", m.TableName, id, code, codes[i], name)
+		}
+		named[name] = codes[i]
+		l.Values = append(l.Values, TmplLookupValue{Name: name, ID: id, Code: codes[i]})
+	}
+	return l, nil
+}
+
+// LookupName turns the code of a row of a lookup table into the PascalCase identifier
+// its constant is suffixed with, dropping anything but letters and digits:
+// "in-progress" and "IN_PROGRESS" both become InProgress.
+func LookupName(code string) string {
+	words := strings.FieldsFunc(strings.ToLower(code), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		words[i] = sqlfmt.ShouldCap(w)
+	}
+	return sqlfmt.ToPascalCase(strings.Join(words, "_"))
+}
@@ -0,0 +1,41 @@
+package tmpl
+
+import "testing"
+
+func TestLookupName(t *testing.T) {
+	cases := map[string]string{
+		"shipped":     "Shipped",
+		"in-progress": "InProgress",
+		"IN_PROGRESS": "InProgress",
+		"on hold":     "OnHold",
+		"api":         "API",
+		"2fa":         "2fa",
+		"--":          "",
+	}
+	for code, want := range cases {
+		if got := LookupName(code); got != want {
+			t.Errorf("LookupName(%q) = %s, want %s", code, got, want)
+		}
+	}
+}
+
+func TestGetLookup(t *testing.T) {
+	m := TmplStruct{Name: "OrderStatus", TableName: "order_status"}
+	models := []TmplStruct{m, {Name: "OrderStatusHistory", TableName: "order_status_history"}}
+	l, err := GetLookup(m, models, "code", []int64{1, 3}, []string{"pending", "shipped"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Type != "OrderStatusID" || l.Column != "code" || len(l.Values) != 2 {
+		t.Fatalf("GetLookup() = %+v", l)
+	}
+	if v := l.Values[1]; v.Name != "OrderStatusShipped" || v.ID != 3 || v.Code != "shipped" {
+		t.Errorf("GetLookup() value = %+v", v)
+	}
+
+	for _, codes := range [][]string{{"pending", "PENDING"}, {"pending", "query"}, {"pending", "id"}, {"pending", "!"}, {"pending", "history"}, {"pending", "history_query"}} {
+		if _, err := GetLookup(m, models, "code", []int64{1, 2}, codes); err == nil {
+			t.Errorf("GetLookup(%q) succeeded, want an error", codes)
+		}
+	}
+}
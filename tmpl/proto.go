@@ -0,0 +1,180 @@
+package tmpl
+
+import (
+	"path"
+	"strings"
+)
+
+// ProtoFieldName returns the name of the protobuf field of a column,
+// replacing the characters a proto identifier cannot hold with underscores.
+func ProtoFieldName(column string) string {
+	name := []byte(strings.ToLower(column))
+	for i, c := range name {
+		if !isLower(c) && !isDigit(c) && c != '_' {
+			name[i] = '_'
+		}
+	}
+	if len(name) == 0 || isDigit(name[0]) {
+		name = append([]byte{'_'}, name...)
+	}
+	return string(name)
+}
+
+// ProtoGoName returns the name protoc-gen-go gives the Go field of a protobuf field:
+// underscores followed by a lower case letter are dropped and the letter upper cased.
+func ProtoGoName(name string) string {
+	var b []byte
+	i := 0
+	if strings.HasPrefix(name, "_") {
+		b = append(b, 'X')
+		i++
+	}
+	for ; i < len(name); i++ {
+		c := name[i]
+		if c == '_' && i+1 < len(name) && isLower(name[i+1]) {
+			continue
+		}
+		if isDigit(c) {
+			b = append(b, c)
+			continue
+		}
+		if isLower(c) {
+			c ^= ' '
+		}
+		b = append(b, c)
+		for i+1 < len(name) && isLower(name[i+1]) {
+			i++
+			b = append(b, name[i])
+		}
+	}
+	return string(b)
+}
+
+func isLower(c byte) bool { return 'a' <= c && c <= 'z' }
+func isDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+// GetProtoPackage returns the proto package of the messages generated into a Go package,
+// named after the last element of its import path.
+func GetProtoPackage(importPath string) string {
+	return ProtoFieldName(path.Base(importPath))
+}
+
+// protoNullable reports whether a field holds NULL values, which are
+// encoded as well known wrapper messages rather than scalars.
+func protoNullable(fl TmplField) bool {
+	switch fl.Type {
+	case "[]byte", "RawJSON":
+		return fl.Nullable
+	default:
+		return strings.HasPrefix(fl.Type, "Null")
+	}
+}
+
+// GetProtoType returns the protobuf type of a field.
+func GetProtoType(fl TmplField) string {
+	switch fl.Type {
+	case "int64":
+		return "int64"
+	case "NullInt64":
+		return "google.protobuf.Int64Value"
+	case "float64":
+		return "double"
+	case "NullFloat64":
+		return "google.protobuf.DoubleValue"
+	case "bool":
+		return "bool"
+	case "NullBool":
+		return "google.protobuf.BoolValue"
+	case "time.Time", "NullTime":
+		return "google.protobuf.Timestamp"
+	case "[]byte":
+		if protoNullable(fl) {
+			return "google.protobuf.BytesValue"
+		}
+		return "bytes"
+	default:
+		// strings, including RawJSON documents
+		if protoNullable(fl) {
+			return "google.protobuf.StringValue"
+		}
+		return "string"
+	}
+}
+
+// GetProtoImports returns the well known types the protobuf message of a model imports.
+func GetProtoImports(p TmplProto) []string {
+	var timestamp, wrappers bool
+	for _, f := range p.Fields {
+		switch typ := GetProtoType(f.Field); {
+		case typ == "google.protobuf.Timestamp":
+			timestamp = true
+		case strings.HasPrefix(typ, "google.protobuf."):
+			wrappers = true
+		}
+	}
+	var imports []string
+	if timestamp {
+		imports = append(imports, "google/protobuf/timestamp.proto")
+	}
+	if wrappers {
+		imports = append(imports, "google/protobuf/wrappers.proto")
+	}
+	return imports
+}
+
+// GetToProto returns the expression converting the value of a field to its protobuf type,
+// made of the converters of the generated x_proto.go file.
+func GetToProto(value string, fl TmplField) string {
+	switch fl.Type {
+	case "NullInt64":
+		return "toInt64Value(" + value + ")"
+	case "NullFloat64":
+		return "toDoubleValue(" + value + ")"
+	case "NullBool":
+		return "toBoolValue(" + value + ")"
+	case "NullString":
+		return "toStringValue(" + value + ")"
+	case "time.Time":
+		return "toTimestamp(" + value + ")"
+	case "NullTime":
+		return "toNullTimestamp(" + value + ")"
+	case "[]byte":
+		if protoNullable(fl) {
+			return "toBytesValue(" + value + ")"
+		}
+	case "RawJSON":
+		if protoNullable(fl) {
+			return "toJSONValue(" + value + ")"
+		}
+		return "string(" + value + ")"
+	}
+	return value
+}
+
+// GetFromProto returns the expression converting a protobuf value to the type of a field.
+func GetFromProto(value string, fl TmplField) string {
+	switch fl.Type {
+	case "NullInt64":
+		return "fromInt64Value(" + value + ")"
+	case "NullFloat64":
+		return "fromDoubleValue(" + value + ")"
+	case "NullBool":
+		return "fromBoolValue(" + value + ")"
+	case "NullString":
+		return "fromStringValue(" + value + ")"
+	case "time.Time":
+		return "fromTimestamp(" + value + ")"
+	case "NullTime":
+		return "fromNullTimestamp(" + value + ")"
+	case "[]byte":
+		if protoNullable(fl) {
+			return "fromBytesValue(" + value + ")"
+		}
+	case "RawJSON":
+		if protoNullable(fl) {
+			return "fromJSONValue(" + value + ")"
+		}
+		return "RawJSON(" + value + ")"
+	}
+	return value
+}
@@ -0,0 +1,24 @@
+package tmpl
+
+import "testing"
+
+func TestProtoGoName(t *testing.T) {
+	tests := []struct {
+		column, name, goName string
+	}{
+		{"id", "id", "Id"},
+		{"created_at", "created_at", "CreatedAt"},
+		{"address_line_2", "address_line_2", "AddressLine_2"},
+		{"Order-Total", "order_total", "OrderTotal"},
+		{"2fa", "_2fa", "X2Fa"},
+	}
+	for _, tt := range tests {
+		name := ProtoFieldName(tt.column)
+		if name != tt.name {
+			t.Errorf("ProtoFieldName(%q) = %q, want %q", tt.column, name, tt.name)
+		}
+		if goName := ProtoGoName(name); goName != tt.goName {
+			t.Errorf("ProtoGoName(%q) = %q, want %q", name, goName, tt.goName)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package tmpl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Schema is a JSON Schema, or an OpenAPI 3 schema object, describing
+// the JSON encoding of a generated model or one of its fields.
+type Schema struct {
+	Schema               string        `json:"$schema,omitempty"`
+	Title                string        `json:"title,omitempty"`
+	Description          string        `json:"description,omitempty"`
+	Type                 interface{}   `json:"type,omitempty"`
+	Format               string        `json:"format,omitempty"`
+	ContentEncoding      string        `json:"contentEncoding,omitempty"`
+	Nullable             bool          `json:"nullable,omitempty"`
+	Enum                 []interface{} `json:"enum,omitempty"`
+	MaxLength            *int          `json:"maxLength,omitempty"`
+	Minimum              *int64        `json:"minimum,omitempty"`
+	Maximum              *int64        `json:"maximum,omitempty"`
+	Properties           Properties    `json:"properties,omitempty"`
+	Required             []string      `json:"required,omitempty"`
+	AdditionalProperties *bool         `json:"additionalProperties,omitempty"`
+}
+
+// Property is a named property of an object schema.
+type Property struct {
+	Name   string
+	Schema Schema
+}
+
+// Properties holds the properties of an object schema,
+// encoded in the order of the columns rather than alphabetically.
+type Properties []Property
+
+// MarshalJSON encodes the properties as a JSON object.
+func (p Properties) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteByte('{')
+	for i, prop := range p {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		enc := json.NewEncoder(buf)
+		// comments often hold <, > and &, which need no escaping outside of HTML
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(prop.Name); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := enc.Encode(prop.Schema); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// GetModelSchema returns the schema of the JSON encoding of a model, an object holding
+// a property per field named after its JSON tag. Every property is required, as
+// the generated structs always encode every field. The openapi flag switches
+// to the OpenAPI 3.0 dialect, which marks nullable values with nullable
+// rather than with a null type.
+func GetModelSchema(m TmplStruct, openapi bool) Schema {
+	closed := false
+	s := Schema{
+		Title:                m.Name,
+		Type:                 "object",
+		AdditionalProperties: &closed,
+	}
+	for _, fl := range m.Fields {
+		s.Properties = append(s.Properties, Property{Name: fl.ColumnName, Schema: GetFieldSchema(fl, openapi)})
+		s.Required = append(s.Required, fl.ColumnName)
+	}
+	return s
+}
+
+// GetFieldSchema returns the schema of the JSON encoding of a field.
+func GetFieldSchema(fl TmplField, openapi bool) Schema {
+	base, args, unsigned := parseColumnType(fl.ColumnType)
+	s := Schema{Description: CommentText(fl.Comment)}
+
+	var typ string
+	switch strings.TrimPrefix(fl.Type, "Null") {
+	case "RawJSON":
+		// any JSON value, including null
+		return s
+	case "[]byte":
+		typ = "string"
+		if openapi {
+			s.Format = "byte"
+		} else {
+			s.ContentEncoding = "base64"
+		}
+	case "string", "String":
+		typ = "string"
+		switch base {
+		case "enum":
+			for _, member := range quotedValues(args) {
+				s.Enum = append(s.Enum, member)
+			}
+		case "char", "varchar":
+			if n, err := strconv.Atoi(args); err == nil {
+				s.MaxLength = &n
+				if base == "char" && n == 36 {
+					s.Format = "uuid"
+				}
+			}
+		}
+	case "int64", "Int64":
+		typ = "integer"
+		if openapi {
+			s.Format = "int64"
+		}
+		if hi, ok := intRanges[base]; ok && base != "bigint" {
+			lo := -hi - 1
+			if unsigned {
+				lo, hi = 0, hi*2+1
+			}
+			s.Minimum, s.Maximum = &lo, &hi
+		} else if unsigned {
+			lo := int64(0)
+			s.Minimum = &lo
+		}
+	case "float64", "Float64":
+		typ = "number"
+		if openapi {
+			s.Format = "double"
+		}
+	case "bool", "Bool":
+		typ = "boolean"
+	case "time.Time", "Time":
+		typ = "string"
+		s.Format = "date-time"
+	default:
+		typ = "string"
+	}
+
+	// NullX types and nil byte slices encode as null
+	if !strings.HasPrefix(fl.Type, "Null") && fl.Type != "[]byte" {
+		s.Type = typ
+		return s
+	}
+	if s.Enum != nil {
+		s.Enum = append(s.Enum, nil)
+	}
+	if openapi {
+		s.Type, s.Nullable = typ, true
+	} else {
+		s.Type = []string{typ, "null"}
+	}
+	return s
+}
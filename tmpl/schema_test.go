@@ -0,0 +1,47 @@
+package tmpl
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetModelSchema(t *testing.T) {
+	m := TmplStruct{
+		Name: "User",
+		Fields: []TmplField{
+			{Name: "ID", Type: "int64", ColumnName: "id", ColumnType: "int(10) unsigned"},
+			{Name: "UUID", Type: "string", ColumnName: "uuid", ColumnType: "char(36)"},
+			{Name: "Status", Type: "NullString", ColumnName: "status", ColumnType: "enum('on','off')", Comment: "current\nstatus"},
+			{Name: "Avatar", Type: "[]byte", ColumnName: "avatar", ColumnType: "blob"},
+			{Name: "CreatedAt", Type: "time.Time", ColumnName: "created_at", ColumnType: "datetime"},
+		},
+	}
+	tests := []struct {
+		openapi bool
+		want    string
+	}{
+		{false, `{"title":"User","type":"object","properties":{` +
+			`"id":{"type":"integer","minimum":0,"maximum":4294967295},` +
+			`"uuid":{"type":"string","format":"uuid","maxLength":36},` +
+			`"status":{"description":"current status","type":["string","null"],"enum":["on","off",null]},` +
+			`"avatar":{"type":["string","null"],"contentEncoding":"base64"},` +
+			`"created_at":{"type":"string","format":"date-time"}},` +
+			`"required":["id","uuid","status","avatar","created_at"],"additionalProperties":false}`},
+		{true, `{"title":"User","type":"object","properties":{` +
+			`"id":{"type":"integer","format":"int64","minimum":0,"maximum":4294967295},` +
+			`"uuid":{"type":"string","format":"uuid","maxLength":36},` +
+			`"status":{"description":"current status","type":"string","nullable":true,"enum":["on","off",null]},` +
+			`"avatar":{"type":"string","format":"byte","nullable":true},` +
+			`"created_at":{"type":"string","format":"date-time"}},` +
+			`"required":["id","uuid","status","avatar","created_at"],"additionalProperties":false}`},
+	}
+	for _, tt := range tests {
+		b, err := json.Marshal(GetModelSchema(m, tt.openapi))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.want {
+			t.Errorf("GetModelSchema(openapi=%v)\ngot:  %s\nwant: %s", tt.openapi, b, tt.want)
+		}
+	}
+}
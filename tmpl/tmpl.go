@@ -2,21 +2,571 @@ package tmpl
 
 import (
 	"fmt"
-	"html/template"
+	"regexp"
+	"strconv"
 	"strings"
+	"text/template"
 )
 
 var FuncMap = template.FuncMap{
 	"insert_fields":       GetInsertFields,
 	"insert_values":       GetInsertValues,
 	"insert_args":         GetInsertArgs,
+	"insert_arg_list":     GetInsertArgList,
 	"scan_fields":         GetScanFields,
+	"select_fields":       GetSelectFields,
 	"update_args":         GetUpdateArgs,
 	"update_values":       GetUpdateValues,
 	"upsert_fields":       GetUpsertFields,
 	"upsert_values":       GetUpsertValues,
 	"upsert_on_duplicate": GetUpsertOnDuplicate,
 	"upsert_args":         GetUpsertArgs,
+	"with_receiver":       WithReceiver,
+	"sql_ident":           QuoteIdent,
+	"go_string":           QuoteString,
+	"go_comment":          CommentText,
+	"field_comment":       GetFieldComment,
+	"column_type":         GetColumnType,
+	"has_column":          HasColumn,
+	"and_not_deleted":     GetAndNotDeleted,
+	"and_version":         GetAndVersion,
+	"field_name":          GetFieldName,
+	"update_fields":       GetUpdateFields,
+	"sample_value":        GetSampleValue,
+	"null_value":          GetNullValue,
+	"unique_match":        GetUniqueMatch,
+	"validation_rules":    GetValidationRules,
+	"database_checks":     GetDatabaseChecks,
+	"proto_package":       GetProtoPackage,
+	"proto_type":          GetProtoType,
+	"proto_imports":       GetProtoImports,
+	"to_proto":            GetToProto,
+	"from_proto":          GetFromProto,
+	"graphql_string":      GraphQLString,
+	"graphql_method":      GraphQLFieldMethod,
+	"graphql_single":      GetGraphQLSingle,
+	"ts_type":             GetTypeScriptType,
+	"ts_property":         TypeScriptProperty,
+	"ts_comment":          GetTypeScriptComment,
+}
+
+// WithReceiver returns the template data with the fields referenced through another
+// variable than the receiver, such as the rows of a batch looped over within a method.
+func WithReceiver(m StructTmplData, receiver string) StructTmplData {
+	m.Receiver = receiver
+	return m
+}
+
+// QuoteIdent quotes a MySQL identifier with backticks,
+// escaping any backtick contained in the name itself.
+func QuoteIdent(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+// QuoteString returns s as a double quoted Go string literal,
+// safe to embed anywhere an expression is expected in generated code.
+func QuoteString(s string) string {
+	return strconv.Quote(s)
+}
+
+// CommentText flattens s onto a single line so it can follow
+// a // comment marker in generated code without breaking out of it.
+func CommentText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// GetFieldComment returns a trailing line comment documenting the column
+// comment and default value of a field, or nothing if it has neither.
+func GetFieldComment(fl TmplField) string {
+	var parts []string
+	if fl.Comment != "" {
+		parts = append(parts, CommentText(fl.Comment))
+	}
+	if fl.HasDefault {
+		parts = append(parts, "default: "+QuoteString(fl.Default))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "// " + strings.Join(parts, " ")
+}
+
+// GetColumnType returns the query column descriptor type matching a field type.
+func GetColumnType(typ string) string {
+	switch typ {
+	case "int64", "NullInt64":
+		return "Int64Column"
+	case "float64", "NullFloat64":
+		return "Float64Column"
+	case "string", "NullString":
+		return "StringColumn"
+	case "bool", "NullBool":
+		return "BoolColumn"
+	case "time.Time", "NullTime":
+		return "TimeColumn"
+	case "[]byte":
+		return "BytesColumn"
+	case "RawJSON":
+		return "JSONColumn"
+	default:
+		return "Column"
+	}
+}
+
+// HasColumn reports whether one of the fields maps to the named column.
+func HasColumn(fields []TmplField, name string) bool {
+	for _, fl := range fields {
+		if fl.ColumnName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAndNotDeleted returns the condition excluding soft deleted rows,
+// to append to a WHERE clause, or nothing if the model has no soft delete column.
+func GetAndNotDeleted(m StructTmplData) string {
+	if m.SoftDelete == "" {
+		return ""
+	}
+	return " AND " + QuoteIdent(m.SoftDelete) + " IS NULL"
+}
+
+// GetAndVersion returns the condition matching the version the row was read at,
+// to append to a WHERE clause, or nothing if the model has no version column.
+func GetAndVersion(m StructTmplData) string {
+	if m.Version == "" {
+		return ""
+	}
+	return " AND " + QuoteIdent(m.Version) + " = ?"
+}
+
+// GetFieldName returns the name of the field mapping to the named column.
+func GetFieldName(fields []TmplField, column string) string {
+	for _, fl := range fields {
+		if fl.ColumnName == column {
+			return fl.Name
+		}
+	}
+	return ""
+}
+
+// GetSampleValue returns an expression generating a random value fitting the column of a field
+// of a model, and the CHECK constraints comparing it with a number, made of the sample functions
+// of the generated integration tests.
+func GetSampleValue(m TmplStruct, fl TmplField) string {
+	base, args, unsigned := parseColumnType(fl.ColumnType)
+	var expr string
+	switch strings.TrimPrefix(fl.Type, "Null") {
+	case "int64", "Int64":
+		lo, hi := int64(1), intRanges[base]
+		if base == "year" {
+			lo, hi = 1901, 2155
+		} else if unsigned {
+			hi = hi*2 + 1
+		}
+		if hi == 0 {
+			hi = 127
+		}
+		lo, hi = checkBounds(m.Checks, fl.ColumnName, lo, hi)
+		expr = fmt.Sprintf("sampleInt(%d, %d)", lo, hi)
+	case "float64", "Float64":
+		digits, scale := 3, 2
+		if base == "decimal" {
+			if p, s, ok := parsePrecision(args); ok {
+				digits, scale = p-s, s
+			}
+		}
+		expr = fmt.Sprintf("sampleFloat(%d, %d)", minInt(digits, 6), minInt(scale, 6))
+	case "bool", "Bool":
+		expr = "sampleBool()"
+	case "string", "String":
+		switch base {
+		case "enum", "set":
+			expr = QuoteString(firstQuoted(args))
+		case "time":
+			expr = "sampleClock()"
+		case "char", "varchar":
+			n, _ := strconv.Atoi(args)
+			expr = fmt.Sprintf("sampleString(%d)", minInt(n, 16))
+		default:
+			expr = "sampleString(16)"
+		}
+	case "[]byte":
+		n, _ := strconv.Atoi(args)
+		switch base {
+		case "bit":
+			return "[]byte{1}"
+		case "binary":
+			// binary columns pad shorter values, so fill them up
+			return fmt.Sprintf("[]byte(sampleString(%d))", n)
+		case "varbinary":
+			return fmt.Sprintf("[]byte(sampleString(%d))", minInt(n, 16))
+		default:
+			return "[]byte(sampleString(16))"
+		}
+	case "RawJSON":
+		return "sampleJSON()"
+	case "time.Time", "Time":
+		if base == "date" {
+			expr = "sampleDate()"
+		} else {
+			expr = "sampleTime()"
+		}
+	default:
+		return GetNullValue(fl)
+	}
+	if strings.HasPrefix(fl.Type, "Null") {
+		field := strings.TrimPrefix(fl.Type, "Null")
+		return fmt.Sprintf("%s{%s: %s, Valid: true}", fl.Type, field, expr)
+	}
+	return expr
+}
+
+// GetNullValue returns the expression of a NULL value for a field.
+func GetNullValue(fl TmplField) string {
+	switch fl.Type {
+	case "[]byte", "RawJSON":
+		return "nil"
+	default:
+		return fl.Type + "{}"
+	}
+}
+
+// GetUniqueMatch returns the condition under which two rows clash on a unique key, as MySQL sees it:
+// every column holds the same value in both, and none of them is NULL, NULL never clashing.
+func GetUniqueMatch(key TmplKey, row, other string) string {
+	var conds []string
+	for _, fl := range key.Fields {
+		a, b := row+"."+fl.Name, other+"."+fl.Name
+		switch {
+		case fl.Type == "[]byte" && fl.Nullable:
+			conds = append(conds, a+" != nil", b+" != nil")
+		case fl.Type == "RawJSON" && fl.Nullable:
+			conds = append(conds, "len("+a+") != 0", "len("+b+") != 0")
+		case strings.HasPrefix(fl.Type, "Null"):
+			conds = append(conds, a+".Valid", b+".Valid")
+		}
+		if fl.Type == "[]byte" || fl.Type == "RawJSON" {
+			conds = append(conds, "string("+a+") == string("+b+")")
+		} else {
+			conds = append(conds, a+" == "+b)
+		}
+	}
+	return strings.Join(conds, " && ")
+}
+
+// intRanges holds the maximum value of the signed integer column types. That of bigint is capped
+// at 2^53, the largest integer a float64 holds exactly, as the bounds of CHECK constraints are parsed
+// as float64, and so that doubling it for unsigned columns cannot overflow an int64.
+var intRanges = map[string]int64{
+	"tinyint":   127,
+	"smallint":  32767,
+	"mediumint": 8388607,
+	"int":       2147483647,
+	"bigint":    1 << 53,
+}
+
+// parseColumnType splits a column type, such as "int(10) unsigned",
+// into its base type, the arguments between its parentheses and whether it is unsigned.
+func parseColumnType(typ string) (base, args string, unsigned bool) {
+	typ = strings.ToLower(typ)
+	unsigned = strings.Contains(typ, " unsigned")
+	base = typ
+	if i := strings.IndexAny(typ, "( "); i >= 0 {
+		base = typ[:i]
+	}
+	if i, j := strings.Index(typ, "("), strings.LastIndex(typ, ")"); i >= 0 && j > i {
+		args = typ[i+1 : j]
+	}
+	return base, args, unsigned
+}
+
+// parsePrecision parses the precision and scale arguments of a decimal column.
+func parsePrecision(args string) (precision, scale int, ok bool) {
+	parts := strings.Split(args, ",")
+	precision, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, false
+	}
+	if len(parts) > 1 {
+		if scale, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {
+			return 0, 0, false
+		}
+	}
+	return precision, scale, true
+}
+
+// firstQuoted returns the first single quoted value of the arguments of an enum or set column.
+func firstQuoted(args string) string {
+	if values := quotedValues(args); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// quotedValues returns the single quoted values of the arguments of an enum or set column.
+func quotedValues(args string) []string {
+	var values []string
+	for i := 0; i < len(args); i++ {
+		if args[i] != '\'' {
+			continue
+		}
+		value := []byte{}
+		for i++; i < len(args); i++ {
+			if args[i] == '\'' {
+				if i+1 < len(args) && args[i+1] == '\'' {
+					value = append(value, '\'')
+					i++
+					continue
+				}
+				break
+			}
+			value = append(value, args[i])
+		}
+		values = append(values, string(value))
+	}
+	return values
+}
+
+// GetValidationRules returns the rules the fields of a model must follow to fit their columns,
+// derived from the column types and the CHECK constraints simple enough to evaluate in Go.
+// The columns the generated methods set themselves are left out.
+func GetValidationRules(m StructTmplData) []TmplRule {
+	var rules []TmplRule
+	for _, fl := range m.Model.Fields {
+		if fl.AutoInc {
+			continue
+		}
+		switch fl.ColumnName {
+		case "id", "created_at", "updated_at", m.SoftDelete, m.Version:
+			continue
+		}
+		rules = append(rules, columnRules(m.Receiver, fl)...)
+	}
+	for _, check := range m.Model.Checks {
+		if rule, ok := checkRule(m.Receiver, m.Model.Fields, check); ok {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// GetDatabaseChecks returns the CHECK constraints of a model which only the database can evaluate.
+func GetDatabaseChecks(m StructTmplData) []TmplCheck {
+	var checks []TmplCheck
+	for _, check := range m.Model.Checks {
+		if _, ok := checkRule(m.Receiver, m.Model.Fields, check); !ok {
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// textSizes holds the maximum size in bytes of the text and blob column types.
+var textSizes = map[string]int{
+	"tinytext":   255,
+	"text":       65535,
+	"mediumtext": 16777215,
+	"tinyblob":   255,
+	"blob":       65535,
+	"mediumblob": 16777215,
+}
+
+// columnRules returns the rules following from the type of the column of a field.
+func columnRules(receiver string, fl TmplField) []TmplRule {
+	base, args, unsigned := parseColumnType(fl.ColumnType)
+	value := receiver + "." + fl.Name
+	rule := func(format string, a ...interface{}) func(string) TmplRule {
+		invalid := fmt.Sprintf(format, a...)
+		return func(message string) TmplRule {
+			return TmplRule{Field: fl, Invalid: invalid, Message: message}
+		}
+	}
+	if strings.HasPrefix(fl.Type, "Null") {
+		// NULL always fits a nullable column, only check valid values
+		inner := value + "." + strings.TrimPrefix(fl.Type, "Null")
+		rules := columnRules(receiver, TmplField{Name: fl.Name, Type: strings.ToLower(strings.TrimPrefix(fl.Type, "Null")), ColumnType: fl.ColumnType})
+		for i := range rules {
+			rules[i].Field = fl
+			rules[i].Invalid = value + ".Valid && (" + strings.Replace(rules[i].Invalid, value, inner, -1) + ")"
+		}
+		return rules
+	}
+
+	var rules []TmplRule
+	switch fl.Type {
+	case "[]byte", "RawJSON":
+		if !fl.Nullable && !fl.HasDefault && fl.ColumnType != "" {
+			// both are written as NULL when empty
+			if fl.Type == "RawJSON" {
+				rules = append(rules, rule("len(%s) == 0", value)("is required"))
+			} else {
+				rules = append(rules, rule("%s == nil", value)("is required"))
+			}
+		}
+		n, _ := strconv.Atoi(args)
+		switch {
+		case base == "bit" && n > 0:
+			rules = append(rules, rule("len(%s) > %d", value, (n+7)/8)(fmt.Sprintf("must fit in a bit(%d) column", n)))
+		case (base == "binary" || base == "varbinary") && n > 0:
+			rules = append(rules, rule("len(%s) > %d", value, n)(fmt.Sprintf("must be at most %d bytes", n)))
+		case textSizes[base] > 0:
+			rules = append(rules, rule("len(%s) > %d", value, textSizes[base])(fmt.Sprintf("must be at most %d bytes", textSizes[base])))
+		}
+	case "string":
+		switch base {
+		case "char", "varchar":
+			if n, _ := strconv.Atoi(args); n > 0 {
+				rules = append(rules, rule("charLength(%s) > %d", value, n)(fmt.Sprintf("must be at most %d characters", n)))
+			}
+		case "enum", "set":
+			members := quotedValues(args)
+			quoted := make([]string, len(members))
+			for i, member := range members {
+				quoted[i] = QuoteString(member)
+			}
+			check, message := "oneOf", "must be one of "
+			if base == "set" {
+				check, message = "setOf", "must be a comma separated list of "
+			}
+			rules = append(rules, rule("!%s(%s, %s)", check, value, strings.Join(quoted, ", "))(message+strings.Join(members, ", ")))
+		default:
+			if textSizes[base] > 0 {
+				rules = append(rules, rule("len(%s) > %d", value, textSizes[base])(fmt.Sprintf("must be at most %d bytes", textSizes[base])))
+			}
+		}
+	case "int64":
+		hi, ok := intRanges[base]
+		switch {
+		case base == "year":
+			rules = append(rules, rule("%s != 0 && (%s < 1901 || %s > 2155)", value, value, value)("must be between 1901 and 2155"))
+		case base == "bigint" && unsigned:
+			rules = append(rules, rule("%s < 0", value)("must not be negative"))
+		case base == "bigint" || !ok:
+		case unsigned:
+			rules = append(rules, rule("%s < 0 || %s > %d", value, value, hi*2+1)(fmt.Sprintf("must be between 0 and %d", hi*2+1)))
+		default:
+			rules = append(rules, rule("%s < %d || %s > %d", value, -hi-1, value, hi)(fmt.Sprintf("must be between %d and %d", -hi-1, hi)))
+		}
+	case "float64":
+		if base == "decimal" {
+			if p, s, ok := parsePrecision(args); ok {
+				rules = append(rules, rule("exceedsDigits(%s, %d)", value, p-s)(fmt.Sprintf("must have at most %d digits before the decimal point", p-s)))
+			}
+		}
+		if unsigned {
+			rules = append(rules, rule("%s < 0", value)("must not be negative"))
+		}
+	}
+	return rules
+}
+
+// parseCheck splits a CHECK constraint comparing a column, or the character length of one,
+// with a number into the column, the Go comparison operator and the number.
+func parseCheck(check TmplCheck) (column string, length bool, op, number string, ok bool) {
+	clause := strings.TrimSpace(check.Clause)
+	for enclosed(clause) {
+		clause = strings.TrimSpace(clause[1 : len(clause)-1])
+	}
+	parts := strings.Fields(clause)
+	if len(parts) != 3 {
+		return "", false, "", "", false
+	}
+	operand, op, number := parts[0], checkOperators[parts[1]], parts[2]
+	if op == "" {
+		return "", false, "", "", false
+	}
+	if !checkNumber.MatchString(number) {
+		return "", false, "", "", false
+	}
+
+	length = strings.HasPrefix(operand, "char_length(") && strings.HasSuffix(operand, ")")
+	if length {
+		operand = operand[len("char_length(") : len(operand)-1]
+	}
+	if len(operand) < 2 || operand[0] != '`' || operand[len(operand)-1] != '`' {
+		return "", false, "", "", false
+	}
+	return strings.ToLower(operand[1 : len(operand)-1]), length, op, number, true
+}
+
+// checkNumber matches the decimal numbers a CHECK constraint can compare a column with,
+// which are valid Go literals as well.
+var checkNumber = regexp.MustCompile(`^-?(\d+(\.\d*)?|\.\d+)([eE][-+]?\d+)?$`)
+
+// checkOperators maps the comparison operators of SQL to those of Go.
+var checkOperators = map[string]string{
+	"=": "==", "<>": "!=", "!=": "!=", "<": "<", "<=": "<=", ">": ">", ">=": ">=",
+}
+
+// checkRule translates a CHECK constraint comparing a numeric column, or the character length
+// of a string column, with a number, such as "(`price` > 0)" or "(char_length(`name`) >= 2)".
+// It reports false for any other constraint.
+func checkRule(receiver string, fields []TmplField, check TmplCheck) (TmplRule, bool) {
+	column, length, op, number, ok := parseCheck(check)
+	if !ok {
+		return TmplRule{}, false
+	}
+
+	for _, fl := range fields {
+		if fl.ColumnName != column {
+			continue
+		}
+		value := receiver + "." + fl.Name
+		guard := ""
+		if strings.HasPrefix(fl.Type, "Null") {
+			// a CHECK constraint is met by NULL
+			guard = value + ".Valid && "
+			value += "." + strings.TrimPrefix(fl.Type, "Null")
+		}
+		integer := length
+		switch typ := strings.TrimPrefix(fl.Type, "Null"); {
+		case length && (typ == "string" || typ == "String"):
+			value = "charLength(" + value + ")"
+		case length || (typ != "int64" && typ != "Int64" && typ != "float64" && typ != "Float64"):
+			return TmplRule{}, false
+		case typ == "int64" || typ == "Int64":
+			integer = true
+		}
+		if _, err := strconv.ParseInt(number, 10, 64); integer && err != nil {
+			// compared as the database does, rather than with a literal Go cannot convert
+			value = "float64(" + value + ")"
+		}
+		return TmplRule{
+			Field:   fl,
+			Invalid: fmt.Sprintf("%s!(%s %s %s)", guard, value, op, number),
+			Message: fmt.Sprintf("must satisfy the %s check: %s", check.Name, CommentText(check.Clause)),
+		}, true
+	}
+	return TmplRule{}, false
+}
+
+// enclosed reports whether s is wrapped in a pair of matching parentheses.
+func enclosed(s string) bool {
+	if !strings.HasPrefix(s, "(") {
+		return false
+	}
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i == len(s)-1
+			}
+		}
+	}
+	return false
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
 }
 
 func GetInsertFields(fields []TmplField) string {
@@ -25,7 +575,7 @@ func GetInsertFields(fields []TmplField) string {
 		if fl.ColumnName == "id" {
 			continue
 		}
-		parts = append(parts, "`"+fl.ColumnName+"`")
+		parts = append(parts, QuoteIdent(fl.ColumnName))
 	}
 	return strings.Join(parts, ", ")
 }
@@ -47,6 +597,13 @@ func GetInsertValues(fields []TmplField) string {
 }
 
 func GetInsertArgs(m StructTmplData) string {
+	if list := GetInsertArgList(m); list != "" {
+		return ", " + list
+	}
+	return ""
+}
+
+func GetInsertArgList(m StructTmplData) string {
 	var parts []string
 	for _, fl := range m.Model.Fields {
 		switch fl.Name {
@@ -55,31 +612,49 @@ func GetInsertArgs(m StructTmplData) string {
 		}
 		parts = append(parts, fmt.Sprintf("%s.%s", m.Receiver, fl.Name))
 	}
-	if len(parts) > 0 {
-		return ", " + strings.Join(parts, ", ")
+	return strings.Join(parts, ", ")
+}
+
+func GetSelectFields(fields []TmplField) string {
+	var parts []string
+	for _, fl := range fields {
+		parts = append(parts, QuoteIdent(fl.ColumnName))
 	}
-	return ""
+	return strings.Join(parts, ", ")
 }
 
-func GetScanFields(m StructTmplData) template.HTML {
+func GetScanFields(m StructTmplData) string {
 	var parts []string
 	for _, fl := range m.Model.Fields {
 		parts = append(parts, fmt.Sprintf("&%s.%s", m.Receiver, fl.Name))
 	}
-	return template.HTML(strings.Join(parts, ", "))
+	return strings.Join(parts, ", ")
 }
 
-func GetUpdateArgs(m StructTmplData) template.HTML {
-	var parts []string
+// GetUpdateFields returns the fields an update writes the value of,
+// leaving out the ones set by the database or managed by the generated methods.
+func GetUpdateFields(m StructTmplData) []TmplField {
+	var fields []TmplField
 	for _, fl := range m.Model.Fields {
 		switch fl.Name {
 		case "ID", "CreatedAt", "UpdatedAt":
 			continue
 		}
+		if fl.ColumnName == m.SoftDelete || fl.ColumnName == m.Version {
+			continue
+		}
+		fields = append(fields, fl)
+	}
+	return fields
+}
+
+func GetUpdateArgs(m StructTmplData) string {
+	var parts []string
+	for _, fl := range GetUpdateFields(m) {
 		parts = append(parts, fmt.Sprintf("%s.%s", m.Receiver, fl.Name))
 	}
 	if len(parts) > 0 {
-		return template.HTML(strings.Join(parts, ", ") + ", ")
+		return strings.Join(parts, ", ") + ", "
 	}
 	return ""
 }
@@ -87,13 +662,20 @@ func GetUpdateArgs(m StructTmplData) template.HTML {
 func GetUpdateValues(m StructTmplData) string {
 	var parts []string
 	for _, fl := range m.Model.Fields {
+		if fl.ColumnName == m.SoftDelete {
+			continue
+		}
+		if fl.ColumnName == m.Version {
+			parts = append(parts, fmt.Sprintf("%[1]s=%[1]s+1", QuoteIdent(fl.ColumnName)))
+			continue
+		}
 		switch fl.Name {
 		case "ID", "CreatedAt":
 			continue
 		case "UpdatedAt":
-			parts = append(parts, fmt.Sprintf("`%s`=UTC_TIMESTAMP()", fl.ColumnName))
+			parts = append(parts, fmt.Sprintf("%s=UTC_TIMESTAMP()", QuoteIdent(fl.ColumnName)))
 		default:
-			parts = append(parts, fmt.Sprintf("`%s`=?", fl.ColumnName))
+			parts = append(parts, fmt.Sprintf("%s=?", QuoteIdent(fl.ColumnName)))
 		}
 	}
 	return strings.Join(parts, ", ")
@@ -102,7 +684,7 @@ func GetUpdateValues(m StructTmplData) string {
 func GetUpsertFields(fields []TmplField) string {
 	var parts []string
 	for _, fl := range fields {
-		parts = append(parts, "`"+fl.ColumnName+"`")
+		parts = append(parts, QuoteIdent(fl.ColumnName))
 	}
 	return strings.Join(parts, ", ")
 }
@@ -121,20 +703,38 @@ func GetUpsertValues(fields []TmplField) string {
 	return strings.Join(parts, ", ")
 }
 
+// GetUpsertOnDuplicate returns the assignments of an ON DUPLICATE KEY UPDATE clause.
+// With a version column, every assignment only applies when the version of the
+// existing row matches the inserted one, and the version is assigned last:
+// MySQL evaluates the assignments in order, so the earlier ones still see the old version.
 func GetUpsertOnDuplicate(m StructTmplData) string {
+	guard := func(col, expr string) string {
+		if m.Version == "" {
+			return fmt.Sprintf("%s=%s", col, expr)
+		}
+		return fmt.Sprintf("%[1]s=IF(%[2]s=VALUES(%[2]s), %[3]s, %[1]s)", col, QuoteIdent(m.Version), expr)
+	}
 	var parts []string
 	for _, fl := range m.Model.Fields {
+		col := QuoteIdent(fl.ColumnName)
+		if fl.ColumnName == m.Version {
+			continue
+		}
 		switch fl.Name {
 		case "CreatedAt":
 			continue
 		case "ID":
-			parts = append(parts, fmt.Sprintf("`%s`=LAST_INSERT_ID(`%s`)", fl.ColumnName, fl.ColumnName))
+			parts = append(parts, fmt.Sprintf("%[1]s=LAST_INSERT_ID(%[1]s)", col))
 		case "UpdatedAt":
-			parts = append(parts, fmt.Sprintf("`%s`=UTC_TIMESTAMP()", fl.ColumnName))
+			parts = append(parts, guard(col, "UTC_TIMESTAMP()"))
 		default:
-			parts = append(parts, fmt.Sprintf("`%s`=VALUES(`%s`)", fl.ColumnName, fl.ColumnName))
+			parts = append(parts, guard(col, fmt.Sprintf("VALUES(%s)", col)))
 		}
 	}
+	if m.Version != "" {
+		col := QuoteIdent(m.Version)
+		parts = append(parts, guard(col, col+"+1"))
+	}
 	return strings.Join(parts, ", ")
 }
 
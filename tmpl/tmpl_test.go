@@ -0,0 +1,257 @@
+package tmpl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuoteIdent(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "plain",
+			in:   "user",
+			want: "`user`",
+		},
+		{
+			name: "reserved word",
+			in:   "order",
+			want: "`order`",
+		},
+		{
+			name: "embedded backtick",
+			in:   "we`ird",
+			want: "`we``ird`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteIdent(tt.in); got != tt.want {
+				t.Errorf("QuoteIdent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetFieldComment(t *testing.T) {
+	tests := []struct {
+		name  string
+		field TmplField
+		want  string
+	}{
+		{
+			name:  "no comment or default",
+			field: TmplField{},
+			want:  "",
+		},
+		{
+			name:  "multiline comment",
+			field: TmplField{Comment: "first <line>\nsecond & \"third\""},
+			want:  `// first <line> second & "third"`,
+		},
+		{
+			name:  "default with quotes",
+			field: TmplField{Default: `say "hi"`, HasDefault: true},
+			want:  `// default: "say \"hi\""`,
+		},
+		{
+			name:  "empty default",
+			field: TmplField{Comment: "flag", HasDefault: true},
+			want:  `// flag default: ""`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetFieldComment(tt.field); got != tt.want {
+				t.Errorf("GetFieldComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetUpsertOnDuplicate(t *testing.T) {
+	fields := []TmplField{
+		{Name: "ID", ColumnName: "id"},
+		{Name: "Version", ColumnName: "version"},
+		{Name: "Name", ColumnName: "name"},
+		{Name: "CreatedAt", ColumnName: "created_at"},
+		{Name: "UpdatedAt", ColumnName: "updated_at"},
+	}
+	tests := []struct {
+		name    string
+		version string
+		want    string
+	}{
+		{
+			name: "without version",
+			want: "`id`=LAST_INSERT_ID(`id`), `version`=VALUES(`version`), `name`=VALUES(`name`), `updated_at`=UTC_TIMESTAMP()",
+		},
+		{
+			name:    "with version",
+			version: "version",
+			want: "`id`=LAST_INSERT_ID(`id`), " +
+				"`name`=IF(`version`=VALUES(`version`), VALUES(`name`), `name`), " +
+				"`updated_at`=IF(`version`=VALUES(`version`), UTC_TIMESTAMP(), `updated_at`), " +
+				"`version`=IF(`version`=VALUES(`version`), `version`+1, `version`)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := StructTmplData{Model: TmplStruct{Fields: fields}, Version: tt.version}
+			if got := GetUpsertOnDuplicate(m); got != tt.want {
+				t.Errorf("GetUpsertOnDuplicate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetUpdateFields(t *testing.T) {
+	m := StructTmplData{
+		Model: TmplStruct{Fields: []TmplField{
+			{Name: "ID", ColumnName: "id"},
+			{Name: "Name", ColumnName: "name"},
+			{Name: "CreatedAt", ColumnName: "created_at"},
+			{Name: "UpdatedAt", ColumnName: "updated_at"},
+			{Name: "DeletedAt", ColumnName: "deleted_at"},
+			{Name: "Version", ColumnName: "version"},
+			{Name: "Email", ColumnName: "email"},
+		}},
+		SoftDelete: "deleted_at",
+		Version:    "version",
+	}
+	var got []string
+	for _, fl := range GetUpdateFields(m) {
+		got = append(got, fl.ColumnName)
+	}
+	if want := []string{"name", "email"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetUpdateFields() = %v, want %v", got, want)
+	}
+}
+
+func TestGetUniqueMatch(t *testing.T) {
+	tests := []struct {
+		name string
+		key  TmplKey
+		want string
+	}{
+		{
+			name: "non nullable columns",
+			key: TmplKey{Fields: []TmplField{
+				{Name: "TenantID", Type: "int64"},
+				{Name: "Hash", Type: "[]byte"},
+			}},
+			want: "other.TenantID == u.TenantID && string(other.Hash) == string(u.Hash)",
+		},
+		{
+			name: "nullable columns",
+			key: TmplKey{Fields: []TmplField{
+				{Name: "Email", Type: "NullString", Nullable: true},
+				{Name: "Token", Type: "[]byte", Nullable: true},
+				{Name: "Meta", Type: "RawJSON", Nullable: true},
+			}},
+			want: "other.Email.Valid && u.Email.Valid && other.Email == u.Email && " +
+				"other.Token != nil && u.Token != nil && string(other.Token) == string(u.Token) && " +
+				"len(other.Meta) != 0 && len(u.Meta) != 0 && string(other.Meta) == string(u.Meta)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetUniqueMatch(tt.key, "other", "u"); got != tt.want {
+				t.Errorf("GetUniqueMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSampleValue(t *testing.T) {
+	tests := []struct {
+		typ, columnType, want string
+	}{
+		{"int64", "int(11)", "sampleInt(1, 2147483647)"},
+		{"int64", "tinyint(3) unsigned", "sampleInt(1, 255)"},
+		{"NullInt64", "year(4)", "NullInt64{Int64: sampleInt(1901, 2155), Valid: true}"},
+		{"float64", "decimal(10,2)", "sampleFloat(6, 2)"},
+		{"bool", "tinyint(1)", "sampleBool()"},
+		{"string", "enum('it''s','b')", `"it's"`},
+		{"string", "varchar(8)", "sampleString(8)"},
+		{"NullString", "text", "NullString{String: sampleString(16), Valid: true}"},
+		{"[]byte", "binary(32)", "[]byte(sampleString(32))"},
+		{"RawJSON", "json", "sampleJSON()"},
+		{"time.Time", "date", "sampleDate()"},
+		{"NullTime", "datetime", "NullTime{Time: sampleTime(), Valid: true}"},
+	}
+	for _, tt := range tests {
+		if got := GetSampleValue(TmplStruct{}, TmplField{Type: tt.typ, ColumnType: tt.columnType}); got != tt.want {
+			t.Errorf("GetSampleValue(%s %s) = %s, want %s", tt.typ, tt.columnType, got, tt.want)
+		}
+	}
+
+	m := TmplStruct{Checks: []TmplCheck{
+		{Name: "small_max", Clause: "(`small` < 100)"},
+		{Name: "small_min", Clause: "(`small` >= 10)"},
+		{Name: "age_min", Clause: "(`age` > 17)"},
+	}}
+	checked := []struct {
+		fl   TmplField
+		want string
+	}{
+		{TmplField{Type: "NullInt64", ColumnName: "small", ColumnType: "smallint(6) unsigned"}, "NullInt64{Int64: sampleInt(10, 99), Valid: true}"},
+		{TmplField{Type: "int64", ColumnName: "age", ColumnType: "tinyint(3) unsigned"}, "sampleInt(18, 255)"},
+		{TmplField{Type: "int64", ColumnName: "count", ColumnType: "int(11)"}, "sampleInt(1, 2147483647)"},
+	}
+	for _, tt := range checked {
+		if got := GetSampleValue(m, tt.fl); got != tt.want {
+			t.Errorf("GetSampleValue(%s) with checks = %s, want %s", tt.fl.ColumnName, got, tt.want)
+		}
+	}
+}
+
+func TestGetValidationRules(t *testing.T) {
+	m := StructTmplData{
+		Model: TmplStruct{
+			Fields: []TmplField{
+				{Name: "ID", ColumnName: "id", Type: "int64", ColumnType: "int(10) unsigned", AutoInc: true},
+				{Name: "Email", ColumnName: "email", Type: "string", ColumnType: "varchar(255)"},
+				{Name: "Age", ColumnName: "age", Type: "NullInt64", ColumnType: "tinyint(3) unsigned", Nullable: true},
+				{Name: "Status", ColumnName: "status", Type: "string", ColumnType: "enum('on','off')"},
+				{Name: "Avatar", ColumnName: "avatar", Type: "[]byte", ColumnType: "blob"},
+				{Name: "Total", ColumnName: "total", Type: "float64", ColumnType: "decimal(6,2)"},
+				{Name: "CreatedAt", ColumnName: "created_at", Type: "time.Time", ColumnType: "datetime"},
+			},
+			Checks: []TmplCheck{
+				{Name: "total_positive", Clause: "(`total` > 0)"},
+				{Name: "email_length", Clause: "((char_length(`email`) >= 3))"},
+				{Name: "compares_columns", Clause: "(`total` > `age`)"},
+				{Name: "age_adult", Clause: "(`age` > 1.5)"},
+				{Name: "email_short", Clause: "(char_length(`email`) < 1e3)"},
+				{Name: "not_a_number", Clause: "(`total` > Inf)"},
+			},
+		},
+		Receiver: "u",
+	}
+	want := []string{
+		"charLength(u.Email) > 255",
+		"u.Age.Valid && (u.Age.Int64 < 0 || u.Age.Int64 > 255)",
+		`!oneOf(u.Status, "on", "off")`,
+		"u.Avatar == nil",
+		"len(u.Avatar) > 65535",
+		"exceedsDigits(u.Total, 4)",
+		"!(u.Total > 0)",
+		"!(charLength(u.Email) >= 3)",
+		"u.Age.Valid && !(float64(u.Age.Int64) > 1.5)",
+		"!(float64(charLength(u.Email)) < 1e3)",
+	}
+	var got []string
+	for _, rule := range GetValidationRules(m) {
+		got = append(got, rule.Invalid)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetValidationRules() = %q, want %q", got, want)
+	}
+	if checks := GetDatabaseChecks(m); len(checks) != 2 || checks[0].Name != "compares_columns" || checks[1].Name != "not_a_number" {
+		t.Errorf("GetDatabaseChecks() = %v, want compares_columns and not_a_number", checks)
+	}
+}
@@ -5,10 +5,14 @@ type TmplStructs []TmplStruct
 
 // TmplStruct defines the table data to pass to the models
 type TmplStruct struct {
-	Name      string
-	TableName string
-	Fields    []TmplField
-	Imports   map[string]struct{}
+	Name        string
+	TableName   string
+	Fields      []TmplField
+	Keys        []TmplKey
+	Uniques     []TmplKey
+	Checks      []TmplCheck
+	ForeignKeys []TmplForeignKey
+	Imports     map[string]struct{}
 }
 
 // TmplField defines a table field template
@@ -16,7 +20,12 @@ type TmplField struct {
 	Name       string
 	Type       string
 	ColumnName string
+	ColumnType string
 	Nullable   bool
+	Comment    string
+	Default    string
+	HasDefault bool
+	AutoInc    bool
 }
 
 // StructTmplData defines the top level struct data to pass to the models
@@ -24,4 +33,111 @@ type StructTmplData struct {
 	Model       TmplStruct
 	Receiver    string
 	PackageName string
+	ContextOnly bool
+	SoftDelete  string
+	Version     string
+	Validate    bool
+	Proto       TmplProto
+	Factory     TmplFactory
+	Lookup      TmplLookup
+}
+
+// TmplKey defines a unique key of a table, named after its fields. The Keys of a table are usable
+// for keyset pagination, the primary key coming first with an empty Name, while its Uniques hold
+// every unique secondary index, nullable columns included, for the fake repositories to enforce.
+type TmplKey struct {
+	Name   string
+	Fields []TmplField
+}
+
+// TmplCheck defines a CHECK constraint of a table, with its clause as the database reports it.
+type TmplCheck struct {
+	Name   string
+	Clause string
+}
+
+// TmplRule defines a validation rule of a field derived from its column:
+// Invalid is a Go expression which is true when the field breaks the rule.
+type TmplRule struct {
+	Field   TmplField
+	Invalid string
+	Message string
+}
+
+// TmplForeignKey defines a single column foreign key of a table.
+type TmplForeignKey struct {
+	Name      string
+	Column    string
+	RefTable  string
+	RefColumn string
+}
+
+// TmplProto defines the protobuf message of a model. It is empty unless
+// protobuf generation is enabled, Package being the Go import path
+// of the package protoc generates from the .proto files.
+type TmplProto struct {
+	Package       string
+	Fields        []TmplProtoField
+	Reserved      []int
+	ReservedNames []string
+}
+
+// TmplProtoField defines a field of a protobuf message: its proto name,
+// the name protoc gives it in Go and its field number.
+type TmplProtoField struct {
+	Field  TmplField
+	Name   string
+	GoName string
+	Number int
+}
+
+// TmplFactory defines the factory of a model, generated into a package of its own
+// importing the models package, whose import path is Import and name Package.
+type TmplFactory struct {
+	Import  string
+	Package string
+	Fields  []TmplFactoryField
+	Parents []TmplFactoryParent
+}
+
+// TmplFactoryField pairs a field with the expression of the fake value a factory fills it with.
+type TmplFactoryField struct {
+	Field TmplField
+	Value string
+}
+
+// TmplFactoryParent defines a foreign key column whose referenced row
+// a factory inserts first, and the model of that row.
+type TmplFactoryParent struct {
+	Field TmplField
+	Model TmplStruct
+}
+
+// TmplFixtures defines the Go fixtures of the rows of a table, dumped from the database.
+type TmplFixtures struct {
+	PackageName string
+	Model       TmplStruct
+	Imports     []string
+	Rows        [][]TmplFixtureValue
+}
+
+// TmplFixtureValue pairs a field with the Go expression of its value in a dumped row.
+type TmplFixtureValue struct {
+	Field TmplField
+	Value string
+}
+
+// TmplLookup defines the typed constants of a lookup table, one per row read when generating
+// the models, named after the code of the row held by Column. It is empty for other tables.
+type TmplLookup struct {
+	Type   string
+	Column string
+	Values []TmplLookupValue
+}
+
+// TmplLookupValue defines the constant of a row of a lookup table.
+type TmplLookupValue struct {
+	Name string
+	ID   int64
+	Code string
 }
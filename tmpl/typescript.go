@@ -0,0 +1,73 @@
+package tmpl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// GetTypeScriptType returns the TypeScript type of the JSON encoding of a field:
+// times are ISO 8601 strings, byte slices base64 strings, and the members of
+// enum columns string literals. Nullable columns and nil byte slices add null.
+func GetTypeScriptType(fl TmplField) string {
+	base, args, _ := parseColumnType(fl.ColumnType)
+	var typ string
+	switch strings.TrimPrefix(fl.Type, "Null") {
+	case "RawJSON":
+		// any JSON value, including null
+		return "unknown"
+	case "int64", "Int64", "float64", "Float64":
+		typ = "number"
+	case "bool", "Bool":
+		typ = "boolean"
+	case "string", "String":
+		typ = "string"
+		if members := quotedValues(args); base == "enum" && len(members) > 0 {
+			literals := make([]string, len(members))
+			for i, member := range members {
+				literals[i] = typeScriptString(member)
+			}
+			typ = strings.Join(literals, " | ")
+		}
+	default:
+		typ = "string"
+	}
+	if strings.HasPrefix(fl.Type, "Null") || fl.Type == "[]byte" {
+		typ += " | null"
+	}
+	return typ
+}
+
+// TypeScriptProperty returns the name of the property holding a column,
+// quoted unless it is a valid identifier.
+func TypeScriptProperty(name string) string {
+	if name == "" || isDigit(name[0]) {
+		return typeScriptString(name)
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !isLower(c) && !isDigit(c) && (c < 'A' || c > 'Z') && c != '_' && c != '$' {
+			return typeScriptString(name)
+		}
+	}
+	return name
+}
+
+// GetTypeScriptComment returns a JSDoc comment documenting the column
+// comment and default value of a field, or nothing if it has neither.
+func GetTypeScriptComment(fl TmplField) string {
+	text := strings.TrimPrefix(GetFieldComment(fl), "// ")
+	if text == "" {
+		return ""
+	}
+	return "/** " + strings.Replace(text, "*/", `*\/`, -1) + " */"
+}
+
+// typeScriptString quotes s as a TypeScript string literal, which JSON strings are valid ones of.
+func typeScriptString(s string) string {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	return strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,44 @@
+package tmpl
+
+import "testing"
+
+func TestGetTypeScriptType(t *testing.T) {
+	tests := []struct {
+		typ, columnType, want string
+	}{
+		{"int64", "bigint(20) unsigned", "number"},
+		{"NullFloat64", "decimal(10,2)", "number | null"},
+		{"bool", "tinyint(1)", "boolean"},
+		{"NullBool", "tinyint(1)", "boolean | null"},
+		{"string", "varchar(255)", "string"},
+		{"NullString", "text", "string | null"},
+		{"time.Time", "datetime", "string"},
+		{"NullTime", "timestamp", "string | null"},
+		{"[]byte", "blob", "string | null"},
+		{"RawJSON", "json", "unknown"},
+		{"string", "enum('a','b''c')", `"a" | "b'c"`},
+		{"NullString", "enum('on')", `"on" | null`},
+	}
+	for _, tt := range tests {
+		fl := TmplField{Type: tt.typ, ColumnType: tt.columnType}
+		if got := GetTypeScriptType(fl); got != tt.want {
+			t.Errorf("GetTypeScriptType(%s %s) = %s, want %s", tt.typ, tt.columnType, got, tt.want)
+		}
+	}
+}
+
+func TestTypeScriptProperty(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"created_at", "created_at"},
+		{"$ref", "$ref"},
+		{"2fa", `"2fa"`},
+		{"order-total", `"order-total"`},
+	}
+	for _, tt := range tests {
+		if got := TypeScriptProperty(tt.name); got != tt.want {
+			t.Errorf("TypeScriptProperty(%q) = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
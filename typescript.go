@@ -0,0 +1,35 @@
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/LUSHDigital/modelgen/tmpl"
+)
+
+// langs lists the languages generate writes models in.
+var langs = []string{"go", "typescript"}
+
+// validateLang checks the --lang flag names a supported language.
+func validateLang() {
+	for _, l := range langs {
+		if *lang == l {
+			return
+		}
+	}
+	log.Fatalf("unknown language %q, use go or typescript", *lang)
+}
+
+// writeTypeScript writes a <table>.ts file per model, declaring an interface
+// matching the JSON encoding of the generated Go struct.
+func writeTypeScript(models []tmpl.TmplStruct) {
+	t := loadTemplates()
+	out := *output
+	os.Mkdir(out, 0777)
+
+	for _, model := range models {
+		m := tmpl.StructTmplData{Model: model}
+		writeTemplate(t, "typescript", m, filepath.Join(out, model.TableName+".ts"))
+	}
+}